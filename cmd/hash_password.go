@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"bumsiku/internal/auth"
+)
+
+// runHashPassword는 표준 입력으로 받은 비밀번호의 argon2id 해시를 출력합니다.
+// 출력한 해시를 ADMIN_PW_HASH 환경 변수에 설정합니다.
+//
+//	echo -n 'password' | ./serverapp hash-password
+func runHashPassword(stdin io.Reader, stdout, stderr io.Writer) error {
+	fmt.Fprint(stderr, "비밀번호: ")
+
+	password, err := bufio.NewReader(stdin).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("비밀번호 입력 실패: %w", err)
+	}
+	password = strings.TrimRight(password, "\r\n")
+	if password == "" {
+		return errors.New("비밀번호가 비어 있습니다")
+	}
+
+	hash, err := auth.HashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(stderr)
+	fmt.Fprintln(stdout, hash)
+	return nil
+}
+
+// runCommand는 관리용 하위 명령을 실행합니다. 하위 명령이 없으면 false를 반환해 서버를 시작합니다.
+func runCommand(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+
+	switch args[0] {
+	case "hash-password":
+		if err := runHashPassword(os.Stdin, os.Stdout, os.Stderr); err != nil {
+			fmt.Fprintf(os.Stderr, "해시 생성 실패: %v\n", err)
+			os.Exit(1)
+		}
+	default:
+		fmt.Fprintf(os.Stderr, "알 수 없는 명령: %s\n사용법: serverapp [hash-password]\n", args[0])
+		os.Exit(2)
+	}
+	return true
+}
@@ -3,13 +3,19 @@ package main
 import (
 	"context"
 	"encoding/gob"
+	"errors"
 	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	_ "bumsiku/docs" // Swagger 문서 가져오기
 	"bumsiku/internal/config"
 	"bumsiku/internal/container"
 	"bumsiku/internal/controller"
+	"bumsiku/internal/scheduler"
 )
 
 // @title           Bumsiku API
@@ -21,20 +27,70 @@ import (
 // @securityDefinitions.apikey AdminAuth
 // @in cookie
 // @name loginSession
-// @description 관리자 인증 세션 쿠키
+// @description 관리자 인증 세션 쿠키 (POST, PUT, DELETE 요청은 로그인 또는 /csrf에서 받은 토큰을 X-CSRF-Token 헤더에 함께 보내야 함)
+
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description 자동화용 API 토큰 ("Bearer bsk_..." 형식, /admin/tokens에서 발급)
 
 func main() {
+	// 관리용 하위 명령 (예: hash-password)
+	if runCommand(os.Args[1:]) {
+		return
+	}
+
 	config.LoadEnv()
 	gob.Register(time.Time{})
-	ctx := context.Background()
+
+	// SIGINT, SIGTERM을 받으면 ctx가 취소되어 스케줄러와 서버를 종료
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	container, err := container.NewContainer(ctx)
 	if err != nil {
 		log.Fatalf("의존성 컨테이너 초기화 실패: %v", err)
 	}
 
-	r := controller.SetupRouter(container)
+	// 예약 게시글 발행 스케줄러 시작
+	scheduler.NewPublishScheduler(container.PostRepository, scheduler.DefaultPublishInterval).Start(ctx)
+
+	// 만료된 로그인 세션 정리
+	scheduler.NewSessionCleaner(container.SessionRepository, scheduler.DefaultSessionCleanupInterval).Start(ctx)
+
+	server := &http.Server{
+		Addr:    serverAddr(),
+		Handler: controller.SetupRouter(container),
+	}
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("서버 시작 실패: %v", err)
+		}
+	}()
+	log.Printf("서버 시작: %s", server.Addr)
+
+	<-ctx.Done()
+	stop()
+	log.Printf("서버 종료 중...")
+
+	// 처리 중인 요청을 마친 뒤 남은 로그를 전송
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Printf("서버 종료 실패: %v", err)
+	}
+	if err := container.Logger.Close(shutdownCtx); err != nil {
+		log.Printf("남은 로그 전송 실패: %v", err)
+	}
+}
+
+// shutdownTimeout은 종료 신호를 받은 뒤 처리 중인 요청과 남은 로그 전송을 기다리는 최대 시간입니다.
+const shutdownTimeout = 15 * time.Second
 
-	if err := r.Run(); err != nil {
-		log.Fatalf("서버 시작 실패: %v", err)
+// serverAddr은 서버가 사용할 주소입니다. gin과 같이 PORT 환경 변수가 있으면 사용하고, 없으면 8080 포트를 사용합니다.
+func serverAddr() string {
+	if port := os.Getenv("PORT"); port != "" {
+		return ":" + port
 	}
+	return ":8080"
 }
@@ -20,6 +20,9 @@ const docTemplate = `{
                 "security": [
                     {
                         "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
                     }
                 ],
                 "description": "블로그 카테고리를 추가하거나 수정합니다 (관리자 전용)",
@@ -72,14 +75,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/comments/{commentId}": {
-            "delete": {
+        "/admin/comments": {
+            "get": {
                 "security": [
                     {
                         "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
                     }
                 ],
-                "description": "블로그 댓글을 삭제합니다 (관리자 전용)",
+                "description": "검토 상태와 관계없이 댓글을 등록순으로 조회합니다 (관리자 전용)\nstatus로 검토 대기(pending) 등 상태별 목록을, postId로 특정 게시물의 댓글을 조회할 수 있습니다",
                 "consumes": [
                     "application/json"
                 ],
@@ -89,24 +95,27 @@ const docTemplate = `{
                 "tags": [
                     "댓글"
                 ],
-                "summary": "댓글 삭제",
+                "summary": "관리자용 댓글 목록 조회",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "댓글 ID",
-                        "name": "commentId",
-                        "in": "path",
-                        "required": true
+                        "description": "검토 상태 (pending, approved, rejected, spam)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "게시물 ID",
+                        "name": "postId",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "삭제 성공 메시지",
+                        "description": "댓글 목록",
                         "schema": {
                             "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "additionalProperties": true
                         }
                     },
                     "400": {
@@ -121,12 +130,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "댓글을 찾을 수 없음",
-                        "schema": {
-                            "$ref": "#/definitions/handler.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "서버 오류",
                         "schema": {
@@ -136,38 +139,43 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/images": {
+        "/admin/comments/moderate": {
             "post": {
                 "security": [
                     {
                         "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
                     }
                 ],
-                "description": "블로그에 표시할 이미지를 업로드합니다 (관리자 전용)",
+                "description": "댓글들을 승인(approve), 거절(reject), 스팸 처리(spam)합니다 (관리자 전용)\n승인된 댓글만 공개되며, 존재하지 않는 댓글 ID는 notFound로 반환합니다",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "이미지"
+                    "댓글"
                 ],
-                "summary": "이미지 업로드",
+                "summary": "댓글 일괄 검토",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "이미지 파일",
-                        "name": "image",
-                        "in": "formData",
-                        "required": true
+                        "description": "검토할 댓글과 동작",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ModerateCommentsRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "업로드 성공",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.UploadImageResponse"
+                            "$ref": "#/definitions/handler.ModerateCommentsResponse"
                         }
                     },
                     "400": {
@@ -177,7 +185,7 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "인증되지 않은 요청",
+                        "description": "인증 실패",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
@@ -191,14 +199,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/posts": {
-            "post": {
+        "/admin/comments/{commentId}": {
+            "delete": {
                 "security": [
                     {
                         "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
                     }
                 ],
-                "description": "새 블로그 게시물을 작성합니다 (관리자 전용)",
+                "description": "블로그 댓글을 삭제합니다 (관리자 전용)\n답글이 있는 댓글은 답글을 유지하도록 작성자와 내용만 지우고 삭제 표시합니다",
                 "consumes": [
                     "application/json"
                 ],
@@ -206,25 +217,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "게시물"
+                    "댓글"
                 ],
-                "summary": "게시물 작성",
+                "summary": "댓글 삭제",
                 "parameters": [
                     {
-                        "description": "게시물 정보",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.CreatePostRequest"
-                        }
+                        "type": "string",
+                        "description": "댓글 ID",
+                        "name": "commentId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "삭제 성공 메시지",
                         "schema": {
-                            "$ref": "#/definitions/model.Post"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "400": {
@@ -239,6 +251,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "댓글을 찾을 수 없음",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "서버 오류",
                         "schema": {
@@ -248,47 +266,41 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/posts/{id}": {
-            "put": {
+        "/admin/images": {
+            "post": {
                 "security": [
                     {
                         "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
                     }
                 ],
-                "description": "기존 블로그 게시물을 수정합니다 (관리자 전용)",
+                "description": "블로그에 표시할 이미지를 업로드합니다 (관리자 전용)",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "게시물"
+                    "이미지"
                 ],
-                "summary": "게시물 수정",
+                "summary": "이미지 업로드",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "게시물 ID",
-                        "name": "id",
-                        "in": "path",
+                        "type": "file",
+                        "description": "이미지 파일",
+                        "name": "image",
+                        "in": "formData",
                         "required": true
-                    },
-                    {
-                        "description": "수정할 게시물 정보",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.UpdatePostRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "업로드 성공",
                         "schema": {
-                            "$ref": "#/definitions/model.Post"
+                            "$ref": "#/definitions/model.UploadImageResponse"
                         }
                     },
                     "400": {
@@ -298,13 +310,7 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "인증 실패",
-                        "schema": {
-                            "$ref": "#/definitions/handler.ErrorResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "게시물을 찾을 수 없음",
+                        "description": "인증되지 않은 요청",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
@@ -316,14 +322,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/admin/me": {
+            "get": {
                 "security": [
                     {
                         "AdminAuth": []
                     }
                 ],
-                "description": "블로그 게시물과 관련 댓글을 삭제합니다 (관리자 전용)",
+                "description": "로그인한 관리자 계정과 역할을 조회합니다",
                 "consumes": [
                     "application/json"
                 ],
@@ -331,32 +339,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "게시물"
-                ],
-                "summary": "게시물 삭제",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "게시물 ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "사용자"
                 ],
+                "summary": "내 계정 조회",
                 "responses": {
                     "200": {
-                        "description": "삭제 성공 메시지",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
-                    "400": {
-                        "description": "잘못된 요청",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.ErrorResponse"
+                            "$ref": "#/definitions/model.User"
                         }
                     },
                     "401": {
@@ -365,12 +355,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "게시물을 찾을 수 없음",
-                        "schema": {
-                            "$ref": "#/definitions/handler.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "서버 오류",
                         "schema": {
@@ -380,9 +364,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/categories": {
-            "get": {
-                "description": "블로그에 등록된 모든 카테고리를 순서대로 조회합니다",
+        "/admin/me/totp": {
+            "post": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    }
+                ],
+                "description": "새 TOTP 비밀 키를 발급합니다. 인증 앱에 등록한 뒤 /admin/me/totp/confirm으로 코드를 확인해야 사용이 시작됩니다",
                 "consumes": [
                     "application/json"
                 ],
@@ -390,14 +379,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "카테고리"
+                    "인증"
                 ],
-                "summary": "카테고리 목록 조회",
+                "summary": "2단계 인증 등록 시작",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GetCategoriesResponse"
+                            "$ref": "#/definitions/handler.TOTPEnrollResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "이미 2단계 인증 사용 중",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
                     "500": {
@@ -407,11 +408,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/comments/{id}": {
-            "get": {
-                "description": "특정 게시물에 작성된 댓글 목록을 조회합니다",
+            },
+            "delete": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    }
+                ],
+                "description": "인증 앱의 코드 또는 복구 코드를 확인하고 2단계 인증을 해제합니다\n실패는 로그인 실패와 같이 제한됩니다",
                 "consumes": [
                     "application/json"
                 ],
@@ -419,28 +423,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "댓글"
+                    "인증"
                 ],
-                "summary": "게시물 댓글 조회",
+                "summary": "2단계 인증 해제",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "게시물 ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "인증 코드",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.TOTPCodeRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "댓글 목록",
+                        "description": "해제 성공",
                         "schema": {
                             "type": "object",
-                            "additionalProperties": true
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "400": {
-                        "description": "잘못된 요청",
+                        "description": "잘못된 요청 또는 코드 불일치",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "로그인 잠금 (Retry-After 헤더 참고)",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
@@ -454,9 +474,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/comments/{postId}": {
+        "/admin/me/totp/confirm": {
             "post": {
-                "description": "특정 게시물에 새 댓글을 등록합니다",
+                "security": [
+                    {
+                        "AdminAuth": []
+                    }
+                ],
+                "description": "인증 앱의 코드로 등록을 확인하고 2단계 인증을 사용합니다. 응답의 복구 코드는 다시 조회할 수 없습니다\n실패는 로그인 실패와 같이 제한됩니다",
                 "consumes": [
                     "application/json"
                 ],
@@ -464,42 +489,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "댓글"
+                    "인증"
                 ],
-                "summary": "댓글 등록",
+                "summary": "2단계 인증 등록 완료",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "게시물 ID",
-                        "name": "postId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "댓글 정보",
+                        "description": "인증 앱의 코드",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.CreateCommentRequest"
+                            "$ref": "#/definitions/handler.TOTPCodeRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Comment"
+                            "$ref": "#/definitions/handler.TOTPConfirmResponse"
                         }
                     },
                     "400": {
-                        "description": "잘못된 요청",
+                        "description": "잘못된 요청 또는 코드 불일치",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "게시물을 찾을 수 없음",
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "이미 2단계 인증 사용 중",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "로그인 잠금 (Retry-After 헤더 참고)",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
@@ -513,9 +543,119 @@ const docTemplate = `{
                 }
             }
         },
-        "/login": {
+        "/admin/posts": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "초안, 예약, 보관 상태를 포함한 게시물 목록을 조회합니다 (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "게시물"
+                ],
+                "summary": "관리자 게시물 목록 조회",
+                "parameters": [
+                    {
+                        "enum": [
+                            "draft",
+                            "scheduled",
+                            "published",
+                            "archived"
+                        ],
+                        "type": "string",
+                        "description": "상태 필터 (생략 시 전체)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "카테고리 필터",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "태그 필터",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "newest",
+                            "oldest",
+                            "updated"
+                        ],
+                        "type": "string",
+                        "description": "정렬 방식 (기본값: newest)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "페이지 번호 (기본값: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "페이지 크기 (기본값: 10, 최대: 100)",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "이전 응답의 nextCursor (지정 시 page는 무시)",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GetPostsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
             "post": {
-                "description": "블로그 관리자 로그인 API",
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "새 블로그 게시물을 작성합니다 (관리자 전용)",
                 "consumes": [
                     "application/json"
                 ],
@@ -523,28 +663,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "인증"
+                    "게시물"
                 ],
-                "summary": "관리자 로그인",
+                "summary": "게시물 작성",
                 "parameters": [
                     {
-                        "description": "로그인 정보",
+                        "description": "게시물 정보",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.LoginRequest"
+                            "$ref": "#/definitions/handler.CreatePostRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "로그인 성공",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.Post"
                         }
                     },
                     "400": {
@@ -554,7 +691,7 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "로그인 실패",
+                        "description": "인증 실패",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
@@ -568,9 +705,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts": {
+        "/admin/posts/{id}": {
             "get": {
-                "description": "블로그 게시물 목록을 페이지네이션하여 조회합니다",
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "공개 상태와 관계없이 게시물 상세 정보를 조회합니다 (관리자 전용)",
                 "consumes": [
                     "application/json"
                 ],
@@ -580,32 +725,45 @@ const docTemplate = `{
                 "tags": [
                     "게시물"
                 ],
-                "summary": "게시물 목록 조회",
+                "summary": "관리자 게시물 상세 조회",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "카테고리 필터",
-                        "name": "category",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "페이지 번호 (기본값: 1)",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "페이지 크기 (기본값: 10)",
-                        "name": "pageSize",
-                        "in": "query"
+                        "description": "게시물 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GetPostsResponse"
+                            "$ref": "#/definitions/model.Post"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "게시물 버전 (수정 시 If-Match로 전달)"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "게시물을 찾을 수 없음",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
                     "500": {
@@ -615,11 +773,17 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/posts/{id}": {
-            "get": {
-                "description": "특정 ID의 게시물 상세 정보를 조회합니다",
+            },
+            "put": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "기존 블로그 게시물을 수정하고 수정 결과를 리비전으로 기록합니다 (관리자 전용)\nIf-Match에 조회 시 받은 ETag를 전달하면 그 사이 다른 곳에서 수정된 경우 412로 거부합니다",
                 "consumes": [
                     "application/json"
                 ],
@@ -629,7 +793,7 @@ const docTemplate = `{
                 "tags": [
                     "게시물"
                 ],
-                "summary": "게시물 상세 조회",
+                "summary": "게시물 수정",
                 "parameters": [
                     {
                         "type": "string",
@@ -637,6 +801,21 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "게시물 ETag (예: \\",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "수정할 게시물 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.UpdatePostRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -644,6 +823,12 @@ const docTemplate = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/model.Post"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "수정된 게시물 버전"
+                            }
                         }
                     },
                     "400": {
@@ -652,12 +837,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
                     "404": {
                         "description": "게시물을 찾을 수 없음",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
+                    "412": {
+                        "description": "다른 곳에서 먼저 수정됨",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "서버 오류",
                         "schema": {
@@ -665,54 +862,2130 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "handler.APIError": {
-            "type": "object",
-            "properties": {
-                "code": {
-                    "description": "오류 코드",
-                    "type": "string",
-                    "example": "BAD_REQUEST"
-                },
-                "message": {
-                    "description": "오류 메시지",
-                    "type": "string",
-                    "example": "잘못된 요청입니다"
-                }
-            }
-        },
-        "handler.CreateCommentRequest": {
-            "type": "object",
-            "required": [
-                "content",
-                "nickname"
-            ],
-            "properties": {
-                "content": {
-                    "description": "댓글 내용",
-                    "type": "string",
-                    "example": "좋은 글이네요!"
-                },
-                "nickname": {
-                    "description": "닉네임",
-                    "type": "string",
-                    "example": "익명사용자"
-                }
-            }
-        },
-        "handler.CreatePostRequest": {
-            "type": "object",
-            "required": [
-                "category",
-                "content",
-                "summary",
-                "title"
-            ],
-            "properties": {
-                "category": {
+            },
+            "delete": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "블로그 게시물과 관련 댓글, 리비전을 삭제합니다 (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "게시물"
+                ],
+                "summary": "게시물 삭제",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "게시물 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "삭제 성공 메시지",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "게시물을 찾을 수 없음",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/posts/{id}/revisions": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "게시물의 저장 이력을 최신순으로 조회합니다. 본문은 포함하지 않습니다 (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "게시물"
+                ],
+                "summary": "게시물 리비전 목록 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "게시물 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "리비전 목록",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "게시물을 찾을 수 없음",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/posts/{id}/revisions/{rev}/diff": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "지정한 리비전과 현재 게시물 본문의 줄 단위 unified diff를 반환합니다 (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "게시물"
+                ],
+                "summary": "게시물 리비전 비교",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "게시물 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "리비전 번호",
+                        "name": "rev",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.PostRevisionDiffResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 리비전 번호",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "게시물 또는 리비전을 찾을 수 없음",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/posts/{id}/revisions/{rev}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "게시물의 제목, 본문, 요약, 카테고리를 지정한 리비전으로 되돌립니다.\n복원 결과는 새 리비전으로 기록됩니다 (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "게시물"
+                ],
+                "summary": "게시물 리비전 복원",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "게시물 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "복원할 리비전 번호",
+                        "name": "rev",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Post"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 리비전 번호",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "게시물 또는 리비전을 찾을 수 없음",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/posts/{id}/status": {
+            "put": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "게시물을 초안, 예약, 발행, 보관 상태로 변경합니다 (관리자 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "게시물"
+                ],
+                "summary": "게시물 상태 변경",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "게시물 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "변경할 상태 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.UpdatePostStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Post"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "변경된 게시물 버전"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청 또는 허용되지 않는 상태 전환",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "게시물을 찾을 수 없음",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/sessions": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    }
+                ],
+                "description": "로그인한 계정의 유효한 로그인 세션 목록을 조회합니다 (IP, 브라우저, 로그인 시간 포함)\nowner는 username 파라미터로 다른 계정의 세션을 조회할 수 있습니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "인증"
+                ],
+                "summary": "로그인 세션 목록 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "조회할 계정 (owner 전용)",
+                        "name": "username",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GetSessionsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/sessions/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    }
+                ],
+                "description": "로그인 세션을 폐기합니다. 폐기한 세션은 다음 요청부터 인증에 실패합니다\n자신의 세션만 폐기할 수 있으며, owner는 모든 계정의 세션을 폐기할 수 있습니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "인증"
+                ],
+                "summary": "로그인 세션 폐기",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "세션 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "폐기 성공",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "세션 없음",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/tags/{tag}": {
+            "put": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "태그가 달린 모든 게시물에서 태그 이름을 변경합니다 (관리자 전용)\n새 이름이 이미 사용 중이면 409를 반환하며, 이 경우 병합을 사용합니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "태그"
+                ],
+                "summary": "태그 이름 변경",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "기존 태그",
+                        "name": "tag",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "새 태그 이름",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.RenameTagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.UpdateTagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "태그를 찾을 수 없음",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "이미 사용 중인 태그",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/tags/{tag}/merge": {
+            "post": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "태그가 달린 모든 게시물에서 태그를 대상 태그로 바꿉니다 (관리자 전용)\n두 태그가 모두 달린 게시물은 대상 태그 하나만 남습니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "태그"
+                ],
+                "summary": "태그 병합",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "병합할 태그",
+                        "name": "tag",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "병합 대상 태그",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.MergeTagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.UpdateTagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "태그를 찾을 수 없음",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/tokens": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    }
+                ],
+                "description": "로그인한 계정이 발급한 API 토큰 목록을 조회합니다 (토큰 값은 포함하지 않음)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "인증"
+                ],
+                "summary": "API 토큰 목록 조회",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GetAPITokensResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    }
+                ],
+                "description": "자동화와 CI 배포에 사용할 API 토큰을 발급합니다. 토큰 값은 응답에서 한 번만 확인할 수 있습니다\n토큰은 Authorization: Bearer 헤더로 사용하며, 발급한 계정의 역할이 허용하는 작업 중 권한 범위에 포함된 작업만 할 수 있습니다\n권한 범위: posts:read, posts:write, images:write, comments:read, comments:write, taxonomy:write",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "인증"
+                ],
+                "summary": "API 토큰 발급",
+                "parameters": [
+                    {
+                        "description": "토큰 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateAPITokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateAPITokenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/tokens/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    }
+                ],
+                "description": "로그인한 계정이 발급한 API 토큰을 폐기합니다. owner는 모든 계정의 토큰을 폐기할 수 있습니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "인증"
+                ],
+                "summary": "API 토큰 폐기",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "토큰 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "폐기 성공",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "토큰을 찾을 수 없음",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/users": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    }
+                ],
+                "description": "모든 관리자 계정과 역할을 조회합니다 (owner 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "사용자"
+                ],
+                "summary": "관리자 계정 목록 조회",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GetUsersResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    }
+                ],
+                "description": "새 관리자 계정을 생성합니다 (owner 전용)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "사용자"
+                ],
+                "summary": "관리자 계정 생성",
+                "parameters": [
+                    {
+                        "description": "계정 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.User"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "이미 존재하는 사용자 이름",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/users/{username}": {
+            "put": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    }
+                ],
+                "description": "관리자 계정의 역할이나 비밀번호를 변경하거나 2단계 인증을 해제합니다 (owner 전용)\n마지막 owner 계정의 역할은 변경할 수 없습니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "사용자"
+                ],
+                "summary": "관리자 계정 수정",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "사용자 이름",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "변경할 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.UpdateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.User"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "계정을 찾을 수 없음",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    }
+                ],
+                "description": "관리자 계정과 계정이 발급한 API 토큰을 삭제합니다 (owner 전용). 자기 자신과 마지막 owner 계정은 삭제할 수 없습니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "사용자"
+                ],
+                "summary": "관리자 계정 삭제",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "사용자 이름",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "삭제 성공",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "계정을 찾을 수 없음",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/atom.xml": {
+            "get": {
+                "description": "최신 발행 게시물 20개를 Atom 1.0 형식으로 제공합니다\nfull=true이면 본문을 HTML로 변환하여 content에 포함합니다\nETag, Last-Modified를 제공하며 If-None-Match, If-Modified-Since 조건이 맞으면 304를 반환합니다",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "피드"
+                ],
+                "summary": "Atom 피드",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "본문 포함 여부 (기본값: false)",
+                        "name": "full",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Atom 1.0 문서",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "변경 없음",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/categories": {
+            "get": {
+                "description": "블로그에 등록된 모든 카테고리를 순서대로 조회합니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "카테고리"
+                ],
+                "summary": "카테고리 목록 조회",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GetCategoriesResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/category/{name}/atom.xml": {
+            "get": {
+                "description": "최신 발행 게시물 20개를 Atom 1.0 형식으로 제공합니다\nfull=true이면 본문을 HTML로 변환하여 content에 포함합니다\nETag, Last-Modified를 제공하며 If-None-Match, If-Modified-Since 조건이 맞으면 304를 반환합니다",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "피드"
+                ],
+                "summary": "Atom 피드",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "카테고리 (카테고리별 피드)",
+                        "name": "name",
+                        "in": "path"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "본문 포함 여부 (기본값: false)",
+                        "name": "full",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Atom 1.0 문서",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "변경 없음",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/category/{name}/feed.json": {
+            "get": {
+                "description": "최신 발행 게시물 20개를 JSON Feed 1.1 형식으로 제공합니다\nfull=true이면 본문을 HTML로 변환하여 content_html에 포함하고, 아니면 요약을 content_text로 제공합니다\nETag, Last-Modified를 제공하며 If-None-Match, If-Modified-Since 조건이 맞으면 304를 반환합니다",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "피드"
+                ],
+                "summary": "JSON 피드",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "카테고리 (카테고리별 피드)",
+                        "name": "name",
+                        "in": "path"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "본문 포함 여부 (기본값: false)",
+                        "name": "full",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "JSON Feed 1.1 문서",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "변경 없음",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/category/{name}/feed.xml": {
+            "get": {
+                "description": "최신 발행 게시물 20개를 RSS 2.0 형식으로 제공합니다\nfull=true이면 본문을 HTML로 변환하여 content:encoded에 포함합니다\nETag, Last-Modified를 제공하며 If-None-Match, If-Modified-Since 조건이 맞으면 304를 반환합니다",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "피드"
+                ],
+                "summary": "RSS 피드",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "카테고리 (카테고리별 피드)",
+                        "name": "name",
+                        "in": "path"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "본문 포함 여부 (기본값: false)",
+                        "name": "full",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "RSS 2.0 문서",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "변경 없음",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/comments/{id}": {
+            "get": {
+                "description": "특정 게시물에 작성된 댓글을 답글 트리로 조회합니다\n승인된 댓글만 공개하며, 답글이 남아 있는 삭제되거나 공개되지 않은 댓글은 작성자와 내용 없이 deleted=true로 표시됩니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "댓글"
+                ],
+                "summary": "게시물 댓글 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "게시물 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "댓글 트리",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GetCommentsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/comments/{id}/form": {
+            "get": {
+                "description": "댓글 작성 폼을 열 때 호출해 폼 토큰과 스팸 방지용 숨김 필드 이름을 받습니다\n폼 토큰은 게시물별로 발급되며, 발급 직후 제출하거나 만료된 토큰으로 제출한 댓글은 거부될 수 있습니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "댓글"
+                ],
+                "summary": "댓글 작성 폼 정보 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "게시물 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CommentFormResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "게시물을 찾을 수 없음",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/comments/{postId}": {
+            "post": {
+                "description": "특정 게시물에 새 댓글을 등록합니다\nparentCommentId를 지정하면 해당 댓글의 답글로 등록하며, 최상위 댓글을 포함해 3단계까지 중첩할 수 있습니다\n댓글 검토가 켜져 있으면 pending 상태로 등록되어 관리자가 승인한 뒤 공개됩니다\n스팸 필터가 스팸으로 판정한 댓글은 spam 상태로 등록되어 공개되지 않으며, 봇으로 판정된 요청은 거부됩니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "댓글"
+                ],
+                "summary": "댓글 등록",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "게시물 ID",
+                        "name": "postId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "댓글 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateCommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.Comment"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "게시물 또는 부모 댓글을 찾을 수 없음",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "요청 수 제한 초과 (Retry-After 헤더 참고)",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/csrf": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    }
+                ],
+                "description": "로그인 세션의 CSRF 토큰을 조회합니다. 토큰은 로그인할 때 발급되며 로그아웃할 때까지 유지됩니다\n로그인 세션으로 /admin에 POST, PUT, DELETE 요청을 보낼 때 X-CSRF-Token 헤더에 담아야 합니다 (API 토큰 요청은 제외)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "인증"
+                ],
+                "summary": "CSRF 토큰 조회",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CSRFTokenResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/feed.json": {
+            "get": {
+                "description": "최신 발행 게시물 20개를 JSON Feed 1.1 형식으로 제공합니다\nfull=true이면 본문을 HTML로 변환하여 content_html에 포함하고, 아니면 요약을 content_text로 제공합니다\nETag, Last-Modified를 제공하며 If-None-Match, If-Modified-Since 조건이 맞으면 304를 반환합니다",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "피드"
+                ],
+                "summary": "JSON 피드",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "본문 포함 여부 (기본값: false)",
+                        "name": "full",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "JSON Feed 1.1 문서",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "변경 없음",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/feed.xml": {
+            "get": {
+                "description": "최신 발행 게시물 20개를 RSS 2.0 형식으로 제공합니다\nfull=true이면 본문을 HTML로 변환하여 content:encoded에 포함합니다\nETag, Last-Modified를 제공하며 If-None-Match, If-Modified-Since 조건이 맞으면 304를 반환합니다",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "피드"
+                ],
+                "summary": "RSS 피드",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "본문 포함 여부 (기본값: false)",
+                        "name": "full",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "RSS 2.0 문서",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "변경 없음",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/login": {
+            "post": {
+                "description": "블로그 관리자 로그인 API\n2단계 인증을 사용하는 계정은 totpRequired가 true로 반환되며, /login/totp로 인증 코드를 확인해야 로그인이 완료됩니다\n로그인이 완료되면 csrfToken을 반환하며, 이후 /admin 변경 요청(POST, PUT, DELETE)의 X-CSRF-Token 헤더에 담아야 합니다\n로그인에 연속으로 실패하면 다음 시도까지 점점 긴 지연이 생기고, 실패가 계속되면 일정 시간 잠깁니다 (사용자 이름, IP별)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "인증"
+                ],
+                "summary": "관리자 로그인",
+                "parameters": [
+                    {
+                        "description": "로그인 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "로그인 성공 또는 2단계 인증 필요 (totpRequired)",
+                        "schema": {
+                            "$ref": "#/definitions/handler.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "로그인 실패",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "요청 수 제한 초과 또는 로그인 잠금 (Retry-After 헤더 참고)",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/login/totp": {
+            "post": {
+                "description": "비밀번호 확인 후 totpRequired 응답을 받은 세션에서 인증 앱의 코드 또는 복구 코드로 로그인을 완료합니다\n비밀번호 확인 후 5분 안에 요청해야 하며, 실패는 로그인 실패와 같이 제한됩니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "인증"
+                ],
+                "summary": "로그인 2단계 인증",
+                "parameters": [
+                    {
+                        "description": "인증 코드",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.TOTPCodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "로그인 성공",
+                        "schema": {
+                            "$ref": "#/definitions/handler.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패 또는 비밀번호 확인 필요",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "요청 수 제한 초과 또는 로그인 잠금 (Retry-After 헤더 참고)",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/logout": {
+            "post": {
+                "description": "현재 로그인 세션을 서버에서 삭제하고 세션 쿠키를 지웁니다\n로그인하지 않은 상태에서 요청해도 성공으로 응답합니다",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "인증"
+                ],
+                "summary": "로그아웃",
+                "responses": {
+                    "200": {
+                        "description": "로그아웃 성공",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts": {
+            "get": {
+                "description": "블로그 게시물 목록을 페이지네이션하여 조회합니다\ncursor를 지정하면 해당 위치부터 조회하며, 이때 totalCount와 totalPages는 계산하지 않습니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "게시물"
+                ],
+                "summary": "게시물 목록 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "카테고리 필터",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "태그 필터",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "newest",
+                            "oldest",
+                            "updated"
+                        ],
+                        "type": "string",
+                        "description": "정렬 방식 (기본값: newest)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "페이지 번호 (기본값: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "페이지 크기 (기본값: 10, 최대: 100)",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "이전 응답의 nextCursor (지정 시 page는 무시)",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GetPostsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "유효하지 않은 커서, 정렬 방식 또는 태그",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{id}": {
+            "get": {
+                "description": "특정 ID의 게시물 상세 정보를 조회합니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "게시물"
+                ],
+                "summary": "게시물 상세 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "게시물 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Post"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "게시물 버전 (수정 시 If-Match로 전달)"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "게시물을 찾을 수 없음",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/search": {
+            "get": {
+                "description": "제목, 요약, 본문에서 검색어를 찾아 관련도순으로 반환합니다\n한글은 조사를 제거하고 2글자 단위로 비교하며, 검색어의 모든 단어를 포함하는 게시물만 반환합니다\n각 결과의 snippet은 HTML 이스케이프된 본문 발췌이며 검색어는 \u003cmark\u003e로 감싸져 있습니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "게시물"
+                ],
+                "summary": "게시물 검색",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "검색어",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "페이지 번호 (기본값: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "페이지 크기 (기본값: 10, 최대: 100)",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.GetPostsResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "posts": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/search.Result"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "검색어 누락 또는 너무 긴 검색어",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sitemap.xml": {
+            "get": {
+                "description": "메인 페이지, 카테고리, 태그, 발행된 게시물 URL과 게시물 본문의 이미지를 포함한 sitemap.xml을 제공합니다\nURL이 50,000개를 넘으면 /sitemaps/{page}.xml 파일을 가리키는 사이트맵 색인을 반환합니다\n생성 결과는 게시물이나 카테고리가 바뀔 때까지 캐시됩니다",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "사이트맵"
+                ],
+                "summary": "사이트맵",
+                "responses": {
+                    "200": {
+                        "description": "사이트맵 또는 사이트맵 색인",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "변경 없음",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sitemaps/{page}": {
+            "get": {
+                "description": "사이트맵 색인이 가리키는 n번째 사이트맵 파일을 제공합니다",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "사이트맵"
+                ],
+                "summary": "사이트맵 파일",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "사이트맵 파일 (예: 1.xml)",
+                        "name": "page",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "사이트맵",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "변경 없음",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "사이트맵 파일 없음",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags": {
+            "get": {
+                "description": "발행된 게시물에 달린 태그와 태그별 게시물 수를 게시물 수가 많은 순으로 조회합니다",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "태그"
+                ],
+                "summary": "태그 목록 조회",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GetTagsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "handler.APIError": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "오류 코드",
+                    "type": "string",
+                    "example": "BAD_REQUEST"
+                },
+                "message": {
+                    "description": "오류 메시지",
+                    "type": "string",
+                    "example": "잘못된 요청입니다"
+                }
+            }
+        },
+        "handler.CSRFTokenResponse": {
+            "type": "object",
+            "properties": {
+                "csrfToken": {
+                    "description": "변경 요청의 X-CSRF-Token 헤더에 보낼 토큰",
+                    "type": "string",
+                    "example": "Q2hhbmdlTWUhQ2hhbmdlTWUhQ2hhbmdlTWUhQ2hhbmdlTWU"
+                }
+            }
+        },
+        "handler.CommentFormResponse": {
+            "type": "object",
+            "properties": {
+                "formToken": {
+                    "description": "댓글 등록 시 함께 보낼 폼 토큰",
+                    "type": "string",
+                    "example": "1700000000.c2lnbmF0dXJl"
+                },
+                "honeypotField": {
+                    "description": "화면에 숨기고 비워 둬야 하는 입력 필드 이름",
+                    "type": "string",
+                    "example": "website"
+                },
+                "minDelaySeconds": {
+                    "description": "폼 토큰 발급 후 댓글 등록까지 기다려야 하는 최소 시간 (초)",
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "handler.CreateAPITokenRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "scopes"
+            ],
+            "properties": {
+                "expiresInDays": {
+                    "description": "유효 기간 (일, 기본 90일, 최대 365일)",
+                    "type": "integer",
+                    "example": 90
+                },
+                "name": {
+                    "description": "토큰 이름 (용도)",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "GitHub Actions 배포"
+                },
+                "scopes": {
+                    "description": "권한 범위",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "posts:write",
+                        "images:write"
+                    ]
+                }
+            }
+        },
+        "handler.CreateAPITokenResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "description": "발급 시간",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "expiresAt": {
+                    "description": "만료 시간",
+                    "type": "string",
+                    "example": "2023-04-01T00:00:00Z"
+                },
+                "lastUsedAt": {
+                    "description": "마지막 사용 시간",
+                    "type": "string",
+                    "example": "2023-01-02T00:00:00Z"
+                },
+                "name": {
+                    "description": "토큰 이름 (용도)",
+                    "type": "string",
+                    "example": "GitHub Actions 배포"
+                },
+                "scopes": {
+                    "description": "권한 범위",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "posts:write",
+                        "images:write"
+                    ]
+                },
+                "token": {
+                    "description": "토큰 값 (다시 조회할 수 없음)",
+                    "type": "string",
+                    "example": "bsk_3f2a9c1d7e5b4a60_Q2hhbmdlTWUhQ2hhbmdlTWUhQ2hhbmdlTWUh"
+                },
+                "tokenId": {
+                    "description": "토큰 ID (토큰 값에 포함)",
+                    "type": "string",
+                    "example": "3f2a9c1d7e5b4a60"
+                },
+                "username": {
+                    "description": "발급한 계정",
+                    "type": "string",
+                    "example": "editor1"
+                }
+            }
+        },
+        "handler.CreateCommentRequest": {
+            "type": "object",
+            "required": [
+                "content",
+                "nickname"
+            ],
+            "properties": {
+                "content": {
+                    "description": "댓글 내용",
+                    "type": "string",
+                    "example": "좋은 글이네요!"
+                },
+                "formToken": {
+                    "description": "댓글 작성 폼 토큰 (GET /comments/{id}/form에서 발급)",
+                    "type": "string",
+                    "example": "1700000000.c2lnbmF0dXJl"
+                },
+                "nickname": {
+                    "description": "닉네임",
+                    "type": "string",
+                    "example": "익명사용자"
+                },
+                "parentCommentId": {
+                    "description": "답글을 달 댓글 ID (선택)",
+                    "type": "string",
+                    "example": "comment-100"
+                },
+                "website": {
+                    "description": "스팸 방지용 숨김 필드 (항상 비워 둠)",
+                    "type": "string",
+                    "example": ""
+                }
+            }
+        },
+        "handler.CreatePostRequest": {
+            "type": "object",
+            "required": [
+                "category",
+                "content",
+                "summary",
+                "title"
+            ],
+            "properties": {
+                "category": {
                     "description": "카테고리",
                     "type": "string",
                     "example": "technology"
@@ -722,11 +2995,32 @@ const docTemplate = `{
                     "type": "string",
                     "example": "게시물 본문 내용..."
                 },
+                "publishAt": {
+                    "description": "발행 예정 시간 (scheduled인 경우 필수)",
+                    "type": "string",
+                    "example": "2030-01-01T09:00:00+09:00"
+                },
+                "status": {
+                    "description": "공개 상태 (기본값: published)",
+                    "type": "string",
+                    "example": "draft"
+                },
                 "summary": {
                     "description": "게시물 요약",
                     "type": "string",
                     "example": "게시물 요약..."
                 },
+                "tags": {
+                    "description": "태그 (최대 10개, 소문자로 정규화)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "golang",
+                        "concurrency"
+                    ]
+                },
                 "title": {
                     "description": "게시물 제목",
                     "type": "string",
@@ -734,6 +3028,31 @@ const docTemplate = `{
                 }
             }
         },
+        "handler.CreateUserRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "role",
+                "username"
+            ],
+            "properties": {
+                "password": {
+                    "description": "비밀번호 (8자 이상)",
+                    "type": "string",
+                    "example": "********"
+                },
+                "role": {
+                    "description": "역할 (owner, editor, moderator)",
+                    "type": "string",
+                    "example": "editor"
+                },
+                "username": {
+                    "description": "사용자 이름 (영문 소문자로 시작하는 3~32자의 영문 소문자, 숫자, '-', '_', '.')",
+                    "type": "string",
+                    "example": "editor1"
+                }
+            }
+        },
         "handler.ErrorResponse": {
             "type": "object",
             "properties": {
@@ -752,42 +3071,322 @@ const docTemplate = `{
                 }
             }
         },
-        "handler.GetCategoriesResponse": {
+        "handler.GetAPITokensResponse": {
+            "type": "object",
+            "properties": {
+                "tokens": {
+                    "description": "발급 시간순 토큰 목록 (토큰 값은 포함하지 않음)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.APIToken"
+                    }
+                }
+            }
+        },
+        "handler.GetCategoriesResponse": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "description": "카테고리 목록",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Category"
+                    }
+                }
+            }
+        },
+        "handler.GetCommentsResponse": {
+            "type": "object",
+            "properties": {
+                "comments": {
+                    "description": "등록순 최상위 댓글과 답글 트리",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CommentThread"
+                    }
+                }
+            }
+        },
+        "handler.GetPostsResponse": {
+            "type": "object",
+            "properties": {
+                "currentPage": {
+                    "description": "현재 페이지",
+                    "type": "integer",
+                    "example": 1
+                },
+                "nextCursor": {
+                    "description": "다음 페이지 커서 (마지막 페이지면 생략)",
+                    "type": "string",
+                    "example": "eyJzIjoiIn0.c2ln"
+                },
+                "posts": {
+                    "description": "게시물 목록",
+                    "type": "array",
+                    "items": {
+                        "type": "object"
+                    }
+                },
+                "totalCount": {
+                    "description": "전체 게시물 수",
+                    "type": "integer",
+                    "example": 100
+                },
+                "totalPages": {
+                    "description": "전체 페이지 수",
+                    "type": "integer",
+                    "example": 10
+                }
+            }
+        },
+        "handler.GetSessionsResponse": {
+            "type": "object",
+            "properties": {
+                "sessions": {
+                    "description": "최근 사용순 로그인 세션 목록 (만료된 세션 제외)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.SessionResponse"
+                    }
+                }
+            }
+        },
+        "handler.GetTagsResponse": {
+            "type": "object",
+            "properties": {
+                "tags": {
+                    "description": "태그 목록 (게시글 수 내림차순)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Tag"
+                    }
+                }
+            }
+        },
+        "handler.GetUsersResponse": {
+            "type": "object",
+            "properties": {
+                "users": {
+                    "description": "사용자 이름순 계정 목록",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.User"
+                    }
+                }
+            }
+        },
+        "handler.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "csrfToken": {
+                    "description": "변경 요청의 X-CSRF-Token 헤더에 보낼 토큰 (로그인 완료 시)",
+                    "type": "string",
+                    "example": "Q2hhbmdlTWUhQ2hhbmdlTWUhQ2hhbmdlTWUhQ2hhbmdlTWU"
+                },
+                "message": {
+                    "description": "결과 메시지",
+                    "type": "string",
+                    "example": "로그인에 성공했습니다"
+                },
+                "role": {
+                    "description": "로그인한 계정의 역할 (로그인 완료 시)",
+                    "type": "string",
+                    "example": "editor"
+                },
+                "totpRequired": {
+                    "description": "2단계 인증 코드 확인 필요 여부",
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "handler.MergeTagRequest": {
+            "type": "object",
+            "required": [
+                "into"
+            ],
+            "properties": {
+                "into": {
+                    "description": "병합 대상 태그",
+                    "type": "string",
+                    "example": "go"
+                }
+            }
+        },
+        "handler.ModerateCommentsRequest": {
+            "type": "object",
+            "required": [
+                "action",
+                "commentIds"
+            ],
+            "properties": {
+                "action": {
+                    "description": "검토 동작 (approve, reject, spam)",
+                    "type": "string",
+                    "example": "approve"
+                },
+                "commentIds": {
+                    "description": "검토할 댓글 ID 목록 (최대 100개)",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "comment-123",
+                        "comment-456"
+                    ]
+                }
+            }
+        },
+        "handler.ModerateCommentsResponse": {
+            "type": "object",
+            "properties": {
+                "notFound": {
+                    "description": "존재하지 않는 댓글 ID",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "comment-456"
+                    ]
+                },
+                "status": {
+                    "description": "변경된 댓글 상태",
+                    "type": "string",
+                    "example": "approved"
+                },
+                "updated": {
+                    "description": "상태를 변경한 댓글 ID",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "comment-123"
+                    ]
+                }
+            }
+        },
+        "handler.PostRevisionDiffResponse": {
+            "type": "object",
+            "properties": {
+                "diff": {
+                    "description": "리비전 → 현재 내용 unified diff (같으면 빈 문자열)",
+                    "type": "string",
+                    "example": "--- revision 3\n+++ current\n@@ -1,1 +1,1 @@\n-이전 내용\n+현재 내용\n"
+                },
+                "postId": {
+                    "description": "게시물 ID",
+                    "type": "string",
+                    "example": "post-123"
+                },
+                "revision": {
+                    "description": "비교한 리비전 번호",
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "handler.RenameTagRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "description": "새 태그 이름",
+                    "type": "string",
+                    "example": "go"
+                }
+            }
+        },
+        "handler.SessionResponse": {
+            "type": "object",
+            "properties": {
+                "current": {
+                    "description": "요청에 사용한 세션 여부",
+                    "type": "boolean",
+                    "example": true
+                },
+                "expiresAt": {
+                    "description": "만료 시간 (사용할 때마다 연장)",
+                    "type": "string",
+                    "example": "2023-01-01T03:00:00Z"
+                },
+                "ip": {
+                    "description": "로그인한 클라이언트 IP",
+                    "type": "string",
+                    "example": "203.0.113.10"
+                },
+                "lastSeenAt": {
+                    "description": "마지막 사용 시간",
+                    "type": "string",
+                    "example": "2023-01-01T01:00:00Z"
+                },
+                "loginTime": {
+                    "description": "로그인 시간",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "sessionId": {
+                    "description": "세션 ID (세션 토큰의 해시)",
+                    "type": "string",
+                    "example": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+                },
+                "userAgent": {
+                    "description": "로그인한 브라우저",
+                    "type": "string",
+                    "example": "Mozilla/5.0"
+                },
+                "username": {
+                    "description": "로그인한 계정 (2단계 인증 대기 중이면 빈 값)",
+                    "type": "string",
+                    "example": "editor1"
+                }
+            }
+        },
+        "handler.TOTPCodeRequest": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "description": "인증 앱의 6자리 코드 또는 복구 코드",
+                    "type": "string",
+                    "example": "123456"
+                }
+            }
+        },
+        "handler.TOTPConfirmResponse": {
             "type": "object",
             "properties": {
-                "categories": {
-                    "description": "카테고리 목록",
+                "recoveryCodes": {
+                    "description": "인증 앱을 사용할 수 없을 때 한 번씩 사용할 수 있는 복구 코드 (다시 조회할 수 없음)",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.Category"
-                    }
+                        "type": "string"
+                    },
+                    "example": [
+                        "abcde-fghij"
+                    ]
                 }
             }
         },
-        "handler.GetPostsResponse": {
+        "handler.TOTPEnrollResponse": {
             "type": "object",
             "properties": {
-                "currentPage": {
-                    "description": "현재 페이지",
-                    "type": "integer",
-                    "example": 1
-                },
-                "posts": {
-                    "description": "게시물 목록",
-                    "type": "array",
-                    "items": {
-                        "type": "object"
-                    }
-                },
-                "totalCount": {
-                    "description": "전체 게시물 수",
-                    "type": "integer",
-                    "example": 100
+                "provisioningUri": {
+                    "description": "QR 코드로 표시할 otpauth URI",
+                    "type": "string",
+                    "example": "otpauth://totp/bumsiku:admin?issuer=bumsiku\u0026secret=JBSWY3DP"
                 },
-                "totalPages": {
-                    "description": "전체 페이지 수",
-                    "type": "integer",
-                    "example": 10
+                "secret": {
+                    "description": "인증 앱에 직접 입력할 비밀 키 (base32)",
+                    "type": "string",
+                    "example": "JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"
                 }
             }
         },
@@ -834,6 +3433,17 @@ const docTemplate = `{
                     "type": "string",
                     "example": "수정된 게시물 요약..."
                 },
+                "tags": {
+                    "description": "태그 (생략하면 기존 태그 유지, 빈 배열이면 모두 제거)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "golang",
+                        "concurrency"
+                    ]
+                },
                 "title": {
                     "description": "게시물 제목",
                     "type": "string",
@@ -841,6 +3451,110 @@ const docTemplate = `{
                 }
             }
         },
+        "handler.UpdatePostStatusRequest": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "publishAt": {
+                    "description": "발행 예정 시간 (scheduled인 경우 필수)",
+                    "type": "string",
+                    "example": "2030-01-01T09:00:00+09:00"
+                },
+                "status": {
+                    "description": "변경할 상태 (draft, scheduled, published, archived)",
+                    "type": "string",
+                    "example": "scheduled"
+                }
+            }
+        },
+        "handler.UpdateTagResponse": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "description": "기존 태그",
+                    "type": "string",
+                    "example": "golang"
+                },
+                "to": {
+                    "description": "변경된 태그",
+                    "type": "string",
+                    "example": "go"
+                },
+                "updatedPosts": {
+                    "description": "태그가 변경된 게시물 수",
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "handler.UpdateUserRequest": {
+            "type": "object",
+            "properties": {
+                "password": {
+                    "description": "변경할 비밀번호 (선택, 8자 이상)",
+                    "type": "string",
+                    "example": "********"
+                },
+                "resetTotp": {
+                    "description": "2단계 인증 해제 (인증 앱과 복구 코드를 모두 잃어버린 경우)",
+                    "type": "boolean",
+                    "example": false
+                },
+                "role": {
+                    "description": "변경할 역할 (선택)",
+                    "type": "string",
+                    "example": "moderator"
+                }
+            }
+        },
+        "model.APIToken": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "description": "발급 시간",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "expiresAt": {
+                    "description": "만료 시간",
+                    "type": "string",
+                    "example": "2023-04-01T00:00:00Z"
+                },
+                "lastUsedAt": {
+                    "description": "마지막 사용 시간",
+                    "type": "string",
+                    "example": "2023-01-02T00:00:00Z"
+                },
+                "name": {
+                    "description": "토큰 이름 (용도)",
+                    "type": "string",
+                    "example": "GitHub Actions 배포"
+                },
+                "scopes": {
+                    "description": "권한 범위",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "posts:write",
+                        "images:write"
+                    ]
+                },
+                "tokenId": {
+                    "description": "토큰 ID (토큰 값에 포함)",
+                    "type": "string",
+                    "example": "3f2a9c1d7e5b4a60"
+                },
+                "username": {
+                    "description": "발급한 계정",
+                    "type": "string",
+                    "example": "editor1"
+                }
+            }
+        },
         "model.Category": {
             "type": "object",
             "properties": {
@@ -879,15 +3593,102 @@ const docTemplate = `{
                     "type": "string",
                     "example": "2023-01-01T00:00:00Z"
                 },
+                "deleted": {
+                    "description": "답글이 있어 내용만 지운 댓글 여부",
+                    "type": "boolean",
+                    "example": false
+                },
+                "depth": {
+                    "description": "중첩 단계 (최상위 댓글은 0)",
+                    "type": "integer",
+                    "example": 1
+                },
+                "moderated": {
+                    "description": "관리자가 검토 상태를 지정했는지 여부 (스팸 필터 판정만 받은 댓글은 false)",
+                    "type": "boolean",
+                    "example": true
+                },
+                "nickname": {
+                    "description": "닉네임",
+                    "type": "string",
+                    "example": "익명사용자"
+                },
+                "parentCommentId": {
+                    "description": "부모 댓글 ID (답글인 경우)",
+                    "type": "string",
+                    "example": "comment-100"
+                },
+                "postId": {
+                    "description": "게시물 ID",
+                    "type": "string",
+                    "example": "post-123"
+                },
+                "status": {
+                    "description": "검토 상태 (pending, approved, rejected, spam)",
+                    "type": "string",
+                    "example": "approved"
+                }
+            }
+        },
+        "model.CommentThread": {
+            "type": "object",
+            "properties": {
+                "commentId": {
+                    "description": "댓글 ID",
+                    "type": "string",
+                    "example": "comment-123"
+                },
+                "content": {
+                    "description": "댓글 내용",
+                    "type": "string",
+                    "example": "댓글 내용입니다."
+                },
+                "createdAt": {
+                    "description": "생성 시간",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "deleted": {
+                    "description": "답글이 있어 내용만 지운 댓글 여부",
+                    "type": "boolean",
+                    "example": false
+                },
+                "depth": {
+                    "description": "중첩 단계 (최상위 댓글은 0)",
+                    "type": "integer",
+                    "example": 1
+                },
+                "moderated": {
+                    "description": "관리자가 검토 상태를 지정했는지 여부 (스팸 필터 판정만 받은 댓글은 false)",
+                    "type": "boolean",
+                    "example": true
+                },
                 "nickname": {
                     "description": "닉네임",
                     "type": "string",
                     "example": "익명사용자"
                 },
+                "parentCommentId": {
+                    "description": "부모 댓글 ID (답글인 경우)",
+                    "type": "string",
+                    "example": "comment-100"
+                },
                 "postId": {
                     "description": "게시물 ID",
                     "type": "string",
                     "example": "post-123"
+                },
+                "replies": {
+                    "description": "등록순 답글",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CommentThread"
+                    }
+                },
+                "status": {
+                    "description": "검토 상태 (pending, approved, rejected, spam)",
+                    "type": "string",
+                    "example": "approved"
                 }
             }
         },
@@ -913,6 +3714,11 @@ const docTemplate = `{
         "model.Post": {
             "type": "object",
             "properties": {
+                "authorId": {
+                    "description": "작성한 관리자 사용자 이름",
+                    "type": "string",
+                    "example": "editor1"
+                },
                 "category": {
                     "description": "카테고리",
                     "type": "string",
@@ -933,11 +3739,32 @@ const docTemplate = `{
                     "type": "string",
                     "example": "post-123"
                 },
+                "publishAt": {
+                    "description": "발행(예정) 시간",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "status": {
+                    "description": "공개 상태 (draft, scheduled, published, archived)",
+                    "type": "string",
+                    "example": "published"
+                },
                 "summary": {
                     "description": "게시물 요약",
                     "type": "string",
                     "example": "게시물 요약..."
                 },
+                "tags": {
+                    "description": "태그 (정규화된 소문자)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "golang",
+                        "concurrency"
+                    ]
+                },
                 "title": {
                     "description": "게시물 제목",
                     "type": "string",
@@ -947,6 +3774,26 @@ const docTemplate = `{
                     "description": "수정 시간",
                     "type": "string",
                     "example": "2023-01-01T00:00:00Z"
+                },
+                "version": {
+                    "description": "수정할 때마다 1씩 증가하는 버전 (ETag)",
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "model.Tag": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "description": "발행된 게시글 수",
+                    "type": "integer",
+                    "example": 12
+                },
+                "tag": {
+                    "description": "태그",
+                    "type": "string",
+                    "example": "golang"
                 }
             }
         },
@@ -979,14 +3826,131 @@ const docTemplate = `{
                     "example": "https://bumsiku-bucket.s3.ap-northeast-2.amazonaws.com/image-uuid.webp"
                 }
             }
+        },
+        "model.User": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "description": "생성 시간",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "role": {
+                    "description": "역할 (owner, editor, moderator)",
+                    "type": "string",
+                    "example": "editor"
+                },
+                "totpEnabled": {
+                    "description": "2단계 인증(TOTP) 사용 여부",
+                    "type": "boolean",
+                    "example": false
+                },
+                "updatedAt": {
+                    "description": "수정 시간",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "username": {
+                    "description": "사용자 이름 (로그인 ID)",
+                    "type": "string",
+                    "example": "editor1"
+                }
+            }
+        },
+        "search.Result": {
+            "type": "object",
+            "properties": {
+                "authorId": {
+                    "description": "작성한 관리자 사용자 이름",
+                    "type": "string",
+                    "example": "editor1"
+                },
+                "category": {
+                    "description": "카테고리",
+                    "type": "string",
+                    "example": "technology"
+                },
+                "content": {
+                    "description": "게시물 내용",
+                    "type": "string",
+                    "example": "게시물 본문 내용..."
+                },
+                "createdAt": {
+                    "description": "생성 시간",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "postId": {
+                    "description": "게시물 ID",
+                    "type": "string",
+                    "example": "post-123"
+                },
+                "publishAt": {
+                    "description": "발행(예정) 시간",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "score": {
+                    "description": "관련도 점수",
+                    "type": "number",
+                    "example": 4.2
+                },
+                "snippet": {
+                    "description": "검색어를 \u003cmark\u003e로 강조한 본문 발췌 (HTML 이스케이프됨)",
+                    "type": "string",
+                    "example": "... \u003cmark\u003e검색어\u003c/mark\u003e가 포함된 본문 ..."
+                },
+                "status": {
+                    "description": "공개 상태 (draft, scheduled, published, archived)",
+                    "type": "string",
+                    "example": "published"
+                },
+                "summary": {
+                    "description": "게시물 요약",
+                    "type": "string",
+                    "example": "게시물 요약..."
+                },
+                "tags": {
+                    "description": "태그 (정규화된 소문자)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "golang",
+                        "concurrency"
+                    ]
+                },
+                "title": {
+                    "description": "게시물 제목",
+                    "type": "string",
+                    "example": "블로그 제목"
+                },
+                "updatedAt": {
+                    "description": "수정 시간",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "version": {
+                    "description": "수정할 때마다 1씩 증가하는 버전 (ETag)",
+                    "type": "integer",
+                    "example": 3
+                }
+            }
         }
     },
     "securityDefinitions": {
         "AdminAuth": {
-            "description": "관리자 인증 세션 쿠키",
+            "description": "관리자 인증 세션 쿠키 (POST, PUT, DELETE 요청은 로그인 또는 /csrf에서 받은 토큰을 X-CSRF-Token 헤더에 함께 보내야 함)",
             "type": "apiKey",
             "name": "loginSession",
             "in": "cookie"
+        },
+        "BearerAuth": {
+            "description": "자동화용 API 토큰 (\"Bearer bsk_...\" 형식, /admin/tokens에서 발급)",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
         }
     }
 }`
@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+)
+
+// APITokenPrefix는 API 토큰 값의 접두사입니다. 로그나 저장소에 유출된 토큰을 찾기 쉽게 합니다.
+const APITokenPrefix = "bsk_"
+
+// API 토큰은 "bsk_<토큰 ID>_<비밀 값>" 형식입니다. 토큰 ID로 저장된 해시를 찾아 비교합니다.
+const (
+	apiTokenIDSize     = 8  // 토큰 ID 크기 (바이트, hex 인코딩)
+	apiTokenSecretSize = 32 // 비밀 값 크기 (바이트, base64url 인코딩)
+)
+
+// GenerateAPIToken은 새 API 토큰 값과 토큰 ID, 저장할 해시를 생성합니다.
+func GenerateAPIToken() (token, tokenID, hash string, err error) {
+	id := make([]byte, apiTokenIDSize)
+	if _, err := rand.Read(id); err != nil {
+		return "", "", "", err
+	}
+	secret := make([]byte, apiTokenSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", "", err
+	}
+
+	tokenID = hex.EncodeToString(id)
+	token = APITokenPrefix + tokenID + "_" + base64.RawURLEncoding.EncodeToString(secret)
+	return token, tokenID, HashAPIToken(token), nil
+}
+
+// ParseAPIToken은 토큰 값에서 토큰 ID를 꺼냅니다. 형식이 맞지 않으면 false를 반환합니다.
+func ParseAPIToken(token string) (string, bool) {
+	if !strings.HasPrefix(token, APITokenPrefix) {
+		return "", false
+	}
+	parts := strings.SplitN(strings.TrimPrefix(token, APITokenPrefix), "_", 2)
+	if len(parts) != 2 || len(parts[0]) != apiTokenIDSize*2 || parts[1] == "" {
+		return "", false
+	}
+	if _, err := hex.DecodeString(parts[0]); err != nil {
+		return "", false
+	}
+	return parts[0], true
+}
+
+// HashAPIToken은 저장할 토큰 해시를 반환합니다.
+// 토큰은 충분히 긴 무작위 값이므로 비밀번호와 달리 느린 해시를 사용하지 않습니다.
+func HashAPIToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// AuthenticateAPIToken은 API 토큰을 확인하고 토큰과 발급한 계정을 반환합니다.
+// 형식이 잘못되었거나, 없거나, 만료되었거나, 계정이 삭제된 토큰이면 nil을 반환합니다.
+func AuthenticateAPIToken(ctx context.Context, tokenRepo repository.APITokenRepositoryInterface, userRepo repository.UserRepositoryInterface, token string, now time.Time) (*model.APIToken, *model.User, error) {
+	tokenID, ok := ParseAPIToken(token)
+	if !ok {
+		return nil, nil, nil
+	}
+
+	stored, err := tokenRepo.GetToken(ctx, tokenID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if stored == nil || subtle.ConstantTimeCompare([]byte(stored.TokenHash), []byte(HashAPIToken(token))) != 1 {
+		return nil, nil, nil
+	}
+	if stored.IsExpired(now) {
+		return nil, nil, nil
+	}
+
+	user, err := userRepo.GetUser(ctx, stored.Username)
+	if err != nil {
+		return nil, nil, err
+	}
+	if user == nil {
+		return nil, nil, nil
+	}
+	return stored, user, nil
+}
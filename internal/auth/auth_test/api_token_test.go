@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"bumsiku/internal/auth"
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// [GIVEN] 새로 생성한 API 토큰
+// [WHEN] ParseAPIToken 호출
+// [THEN] 접두사와 토큰 ID를 포함하고, 형식이 잘못된 값은 거부 확인
+func TestGenerateAPIToken(t *testing.T) {
+	token, tokenID, hash, err := auth.GenerateAPIToken()
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(token, auth.APITokenPrefix+tokenID+"_"))
+	assert.Equal(t, auth.HashAPIToken(token), hash)
+	assert.NotContains(t, hash, tokenID)
+
+	parsed, ok := auth.ParseAPIToken(token)
+	assert.True(t, ok)
+	assert.Equal(t, tokenID, parsed)
+
+	other, _, _, err := auth.GenerateAPIToken()
+	require.NoError(t, err)
+	assert.NotEqual(t, token, other)
+
+	for _, invalid := range []string{"", "bsk_", "bsk_" + tokenID, "bsk_zzzzzzzzzzzzzzzz_secret", "ghp_" + tokenID + "_secret"} {
+		_, ok := auth.ParseAPIToken(invalid)
+		assert.False(t, ok, invalid)
+	}
+}
+
+// [GIVEN] 저장된 API 토큰과 발급한 계정
+// [WHEN] 올바른 값, 변조한 값, 만료 후, 계정 삭제 후 AuthenticateAPIToken 호출
+// [THEN] 올바르고 유효한 토큰만 토큰과 계정 반환 확인
+func TestAuthenticateAPIToken(t *testing.T) {
+	ctx := context.Background()
+	userRepo := repository.NewMemoryUserRepository()
+	tokenRepo := repository.NewMemoryAPITokenRepository()
+	require.NoError(t, userRepo.CreateUser(ctx, &model.User{Username: "editor1", Role: model.UserRoleEditor}))
+
+	value, tokenID, hash, err := auth.GenerateAPIToken()
+	require.NoError(t, err)
+	require.NoError(t, tokenRepo.CreateToken(ctx, &model.APIToken{
+		TokenID:   tokenID,
+		Username:  "editor1",
+		Name:      "CI",
+		Scopes:    []string{model.APITokenScopePostsWrite},
+		TokenHash: hash,
+		CreatedAt: baseTime,
+		ExpiresAt: baseTime.Add(24 * time.Hour),
+	}))
+
+	token, user, err := auth.AuthenticateAPIToken(ctx, tokenRepo, userRepo, value, baseTime)
+	require.NoError(t, err)
+	require.NotNil(t, token)
+	require.NotNil(t, user)
+	assert.Equal(t, "editor1", user.Username)
+	assert.True(t, token.HasScope(model.APITokenScopePostsWrite))
+
+	// 같은 토큰 ID에 다른 비밀 값
+	forged := auth.APITokenPrefix + tokenID + "_" + strings.Repeat("A", 43)
+	token, _, err = auth.AuthenticateAPIToken(ctx, tokenRepo, userRepo, forged, baseTime)
+	require.NoError(t, err)
+	assert.Nil(t, token)
+
+	token, _, err = auth.AuthenticateAPIToken(ctx, tokenRepo, userRepo, value, baseTime.Add(24*time.Hour))
+	require.NoError(t, err)
+	assert.Nil(t, token)
+
+	require.NoError(t, userRepo.DeleteUser(ctx, "editor1"))
+	token, _, err = auth.AuthenticateAPIToken(ctx, tokenRepo, userRepo, value, baseTime)
+	require.NoError(t, err)
+	assert.Nil(t, token)
+}
@@ -0,0 +1,209 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"bumsiku/internal/auth"
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var baseTime = time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+// [GIVEN] argon2id로 해시한 비밀번호
+// [WHEN] 같은 비밀번호와 다른 비밀번호로 검증
+// [THEN] 같은 비밀번호만 일치하고, 해시할 때마다 솔트가 달라짐 확인
+func TestHashPassword(t *testing.T) {
+	hash, err := auth.HashPassword("s3cret!")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=65536,t=3,p=2$"))
+
+	ok, err := auth.VerifyPassword(hash, "s3cret!")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = auth.VerifyPassword(hash, "s3cret")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	other, err := auth.HashPassword("s3cret!")
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, other)
+}
+
+// [GIVEN] bcrypt 해시와 잘못된 형식의 해시
+// [WHEN] VerifyPassword 호출
+// [THEN] bcrypt 해시는 검증되고, 형식 오류는 에러 반환 확인
+func TestVerifyPassword_Formats(t *testing.T) {
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("s3cret!"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	ok, err := auth.VerifyPassword(string(bcryptHash), "s3cret!")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = auth.VerifyPassword(string(bcryptHash), "wrong")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, err = auth.VerifyPassword("s3cret!", "s3cret!")
+	assert.ErrorIs(t, err, auth.ErrUnsupportedHash)
+
+	_, err = auth.VerifyPassword("$argon2id$v=19$m=65536,t=3$salt$hash", "s3cret!")
+	assert.ErrorIs(t, err, auth.ErrMalformedHash)
+
+	_, err = auth.VerifyPassword("$2b$10$short", "s3cret!")
+	assert.ErrorIs(t, err, auth.ErrMalformedHash)
+}
+
+// [GIVEN] 비밀번호 해시로 저장한 관리자 계정
+// [WHEN] Authenticate 호출
+// [THEN] 사용자 이름과 비밀번호가 모두 일치할 때만 계정 반환 확인
+func TestAuthenticate(t *testing.T) {
+	ctx := context.Background()
+	userRepo := repository.NewMemoryUserRepository()
+	hash, err := auth.HashPassword("s3cret!")
+	require.NoError(t, err)
+	require.NoError(t, userRepo.CreateUser(ctx, &model.User{Username: "editor1", PasswordHash: hash, Role: model.UserRoleEditor}))
+
+	user, err := auth.Authenticate(ctx, userRepo, "editor1", "s3cret!")
+	require.NoError(t, err)
+	require.NotNil(t, user)
+	assert.Equal(t, model.UserRoleEditor, user.Role)
+
+	user, err = auth.Authenticate(ctx, userRepo, "editor1", "wrong")
+	require.NoError(t, err)
+	assert.Nil(t, user)
+
+	user, err = auth.Authenticate(ctx, userRepo, "nobody", "s3cret!")
+	require.NoError(t, err)
+	assert.Nil(t, user)
+}
+
+// [GIVEN] 환경 변수의 관리자 계정 설정
+// [WHEN] EnsureOwner 반복 호출
+// [THEN] 처음에는 owner 계정 생성, 이후에는 역할만 owner로 되돌리고 비밀번호는 덮어쓰지 않으며, 잘못된 설정은 에러 확인
+func TestEnsureOwner(t *testing.T) {
+	ctx := context.Background()
+	userRepo := repository.NewMemoryUserRepository()
+	hash, err := auth.HashPassword("s3cret!")
+	require.NoError(t, err)
+
+	changed, err := auth.EnsureOwner(ctx, userRepo, "admin", hash, "")
+	require.NoError(t, err)
+	assert.True(t, changed)
+
+	changed, err = auth.EnsureOwner(ctx, userRepo, "admin", hash, "")
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	// owner가 아닌 역할로 바뀐 계정은 다시 owner로
+	user, err := userRepo.GetUser(ctx, "admin")
+	require.NoError(t, err)
+	user.Role = model.UserRoleModerator
+	require.NoError(t, userRepo.UpdateUser(ctx, user))
+
+	changed, err = auth.EnsureOwner(ctx, userRepo, "admin", hash, "")
+	require.NoError(t, err)
+	assert.True(t, changed)
+	user, err = auth.Authenticate(ctx, userRepo, "admin", "s3cret!")
+	require.NoError(t, err)
+	require.NotNil(t, user)
+	assert.Equal(t, model.UserRoleOwner, user.Role)
+
+	// 이미 있는 계정의 비밀번호는 설정이 바뀌어도 덮어쓰지 않음
+	otherHash, err := auth.HashPassword("changed!")
+	require.NoError(t, err)
+	changed, err = auth.EnsureOwner(ctx, userRepo, "admin", otherHash, "")
+	require.NoError(t, err)
+	assert.False(t, changed)
+	changed, err = auth.EnsureOwner(ctx, userRepo, "admin", "", "password")
+	require.NoError(t, err)
+	assert.False(t, changed)
+	user, err = auth.Authenticate(ctx, userRepo, "admin", "s3cret!")
+	require.NoError(t, err)
+	assert.NotNil(t, user)
+
+	// 평문 비밀번호 설정은 새 계정을 만들 때 해시해서 저장
+	changed, err = auth.EnsureOwner(ctx, userRepo, "owner2", "", "password")
+	require.NoError(t, err)
+	assert.True(t, changed)
+	user, err = auth.Authenticate(ctx, userRepo, "owner2", "password")
+	require.NoError(t, err)
+	assert.NotNil(t, user)
+
+	_, err = auth.EnsureOwner(ctx, userRepo, "admin", "plaintext", "")
+	assert.Error(t, err)
+	_, err = auth.EnsureOwner(ctx, userRepo, "", hash, "")
+	assert.Error(t, err)
+}
+
+// [GIVEN] 기본 설정의 로그인 실패 제한
+// [WHEN] 같은 사용자 이름으로 연속 실패
+// [THEN] 허용 횟수 이후 지연이 두 배씩 늘고, 잠금 후에는 잠금 시간만큼 대기 확인
+func TestLoginThrottle_ProgressiveDelayAndLockout(t *testing.T) {
+	throttle := auth.NewLoginThrottle()
+	keys := auth.LoginKeys("Admin", "1.2.3.4")
+
+	now := baseTime
+	expected := []time.Duration{0, 0, time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second, 30 * time.Second, 30 * time.Second}
+	for _, delay := range expected {
+		throttle.Failure(keys, now)
+		wait, locked := throttle.Wait(keys, now)
+		assert.Equal(t, delay, wait)
+		assert.False(t, locked)
+		now = now.Add(wait)
+	}
+
+	// 10번째 실패에서 잠금
+	throttle.Failure(keys, now)
+	wait, locked := throttle.Wait(keys, now)
+	assert.Equal(t, auth.DefaultLockoutDuration, wait)
+	assert.True(t, locked)
+
+	// 다른 IP에서 같은 사용자 이름으로 시도해도 잠김
+	wait, locked = throttle.Wait(auth.LoginKeys("admin", "5.6.7.8"), now.Add(time.Minute))
+	assert.Equal(t, auth.DefaultLockoutDuration-time.Minute, wait)
+	assert.True(t, locked)
+
+	// 잠금이 풀리면 실패 횟수 초기화
+	now = now.Add(auth.DefaultLockoutDuration)
+	wait, _ = throttle.Wait(keys, now)
+	assert.Zero(t, wait)
+	throttle.Failure(keys, now)
+	wait, _ = throttle.Wait(keys, now)
+	assert.Zero(t, wait)
+}
+
+// [GIVEN] 연속 실패가 기록된 상태
+// [WHEN] 로그인 성공 또는 실패 기록 만료
+// [THEN] 실패 기록이 지워져 바로 시도 가능 확인
+func TestLoginThrottle_Reset(t *testing.T) {
+	throttle := auth.NewLoginThrottle()
+	keys := auth.LoginKeys("admin", "1.2.3.4")
+
+	for i := 0; i < 5; i++ {
+		throttle.Failure(keys, baseTime)
+	}
+	wait, _ := throttle.Wait(keys, baseTime)
+	assert.Greater(t, wait, time.Duration(0))
+
+	throttle.Success(keys)
+	wait, _ = throttle.Wait(keys, baseTime)
+	assert.Zero(t, wait)
+
+	for i := 0; i < 5; i++ {
+		throttle.Failure(keys, baseTime)
+	}
+	later := baseTime.Add(auth.DefaultFailureWindow)
+	throttle.Failure(keys, later)
+	wait, _ = throttle.Wait(keys, later)
+	assert.Zero(t, wait)
+}
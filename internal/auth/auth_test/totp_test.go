@@ -0,0 +1,116 @@
+package auth
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"bumsiku/internal/auth"
+	"bumsiku/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// RFC 6238 부록 B의 SHA-1 테스트 키 "12345678901234567890"
+const rfcTestSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+// [GIVEN] RFC 6238 테스트 키
+// [WHEN] 테스트 벡터의 시간으로 TOTPCode 호출
+// [THEN] 8자리 테스트 값의 마지막 6자리와 일치 확인
+func TestTOTPCode_RFC6238(t *testing.T) {
+	vectors := map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	}
+	for unix, expected := range vectors {
+		code, err := auth.TOTPCode(rfcTestSecret, time.Unix(unix, 0))
+		require.NoError(t, err)
+		assert.Equal(t, expected, code, unix)
+	}
+
+	_, err := auth.TOTPCode("not base32!", baseTime)
+	assert.Error(t, err)
+}
+
+// [GIVEN] 새로 생성한 비밀 키
+// [WHEN] 현재, 앞뒤 한 단계, 두 단계 차이의 코드로 VerifyTOTP 호출
+// [THEN] 한 단계 오차까지만 허용하고, 이미 사용한 시간 단계는 거부 확인
+func TestVerifyTOTP(t *testing.T) {
+	secret, err := auth.GenerateTOTPSecret()
+	require.NoError(t, err)
+	assert.Len(t, secret, 32)
+
+	now := baseTime
+	for _, offset := range []time.Duration{-auth.TOTPPeriod, 0, auth.TOTPPeriod} {
+		code, err := auth.TOTPCode(secret, now.Add(offset))
+		require.NoError(t, err)
+		_, ok := auth.VerifyTOTP(secret, code, now, 0)
+		assert.True(t, ok, offset)
+	}
+
+	old, err := auth.TOTPCode(secret, now.Add(-2*auth.TOTPPeriod))
+	require.NoError(t, err)
+	_, ok := auth.VerifyTOTP(secret, old, now, 0)
+	assert.False(t, ok)
+
+	// 같은 코드는 한 번만 사용 가능
+	code, err := auth.TOTPCode(secret, now)
+	require.NoError(t, err)
+	step, ok := auth.VerifyTOTP(secret, code, now, 0)
+	require.True(t, ok)
+	_, ok = auth.VerifyTOTP(secret, code, now, step)
+	assert.False(t, ok)
+
+	_, ok = auth.VerifyTOTP(secret, "12345", now, 0)
+	assert.False(t, ok)
+}
+
+// [GIVEN] 2단계 인증을 사용하는 계정과 발급한 복구 코드
+// [WHEN] TOTP 코드와 복구 코드로 VerifySecondFactor 호출
+// [THEN] 사용한 TOTP 시간 단계가 기록되고, 복구 코드는 한 번만 사용 가능 확인
+func TestVerifySecondFactor(t *testing.T) {
+	secret, err := auth.GenerateTOTPSecret()
+	require.NoError(t, err)
+	codes, hashes, err := auth.GenerateRecoveryCodes()
+	require.NoError(t, err)
+	require.Len(t, codes, auth.RecoveryCodeCount)
+	assert.Regexp(t, `^[a-z2-7]{5}-[a-z2-7]{5}$`, codes[0])
+	assert.NotContains(t, hashes, codes[0])
+
+	user := &model.User{Username: "admin", TOTPEnabled: true, TOTPSecret: secret, RecoveryCodes: hashes}
+
+	code, err := auth.TOTPCode(secret, baseTime)
+	require.NoError(t, err)
+	assert.True(t, auth.VerifySecondFactor(user, code, baseTime))
+	assert.NotZero(t, user.TOTPLastStep)
+	assert.False(t, auth.VerifySecondFactor(user, code, baseTime))
+
+	// 복구 코드는 대소문자와 '-'를 구분하지 않음
+	assert.True(t, auth.VerifySecondFactor(user, strings.ToUpper(strings.ReplaceAll(codes[3], "-", "")), baseTime))
+	assert.Len(t, user.RecoveryCodes, auth.RecoveryCodeCount-1)
+	assert.False(t, auth.VerifySecondFactor(user, codes[3], baseTime))
+	assert.False(t, auth.VerifySecondFactor(user, "wrong", baseTime))
+}
+
+// [GIVEN] 서비스 이름과 계정
+// [WHEN] TOTPProvisioningURI 호출
+// [THEN] 인증 앱이 읽을 수 있는 otpauth URI 확인
+func TestTOTPProvisioningURI(t *testing.T) {
+	uri := auth.TOTPProvisioningURI("bumsiku blog", "admin", rfcTestSecret)
+
+	parsed, err := url.Parse(uri)
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", parsed.Scheme)
+	assert.Equal(t, "totp", parsed.Host)
+	assert.Equal(t, "/bumsiku blog:admin", parsed.Path)
+	assert.Equal(t, rfcTestSecret, parsed.Query().Get("secret"))
+	assert.Equal(t, "bumsiku blog", parsed.Query().Get("issuer"))
+	assert.Equal(t, "6", parsed.Query().Get("digits"))
+	assert.Equal(t, "30", parsed.Query().Get("period"))
+}
@@ -0,0 +1,28 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+)
+
+// csrfTokenSize는 CSRF 토큰의 바이트 수입니다.
+const csrfTokenSize = 32
+
+// GenerateCSRFToken은 로그인 세션에 저장할 새 CSRF 토큰을 생성합니다.
+func GenerateCSRFToken() (string, error) {
+	b := make([]byte, csrfTokenSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// VerifyCSRFToken은 요청의 CSRF 토큰이 세션에 저장된 토큰과 같은지 상수 시간으로 비교합니다.
+// 세션에 토큰이 없으면 항상 실패합니다.
+func VerifyCSRFToken(expected, actual string) bool {
+	if expected == "" || actual == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(actual)) == 1
+}
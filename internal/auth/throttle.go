@@ -0,0 +1,144 @@
+package auth
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// 로그인 실패 제한 기본값
+const (
+	DefaultFreeAttempts     = 3                // 지연 없이 허용하는 연속 실패 횟수
+	DefaultBaseDelay        = time.Second      // 첫 지연 시간 (실패할 때마다 두 배)
+	DefaultMaxDelay         = 30 * time.Second // 최대 지연 시간
+	DefaultLockoutThreshold = 10               // 잠금까지의 연속 실패 횟수
+	DefaultLockoutDuration  = 15 * time.Minute // 잠금 시간
+	DefaultFailureWindow    = 15 * time.Minute // 마지막 실패 후 이 시간이 지나면 실패 횟수 초기화
+)
+
+// throttleSweepInterval은 만료된 실패 기록을 정리하는 주기입니다.
+const throttleSweepInterval = time.Minute
+
+// LoginThrottle은 사용자 이름과 IP별 연속 로그인 실패를 기록해,
+// 실패가 반복되면 다음 시도까지 점점 긴 지연을 두고 일정 횟수를 넘으면 일시적으로 잠급니다.
+type LoginThrottle struct {
+	FreeAttempts     int
+	BaseDelay        time.Duration
+	MaxDelay         time.Duration
+	LockoutThreshold int
+	LockoutDuration  time.Duration
+	FailureWindow    time.Duration
+
+	mu        sync.Mutex
+	failures  map[string]*loginFailures
+	lastSweep time.Time
+}
+
+type loginFailures struct {
+	count int
+	last  time.Time
+}
+
+func NewLoginThrottle() *LoginThrottle {
+	return &LoginThrottle{
+		FreeAttempts:     DefaultFreeAttempts,
+		BaseDelay:        DefaultBaseDelay,
+		MaxDelay:         DefaultMaxDelay,
+		LockoutThreshold: DefaultLockoutThreshold,
+		LockoutDuration:  DefaultLockoutDuration,
+		FailureWindow:    DefaultFailureWindow,
+		failures:         make(map[string]*loginFailures),
+	}
+}
+
+// LoginKeys는 로그인 시도의 실패를 기록할 키(사용자 이름, IP)를 반환합니다.
+func LoginKeys(username, clientIP string) []string {
+	return []string{"user:" + strings.ToLower(username), "ip:" + clientIP}
+}
+
+// Wait는 다음 로그인 시도까지 기다려야 하는 시간을 반환합니다. 0이면 바로 시도할 수 있습니다.
+// 여러 키 중 가장 긴 대기 시간을 반환하며, locked는 잠금 상태인지 여부입니다.
+func (t *LoginThrottle) Wait(keys []string, now time.Time) (wait time.Duration, locked bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.sweepLocked(now)
+
+	for _, key := range keys {
+		failures := t.failures[key]
+		if failures == nil {
+			continue
+		}
+
+		until, isLocked := t.blockedUntil(failures)
+		if remaining := until.Sub(now); remaining > wait {
+			wait = remaining
+			locked = isLocked
+		}
+	}
+	return wait, locked
+}
+
+// Failure는 로그인 실패를 기록합니다.
+func (t *LoginThrottle) Failure(keys []string, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, key := range keys {
+		failures := t.failures[key]
+		if failures == nil || t.expired(failures, now) {
+			failures = &loginFailures{}
+			t.failures[key] = failures
+		}
+		failures.count++
+		failures.last = now
+	}
+}
+
+// Success는 로그인 성공 시 실패 기록을 지웁니다.
+func (t *LoginThrottle) Success(keys []string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, key := range keys {
+		delete(t.failures, key)
+	}
+}
+
+// blockedUntil은 실패 횟수에 따라 다음 시도가 가능한 시각을 계산합니다.
+func (t *LoginThrottle) blockedUntil(failures *loginFailures) (time.Time, bool) {
+	if failures.count >= t.LockoutThreshold {
+		return failures.last.Add(t.LockoutDuration), true
+	}
+	if failures.count < t.FreeAttempts {
+		return failures.last, false
+	}
+
+	delay := t.BaseDelay
+	for i := t.FreeAttempts; i < failures.count && delay < t.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > t.MaxDelay {
+		delay = t.MaxDelay
+	}
+	return failures.last.Add(delay), false
+}
+
+// expired는 실패 기록이 초기화 대상인지 확인합니다. 잠금 중인 기록은 잠금이 풀린 뒤에 만료됩니다.
+func (t *LoginThrottle) expired(failures *loginFailures, now time.Time) bool {
+	until, _ := t.blockedUntil(failures)
+	return !now.Before(until) && now.Sub(failures.last) >= t.FailureWindow
+}
+
+func (t *LoginThrottle) sweepLocked(now time.Time) {
+	if now.Sub(t.lastSweep) < throttleSweepInterval {
+		return
+	}
+	t.lastSweep = now
+
+	for key, failures := range t.failures {
+		if t.expired(failures, now) {
+			delete(t.failures, key)
+		}
+	}
+}
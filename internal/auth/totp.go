@@ -0,0 +1,166 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"bumsiku/internal/model"
+)
+
+// RFC 6238 TOTP 설정. 대부분의 인증 앱이 기본으로 지원하는 값(SHA-1, 6자리, 30초)을 사용합니다.
+const (
+	TOTPDigits = 6
+	TOTPPeriod = 30 * time.Second
+
+	// totpSkew는 시계 오차를 허용하는 앞뒤 시간 단계 수입니다.
+	totpSkew = 1
+	// totpSecretSize는 비밀 키 크기(바이트)입니다. RFC 4226 권장값인 160비트입니다.
+	totpSecretSize = 20
+
+	// RecoveryCodeCount는 2단계 인증 등록 시 발급하는 복구 코드 수입니다.
+	RecoveryCodeCount = 10
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTOTPSecret은 base32로 인코딩한 새 TOTP 비밀 키를 생성합니다.
+func GenerateTOTPSecret() (string, error) {
+	secret := make([]byte, totpSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return totpEncoding.EncodeToString(secret), nil
+}
+
+// TOTPProvisioningURI는 인증 앱에 등록할 otpauth URI를 생성합니다. 관리자 화면에서 QR 코드로 표시합니다.
+func TOTPProvisioningURI(issuer, account, secret string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprintf("%d", TOTPDigits))
+	query.Set("period", fmt.Sprintf("%d", int(TOTPPeriod.Seconds())))
+
+	label := url.PathEscape(issuer + ":" + account)
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
+
+// TOTPCode는 시간 t의 TOTP 코드를 계산합니다.
+func TOTPCode(secret string, t time.Time) (string, error) {
+	key, err := decodeTOTPSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return totpCode(key, totpStep(t)), nil
+}
+
+// VerifyTOTP는 앞뒤 한 단계의 시계 오차를 허용해 TOTP 코드를 확인하고 일치한 시간 단계를 반환합니다.
+// lastStep 이하의 시간 단계는 이미 사용한 코드로 보고 거부합니다.
+func VerifyTOTP(secret, code string, now time.Time, lastStep int64) (int64, bool) {
+	key, err := decodeTOTPSecret(secret)
+	if err != nil || len(code) != TOTPDigits {
+		return 0, false
+	}
+
+	current := totpStep(now)
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if step <= lastStep {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(totpCode(key, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// GenerateRecoveryCodes는 "xxxxx-xxxxx" 형식의 복구 코드와 저장할 해시를 생성합니다.
+// 복구 코드는 사용자에게 한 번만 보여주고 해시만 저장합니다.
+func GenerateRecoveryCodes() (codes []string, hashes []string, err error) {
+	for i := 0; i < RecoveryCodeCount; i++ {
+		raw := make([]byte, 7)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, nil, err
+		}
+		encoded := strings.ToLower(totpEncoding.EncodeToString(raw))[:10]
+		code := encoded[:5] + "-" + encoded[5:]
+		codes = append(codes, code)
+		hashes = append(hashes, HashRecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
+
+// HashRecoveryCode는 복구 코드의 해시를 반환합니다. 대소문자와 '-', 공백은 구분하지 않습니다.
+// 복구 코드는 충분히 긴 무작위 값이므로 비밀번호와 달리 느린 해시를 사용하지 않습니다.
+func HashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
+
+// VerifySecondFactor는 2단계 인증 코드를 확인합니다. TOTP 코드 또는 복구 코드를 사용할 수 있습니다.
+// 확인에 성공하면 재사용을 막도록 user의 마지막 TOTP 시간 단계를 갱신하거나 사용한 복구 코드를 제거하므로,
+// 호출한 쪽에서 계정을 저장해야 합니다.
+func VerifySecondFactor(user *model.User, code string, now time.Time) bool {
+	code = strings.TrimSpace(code)
+	if user.TOTPSecret == "" {
+		return false
+	}
+
+	if step, ok := VerifyTOTP(user.TOTPSecret, code, now, user.TOTPLastStep); ok {
+		user.TOTPLastStep = step
+		return true
+	}
+
+	hash := HashRecoveryCode(code)
+	for i, stored := range user.RecoveryCodes {
+		if subtle.ConstantTimeCompare([]byte(stored), []byte(hash)) == 1 {
+			remaining := make([]string, 0, len(user.RecoveryCodes)-1)
+			remaining = append(remaining, user.RecoveryCodes[:i]...)
+			user.RecoveryCodes = append(remaining, user.RecoveryCodes[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func decodeTOTPSecret(secret string) ([]byte, error) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return nil, fmt.Errorf("TOTP 비밀 키 형식 오류: %w", err)
+	}
+	return key, nil
+}
+
+func totpStep(t time.Time) int64 {
+	return t.Unix() / int64(TOTPPeriod.Seconds())
+}
+
+// totpCode는 RFC 4226 HOTP 값을 계산합니다.
+func totpCode(key []byte, step int64) string {
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], uint64(step))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint32(1)
+	for i := 0; i < TOTPDigits; i++ {
+		modulo *= 10
+	}
+	return fmt.Sprintf("%0*d", TOTPDigits, value%modulo)
+}
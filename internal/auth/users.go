@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+)
+
+var (
+	dummyHashOnce sync.Once
+	dummyHash     string
+)
+
+// Authenticate는 사용자 이름과 비밀번호로 관리자 계정을 확인합니다. 일치하지 않으면 nil을 반환합니다.
+// 계정이 없어도 비밀번호 해시 비교를 수행해, 응답 시간으로 사용자 이름을 추측할 수 없게 합니다.
+func Authenticate(ctx context.Context, userRepo repository.UserRepositoryInterface, username, password string) (*model.User, error) {
+	user, err := userRepo.GetUser(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		dummyHashOnce.Do(func() {
+			dummyHash, _ = HashPassword("")
+		})
+		VerifyPassword(dummyHash, password)
+		return nil, nil
+	}
+
+	ok, err := VerifyPassword(user.PasswordHash, password)
+	if err != nil {
+		return nil, fmt.Errorf("%s 계정의 비밀번호 해시 확인 실패: %w", username, err)
+	}
+	if !ok {
+		return nil, nil
+	}
+	return user, nil
+}
+
+// EnsureOwner는 환경 변수로 설정한 관리자 계정이 없으면 owner 역할로 생성하고, 있으면 owner 역할인지 확인합니다.
+// passwordHash가 비어 있으면 평문 password를 사용합니다. 평문 비밀번호 설정은 이전 설정과의 호환을 위한 것입니다.
+// 비밀번호는 계정을 처음 만들 때만 설정하므로, 실행 중에 바꾼 비밀번호는 배포할 때마다 설정값으로 되돌아가지 않습니다.
+// 이미 있는 계정의 비밀번호는 계정 관리 API(PUT /admin/users/{username})로 바꿔야 하며, 설정값만 바꿔서는 반영되지 않습니다.
+// 계정을 생성하거나 갱신했으면 true를 반환합니다.
+func EnsureOwner(ctx context.Context, userRepo repository.UserRepositoryInterface, username, passwordHash, password string) (bool, error) {
+	if username == "" {
+		return false, errors.New("관리자 아이디가 설정되지 않았습니다")
+	}
+	if passwordHash == "" && password == "" {
+		return false, errors.New("관리자 비밀번호 해시가 설정되지 않았습니다")
+	}
+	if passwordHash != "" {
+		if err := ValidateHash(passwordHash); err != nil {
+			return false, fmt.Errorf("관리자 비밀번호 해시 확인 실패: %w", err)
+		}
+	}
+
+	user, err := userRepo.GetUser(ctx, username)
+	if err != nil {
+		return false, err
+	}
+
+	if user != nil {
+		// 기존 계정은 역할만 확인하고 비밀번호는 그대로 사용
+		if user.Role == model.UserRoleOwner {
+			return false, nil
+		}
+		user.Role = model.UserRoleOwner
+		user.UpdatedAt = time.Now()
+		return true, userRepo.UpdateUser(ctx, user)
+	}
+
+	if passwordHash == "" {
+		if passwordHash, err = HashPassword(password); err != nil {
+			return false, err
+		}
+	}
+
+	return true, userRepo.CreateUser(ctx, &model.User{
+		Username:     username,
+		PasswordHash: passwordHash,
+		Role:         model.UserRoleOwner,
+	})
+}
@@ -0,0 +1,21 @@
+package config
+
+import "os"
+
+// AdminCredentials는 관리자 아이디(ADMIN_ID)와 비밀번호 해시(ADMIN_PW_HASH)를 반환합니다.
+// 비밀번호 해시는 "serverapp hash-password" 명령으로 생성하며, 해시에 '$'가 포함되므로 .env 파일에서는 작은따옴표로 감쌉니다.
+// password는 ADMIN_PW_HASH가 없을 때만 사용하는 이전 방식의 평문 비밀번호(ADMIN_PW)입니다.
+func AdminCredentials() (username, passwordHash, password string) {
+	return os.Getenv("ADMIN_ID"), os.Getenv("ADMIN_PW_HASH"), os.Getenv("ADMIN_PW")
+}
+
+// DefaultTOTPIssuer는 TOTP_ISSUER가 설정되지 않았을 때 인증 앱에 표시할 서비스 이름입니다.
+const DefaultTOTPIssuer = "bumsiku"
+
+// TOTPIssuer는 2단계 인증 등록 시 인증 앱에 표시할 서비스 이름(TOTP_ISSUER)을 반환합니다.
+func TOTPIssuer() string {
+	if issuer := os.Getenv("TOTP_ISSUER"); issuer != "" {
+		return issuer
+	}
+	return DefaultTOTPIssuer
+}
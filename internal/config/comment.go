@@ -0,0 +1,56 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultCommentMaxLinks는 COMMENT_MAX_LINKS가 설정되지 않았을 때 댓글 하나에 허용하는 링크 수입니다.
+const DefaultCommentMaxLinks = 3
+
+// CommentModerationEnabled는 새 댓글을 검토 대기 상태로 등록할지 여부를 반환합니다.
+// COMMENT_MODERATION 환경 변수가 true이면 관리자가 승인한 댓글만 공개됩니다.
+func CommentModerationEnabled() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv("COMMENT_MODERATION"))
+	return enabled
+}
+
+// CommentMaxLinks는 스팸으로 판정하지 않는 댓글의 최대 링크 수를 반환합니다 (COMMENT_MAX_LINKS).
+func CommentMaxLinks() int {
+	maxLinks, err := strconv.Atoi(os.Getenv("COMMENT_MAX_LINKS"))
+	if err != nil || maxLinks < 0 {
+		return DefaultCommentMaxLinks
+	}
+	return maxLinks
+}
+
+// CommentBlocklist는 댓글 금지어 목록을 반환합니다. COMMENT_BLOCKLIST 환경 변수에 쉼표로 구분해 설정합니다.
+func CommentBlocklist() []string {
+	var words []string
+	for _, word := range strings.Split(os.Getenv("COMMENT_BLOCKLIST"), ",") {
+		if word = strings.TrimSpace(word); word != "" {
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+// CommentMinSubmitDelay는 댓글 작성 폼을 연 뒤 제출까지 걸려야 하는 최소 시간을 반환합니다 (COMMENT_MIN_SUBMIT_SECONDS).
+// 0이면 폼 토큰 검사를 하지 않습니다.
+func CommentMinSubmitDelay() time.Duration {
+	seconds, err := strconv.Atoi(os.Getenv("COMMENT_MIN_SUBMIT_SECONDS"))
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// CommentFormSecret은 댓글 폼 토큰 서명 키를 반환합니다. COMMENT_FORM_SECRET이 없으면 SESSION_SECRET을 사용합니다.
+func CommentFormSecret() []byte {
+	if secret := os.Getenv("COMMENT_FORM_SECRET"); secret != "" {
+		return []byte(secret)
+	}
+	return []byte(os.Getenv("SESSION_SECRET"))
+}
@@ -0,0 +1,12 @@
+package config
+
+import "os"
+
+// CursorSecret은 게시글 목록 페이지 커서 서명 키를 반환합니다. CURSOR_SECRET이 없으면 SESSION_SECRET을 사용합니다.
+// 둘 다 없으면 빈 값을 반환하며, 이때 저장소는 프로세스마다 임의의 키를 생성합니다.
+func CursorSecret() []byte {
+	if secret := os.Getenv("CURSOR_SECRET"); secret != "" {
+		return []byte(secret)
+	}
+	return []byte(os.Getenv("SESSION_SECRET"))
+}
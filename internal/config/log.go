@@ -0,0 +1,123 @@
+package config
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// 로그 싱크 종류 (LOG_SINKS 환경 변수)
+const (
+	LogSinkCloudWatch = "cloudwatch"
+	LogSinkStdout     = "stdout"
+	LogSinkFile       = "file"
+)
+
+// 로그 파일 기본값
+const (
+	DefaultLogFilePath       = "logs/bumsiku.log"
+	DefaultLogFileMaxSizeMB  = 100
+	DefaultLogFileMaxBackups = 5
+)
+
+// DefaultLogBodyMaxSize는 로그에 기록하는 요청/응답 바디의 기본 최대 크기(바이트)입니다.
+const DefaultLogBodyMaxSize = 8 * 1024
+
+// LogSinks는 로그를 내보낼 싱크 목록을 반환합니다.
+// LOG_SINKS 환경 변수에 cloudwatch, stdout, file을 쉼표로 구분해 설정하며(예: "stdout,file"), 여러 개를 설정하면 모두에 기록합니다.
+// 설정이 없거나 올바른 항목이 없으면 cloudwatch를 사용합니다.
+func LogSinks() []string {
+	value := strings.TrimSpace(os.Getenv("LOG_SINKS"))
+
+	var sinks []string
+	seen := make(map[string]bool)
+	for _, sink := range strings.Split(value, ",") {
+		sink = strings.ToLower(strings.TrimSpace(sink))
+		if sink == "" || seen[sink] {
+			continue
+		}
+		switch sink {
+		case LogSinkCloudWatch, LogSinkStdout, LogSinkFile:
+			sinks = append(sinks, sink)
+			seen[sink] = true
+		default:
+			log.Printf("알 수 없는 로그 싱크 LOG_SINKS=%s, %s 무시", value, sink)
+		}
+	}
+
+	if len(sinks) == 0 {
+		return []string{LogSinkCloudWatch}
+	}
+	return sinks
+}
+
+// LogLevel은 기록할 최소 로그 레벨(LOG_LEVEL)을 반환합니다. DEBUG, INFO, WARN, ERROR 중 하나로 설정합니다.
+// 설정이 없거나 잘못되면 운영 환경(APP_ENV=production)에서는 INFO, 그 외에는 DEBUG를 사용합니다.
+func LogLevel() string {
+	defaultLevel := "DEBUG"
+	if os.Getenv("APP_ENV") == "production" {
+		defaultLevel = "INFO"
+	}
+
+	value := strings.ToUpper(strings.TrimSpace(os.Getenv("LOG_LEVEL")))
+	switch value {
+	case "":
+		return defaultLevel
+	case "DEBUG", "INFO", "WARN", "ERROR":
+		return value
+	case "WARNING":
+		return "WARN"
+	}
+	log.Printf("잘못된 로그 레벨 LOG_LEVEL=%s, %s 사용", value, defaultLevel)
+	return defaultLevel
+}
+
+// LogFilePath는 file 싱크가 기록할 파일 경로(LOG_FILE_PATH)를 반환합니다.
+func LogFilePath() string {
+	if path := strings.TrimSpace(os.Getenv("LOG_FILE_PATH")); path != "" {
+		return path
+	}
+	return DefaultLogFilePath
+}
+
+// LogFileMaxSize는 로그 파일을 교체하는 크기(LOG_FILE_MAX_SIZE, MB 단위)를 바이트로 반환합니다.
+func LogFileMaxSize() int64 {
+	return int64(logInt("LOG_FILE_MAX_SIZE", DefaultLogFileMaxSizeMB, 1)) * 1024 * 1024
+}
+
+// LogFileMaxBackups는 보관할 이전 로그 파일 수(LOG_FILE_MAX_BACKUPS)를 반환합니다. 0이면 보관하지 않습니다.
+func LogFileMaxBackups() int {
+	return logInt("LOG_FILE_MAX_BACKUPS", DefaultLogFileMaxBackups, 0)
+}
+
+// logInt는 환경 변수의 정수를 읽습니다. 설정이 없거나 min보다 작으면 기본값을 사용합니다.
+func logInt(key string, defaultValue, min int) int {
+	value := strings.TrimSpace(os.Getenv(key))
+	if value == "" {
+		return defaultValue
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < min {
+		log.Printf("잘못된 로그 설정 %s=%s, 기본값 사용", key, value)
+		return defaultValue
+	}
+	return n
+}
+
+// LogRedactKeys는 요청/응답 로그에서 기본 목록(password, token, secret 등)에 더해 값을 가릴 키(LOG_REDACT_KEYS)를 반환합니다.
+// 쉼표로 구분해 설정하며(예: "phone,email"), 키 이름에 포함되면 가립니다.
+func LogRedactKeys() []string {
+	var keys []string
+	for _, key := range strings.Split(os.Getenv("LOG_REDACT_KEYS"), ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// LogBodyMaxSize는 로그에 기록하는 요청/응답 바디의 최대 크기(LOG_BODY_MAX_SIZE, 바이트)를 반환합니다. 넘는 부분은 잘라냅니다.
+func LogBodyMaxSize() int {
+	return logInt("LOG_BODY_MAX_SIZE", DefaultLogBodyMaxSize, 1)
+}
@@ -0,0 +1,63 @@
+package config
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultTrustedProxies는 TRUSTED_PROXIES가 설정되지 않았을 때 X-Forwarded-For 헤더를 신뢰할 프록시 대역입니다.
+// 같은 호스트와 사설망의 로드 밸런서, 리버스 프록시만 신뢰합니다.
+var DefaultTrustedProxies = []string{
+	"127.0.0.0/8", "::1/128",
+	"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "fc00::/7",
+}
+
+// TrustedProxies는 클라이언트 IP 판별에 사용할 신뢰할 수 있는 프록시 목록을 반환합니다.
+// TRUSTED_PROXIES 환경 변수에 IP 또는 CIDR을 쉼표로 구분해 설정하며, "none"이면 프록시 헤더를 사용하지 않습니다.
+func TrustedProxies() []string {
+	value := strings.TrimSpace(os.Getenv("TRUSTED_PROXIES"))
+	if value == "" {
+		return DefaultTrustedProxies
+	}
+	if strings.EqualFold(value, "none") {
+		return nil
+	}
+
+	var proxies []string
+	for _, proxy := range strings.Split(value, ",") {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			proxies = append(proxies, proxy)
+		}
+	}
+	return proxies
+}
+
+// RateLimit은 라우트 그룹의 요청 수 제한을 반환합니다.
+// RATE_LIMIT_<그룹> 환경 변수에 "요청 수/기간" 형식(예: "5/1m")으로 설정하며, "off"이면 제한하지 않습니다(요청 수 0).
+// 설정이 없거나 형식이 잘못되면 기본값을 사용합니다.
+func RateLimit(group string, defaultRequests int, defaultPer time.Duration) (int, time.Duration) {
+	key := "RATE_LIMIT_" + strings.ToUpper(group)
+	value := strings.TrimSpace(os.Getenv(key))
+	if value == "" {
+		return defaultRequests, defaultPer
+	}
+	if strings.EqualFold(value, "off") {
+		return 0, defaultPer
+	}
+
+	requestsText, perText, ok := strings.Cut(value, "/")
+	requests, err := strconv.Atoi(strings.TrimSpace(requestsText))
+	if !ok || err != nil || requests < 0 {
+		log.Printf("잘못된 요청 수 제한 설정 %s=%s, 기본값 사용", key, value)
+		return defaultRequests, defaultPer
+	}
+	per, err := time.ParseDuration(strings.TrimSpace(perText))
+	if err != nil || per <= 0 {
+		log.Printf("잘못된 요청 수 제한 설정 %s=%s, 기본값 사용", key, value)
+		return defaultRequests, defaultPer
+	}
+	return requests, per
+}
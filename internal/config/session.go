@@ -0,0 +1,65 @@
+package config
+
+import (
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 로그인 세션 기본값
+const (
+	DefaultSessionIdleTimeout = 2 * time.Hour  // 마지막 사용 후 세션이 만료되기까지의 시간
+	DefaultSessionMaxAge      = 24 * time.Hour // 사용 여부와 관계없이 로그인 후 세션이 만료되기까지의 시간
+)
+
+// SessionCookieSecure는 세션 쿠키를 HTTPS 연결에서만 전송할지 여부(SESSION_COOKIE_SECURE)를 반환합니다.
+// 설정하지 않으면 블로그 주소(SITE_URL)가 https인 경우 사용합니다.
+func SessionCookieSecure() bool {
+	if secure, err := strconv.ParseBool(os.Getenv("SESSION_COOKIE_SECURE")); err == nil {
+		return secure
+	}
+	return strings.HasPrefix(SiteURL(), "https://")
+}
+
+// SessionCookieSameSite는 세션 쿠키의 SameSite 속성(SESSION_COOKIE_SAMESITE)을 반환합니다.
+// lax, strict, none 중 하나로 설정하며, 설정이 없거나 잘못되면 lax를 사용합니다.
+func SessionCookieSameSite() http.SameSite {
+	value := strings.ToLower(strings.TrimSpace(os.Getenv("SESSION_COOKIE_SAMESITE")))
+	switch value {
+	case "", "lax":
+		return http.SameSiteLaxMode
+	case "strict":
+		return http.SameSiteStrictMode
+	case "none":
+		return http.SameSiteNoneMode
+	}
+	log.Printf("잘못된 세션 쿠키 설정 SESSION_COOKIE_SAMESITE=%s, lax 사용", value)
+	return http.SameSiteLaxMode
+}
+
+// SessionIdleTimeout은 마지막 사용 후 세션이 만료되기까지의 시간(SESSION_IDLE_TIMEOUT, 예: "2h")을 반환합니다.
+func SessionIdleTimeout() time.Duration {
+	return sessionDuration("SESSION_IDLE_TIMEOUT", DefaultSessionIdleTimeout)
+}
+
+// SessionMaxAge는 계속 사용하더라도 로그인 후 세션이 만료되기까지의 시간(SESSION_MAX_AGE, 예: "24h")을 반환합니다.
+func SessionMaxAge() time.Duration {
+	return sessionDuration("SESSION_MAX_AGE", DefaultSessionMaxAge)
+}
+
+// sessionDuration은 환경 변수의 기간을 읽습니다. 설정이 없거나 형식이 잘못되면 기본값을 사용합니다.
+func sessionDuration(key string, defaultValue time.Duration) time.Duration {
+	value := strings.TrimSpace(os.Getenv(key))
+	if value == "" {
+		return defaultValue
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		log.Printf("잘못된 세션 설정 %s=%s, 기본값 사용", key, value)
+		return defaultValue
+	}
+	return duration
+}
@@ -0,0 +1,19 @@
+package config
+
+import (
+	"os"
+	"strings"
+)
+
+// DefaultSiteURL은 SITE_URL이 설정되지 않았을 때 사용하는 블로그 주소입니다.
+const DefaultSiteURL = "https://bumsiku.kr"
+
+// SiteURL은 사이트맵과 구독 피드 링크에 사용할 블로그 주소를 반환합니다.
+// SITE_URL 환경 변수를 사용하며 끝의 '/'는 제거합니다.
+func SiteURL() string {
+	siteURL := strings.TrimRight(strings.TrimSpace(os.Getenv("SITE_URL")), "/")
+	if siteURL == "" {
+		return DefaultSiteURL
+	}
+	return siteURL
+}
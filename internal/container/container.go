@@ -1,47 +1,257 @@
 package container
 
 import (
+	"bumsiku/internal/auth"
+	"bumsiku/internal/config"
 	"bumsiku/internal/repository"
+	"bumsiku/internal/search"
+	"bumsiku/internal/sitemap"
+	"bumsiku/internal/spam"
+	"bumsiku/internal/utils"
 	"bumsiku/pkg/client"
 	"context"
+	"fmt"
+	"log"
+	"os"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 )
 
+// 저장소 백엔드 종류 (STORAGE_BACKEND 환경 변수)
+const (
+	StorageDynamoDB = "dynamodb"
+	StorageSQLite   = "sqlite"
+	StorageMemory   = "memory"
+)
+
 type Container struct {
-	PostRepository     *repository.PostRepository
-	CommentRepository  *repository.CommentRepository
-	CategoryRepository *repository.CategoryRepository
+	PostRepository     repository.PostRepositoryInterface
+	CommentRepository  repository.CommentRepositoryInterface
+	CategoryRepository repository.CategoryRepositoryInterface
+	RevisionRepository repository.RevisionRepositoryInterface
+	TagRepository      repository.TagRepositoryInterface
+	UserRepository     repository.UserRepositoryInterface
+	APITokenRepository repository.APITokenRepositoryInterface
+	SessionRepository  repository.SessionRepositoryInterface
+	SearchIndex        *search.Index
+	Sitemap            *sitemap.Generator
+	SpamClassifier     *spam.Classifier
+	SpamFilter         spam.Filter
+	FormTokens         *spam.FormTokens
+	LoginThrottle      *auth.LoginThrottle
 	S3Client           *s3.Client
-	CloudWatchClient   *cloudwatchlogs.Client
+	CloudWatchClient   *cloudwatchlogs.Client // cloudwatch 로그 싱크를 사용할 때만 생성
+	Logger             *utils.Logger
 }
 
 func NewContainer(ctx context.Context) (*Container, error) {
-	ddbClient, err := client.NewDdbClient(ctx)
+	s3Client, err := client.NewS3Client(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	s3Client, err := client.NewS3Client(ctx)
-	if err != nil {
+	container := &Container{
+		S3Client: s3Client,
+	}
+
+	if err := container.initLogger(ctx); err != nil {
 		return nil, err
 	}
 
-	cwClient, err := client.NewCloudWatchLogsClient(ctx)
-	if err != nil {
+	if err := container.initRepositories(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := container.initAuth(ctx); err != nil {
 		return nil, err
 	}
 
-	postRepo := repository.NewPostRepository(ddbClient)
-	commentRepo := repository.NewCommentRepository(ddbClient)
-	categoryRepo := repository.NewCategoryRepository(ddbClient)
+	container.initSearchIndex(ctx)
+	container.initSitemap()
+	container.initSpamFilter(ctx)
+
+	return container, nil
+}
+
+// initLogger는 LOG_SINKS 설정에 따라 로그 싱크를 만들고, LOG_LEVEL 이상의 로그만 기록하는 Logger를 생성합니다.
+// CloudWatch 클라이언트는 cloudwatch 싱크를 사용할 때만 생성하므로, stdout이나 file만 사용하면 AWS에 접속하지 않습니다.
+func (c *Container) initLogger(ctx context.Context) error {
+	var sinks []utils.Sink
+	for _, name := range config.LogSinks() {
+		switch name {
+		case config.LogSinkCloudWatch:
+			cwClient, err := client.NewCloudWatchLogsClient(ctx)
+			if err != nil {
+				return err
+			}
+			c.CloudWatchClient = cwClient
+			sinks = append(sinks, utils.NewCloudWatchSink(cwClient, utils.CloudWatchSinkOptions{}))
+
+		case config.LogSinkStdout:
+			sinks = append(sinks, utils.NewStdoutSink())
+
+		case config.LogSinkFile:
+			fileSink, err := utils.NewFileSink(config.LogFilePath(), config.LogFileMaxSize(), config.LogFileMaxBackups())
+			if err != nil {
+				return err
+			}
+			sinks = append(sinks, fileSink)
+		}
+	}
+
+	c.Logger = utils.NewLoggerWithOptions(utils.LoggerOptions{MinLevel: config.LogLevel()}, sinks...)
+	return nil
+}
+
+// initAuth는 로그인 실패 제한을 초기화하고 환경 변수로 설정한 관리자 계정을 owner 계정으로 등록합니다.
+// 비밀번호 해시(ADMIN_PW_HASH)가 없으면 평문 비밀번호(ADMIN_PW)를 해시해 사용하고 경고를 남깁니다.
+// 비밀번호는 계정을 처음 등록할 때만 사용하며, 이미 있는 계정의 비밀번호는 바꾸지 않습니다.
+// 관리자 계정이 설정되지 않았으면 저장소에 이미 등록된 계정으로만 로그인할 수 있습니다.
+func (c *Container) initAuth(ctx context.Context) error {
+	c.LoginThrottle = auth.NewLoginThrottle()
+
+	username, passwordHash, password := config.AdminCredentials()
+	if username == "" || (passwordHash == "" && password == "") {
+		log.Printf("관리자 계정이 설정되지 않아 owner 계정을 등록하지 않습니다 (ADMIN_ID, ADMIN_PW_HASH)")
+		return nil
+	}
+	if passwordHash == "" {
+		log.Printf("ADMIN_PW 평문 비밀번호 사용 중: hash-password 명령으로 만든 ADMIN_PW_HASH로 교체하세요")
+	}
+
+	changed, err := auth.EnsureOwner(ctx, c.UserRepository, username, passwordHash, password)
+	if err != nil {
+		return fmt.Errorf("owner 계정 등록 실패: %w", err)
+	}
+	if changed {
+		log.Printf("owner 계정 등록: %s", username)
+	}
+	return nil
+}
 
-	return &Container{
-		PostRepository:     postRepo,
-		CommentRepository:  commentRepo,
-		CategoryRepository: categoryRepo,
-		S3Client:           s3Client,
-		CloudWatchClient:   cwClient,
-	}, nil
+// initRepositories는 STORAGE_BACKEND 설정에 따라 저장소 구현체를 생성합니다. 기본값은 DynamoDB입니다.
+func (c *Container) initRepositories(ctx context.Context) error {
+	backend := os.Getenv("STORAGE_BACKEND")
+	if backend == "" {
+		backend = StorageDynamoDB
+	}
+
+	cursorSecret := config.CursorSecret()
+	if len(cursorSecret) == 0 {
+		log.Printf("커서 서명 키가 설정되지 않아 임의의 키를 사용합니다: 재시작하면 이전 페이지 커서는 무효가 됩니다 (CURSOR_SECRET)")
+	}
+	cursors := repository.NewCursorSigner(cursorSecret)
+
+	switch backend {
+	case StorageDynamoDB:
+		ddbClient, err := client.NewDdbClient(ctx)
+		if err != nil {
+			return err
+		}
+
+		postRepo := repository.NewPostRepository(ddbClient, cursors)
+
+		// 시간순 피드 인덱스와 공개 상태 도입 이전에 작성된 게시글 보정
+		if updated, err := postRepo.BackfillPostDefaults(ctx); err != nil {
+			log.Printf("게시글 기본값 보정 실패: %v", err)
+		} else if updated > 0 {
+			log.Printf("게시글 기본값 보정 완료: %d건", updated)
+		}
+
+		c.PostRepository = postRepo
+		c.CommentRepository = repository.NewCommentRepository(ddbClient)
+		c.CategoryRepository = repository.NewCategoryRepository(ddbClient)
+		c.RevisionRepository = repository.NewRevisionRepository(ddbClient)
+		c.TagRepository = repository.NewTagRepository(ddbClient)
+		c.UserRepository = repository.NewUserRepository(ddbClient)
+		c.APITokenRepository = repository.NewAPITokenRepository(ddbClient)
+		c.SessionRepository = repository.NewSessionRepository(ddbClient)
+
+	case StorageSQLite:
+		path := os.Getenv("SQLITE_PATH")
+		if path == "" {
+			path = "bumsiku.db"
+		}
+
+		db, err := repository.OpenSQLite(path)
+		if err != nil {
+			return err
+		}
+
+		c.PostRepository = repository.NewSQLitePostRepository(db, cursors)
+		c.CommentRepository = repository.NewSQLiteCommentRepository(db)
+		c.CategoryRepository = repository.NewSQLiteCategoryRepository(db)
+		c.RevisionRepository = repository.NewSQLiteRevisionRepository(db)
+		c.TagRepository = repository.NewSQLiteTagRepository(db)
+		c.UserRepository = repository.NewSQLiteUserRepository(db)
+		c.APITokenRepository = repository.NewSQLiteAPITokenRepository(db)
+		c.SessionRepository = repository.NewSQLiteSessionRepository(db)
+
+	case StorageMemory:
+		c.PostRepository = repository.NewMemoryPostRepository(cursors)
+		c.CommentRepository = repository.NewMemoryCommentRepository()
+		c.CategoryRepository = repository.NewMemoryCategoryRepository()
+		c.RevisionRepository = repository.NewMemoryRevisionRepository()
+		c.TagRepository = repository.NewMemoryTagRepository()
+		c.UserRepository = repository.NewMemoryUserRepository()
+		c.APITokenRepository = repository.NewMemoryAPITokenRepository()
+		c.SessionRepository = repository.NewMemorySessionRepository()
+
+	default:
+		return fmt.Errorf("지원하지 않는 저장소 백엔드: %s", backend)
+	}
+
+	// 게시글 쓰기가 태그 색인에 반영되도록 저장소를 감쌈
+	c.PostRepository = repository.NewTaggedPostRepository(c.PostRepository, c.TagRepository, c.Logger)
+
+	log.Printf("저장소 백엔드: %s", backend)
+	return nil
+}
+
+// initSearchIndex는 저장된 게시글로 검색 색인을 만들고, 이후 게시글 쓰기가 색인에 반영되도록 저장소를 감쌉니다.
+// 색인 생성에 실패해도 서버는 시작하며, 새로 쓰인 게시글부터 검색됩니다.
+func (c *Container) initSearchIndex(ctx context.Context) {
+	c.SearchIndex = search.NewIndex()
+	if indexed, err := c.SearchIndex.Rebuild(ctx, c.PostRepository); err != nil {
+		log.Printf("검색 색인 생성 실패: %v", err)
+	} else {
+		log.Printf("검색 색인 생성 완료: %d건", indexed)
+	}
+
+	c.PostRepository = search.NewIndexedPostRepository(c.PostRepository, c.SearchIndex, c.Logger)
+}
+
+// initSitemap은 사이트맵 생성기를 만들고, 게시글과 카테고리 쓰기가 사이트맵 캐시를 무효화하도록 저장소를 감쌉니다.
+func (c *Container) initSitemap() {
+	c.Sitemap = sitemap.NewGenerator(config.SiteURL(), c.PostRepository, c.CategoryRepository)
+	c.PostRepository = sitemap.NewPostRepository(c.PostRepository, c.Sitemap)
+	c.CategoryRepository = sitemap.NewCategoryRepository(c.CategoryRepository, c.Sitemap)
+}
+
+// initSpamFilter는 저장된 댓글로 스팸 분류기를 학습하고 익명 댓글에 적용할 스팸 필터를 구성합니다.
+// 관리자의 검토 결과가 분류기에 반영되도록 댓글 저장소를 감쌉니다.
+func (c *Container) initSpamFilter(ctx context.Context) {
+	c.SpamClassifier = spam.NewClassifier()
+	if learned, err := c.SpamClassifier.Rebuild(ctx, c.CommentRepository); err != nil {
+		log.Printf("스팸 분류기 학습 실패: %v", err)
+	} else {
+		log.Printf("스팸 분류기 학습 완료: %d건", learned)
+	}
+	c.CommentRepository = spam.NewTrainingCommentRepository(c.CommentRepository, c.SpamClassifier, c.Logger)
+
+	minDelay := config.CommentMinSubmitDelay()
+	c.FormTokens = spam.NewFormTokens(config.CommentFormSecret(), minDelay, spam.DefaultFormTokenMaxAge)
+
+	// 봇으로 보이는 요청은 거부하는 필터를 먼저, 내용으로 판정하는 필터를 나중에 적용
+	filters := []spam.Filter{spam.NewHoneypotFilter()}
+	if minDelay > 0 {
+		filters = append(filters, spam.NewTimingFilter(c.FormTokens))
+	}
+	filters = append(filters,
+		spam.NewLinkFilter(config.CommentMaxLinks()),
+		spam.NewBlocklistFilter(config.CommentBlocklist()),
+		spam.NewBayesFilter(c.SpamClassifier),
+	)
+	c.SpamFilter = spam.NewChain(filters...)
 }
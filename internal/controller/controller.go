@@ -1,15 +1,18 @@
 package controller
 
 import (
+	"bumsiku/internal/config"
 	"bumsiku/internal/container"
 	"bumsiku/internal/handler"
 	"bumsiku/internal/middleware"
-	"bumsiku/internal/utils"
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+	"bumsiku/internal/session"
+	"log"
 	"net/http"
-	"os"
+	"time"
 
 	"github.com/gin-contrib/sessions"
-	"github.com/gin-contrib/sessions/cookie"
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
@@ -20,15 +23,27 @@ const SessionStoreName = "loginSession"
 func SetupRouter(container *container.Container) *gin.Engine {
 	// 기본 gin 엔진 대신 새 엔진 생성 (기본 미들웨어 없이)
 	router := gin.New()
+	// gin.Context를 context.Context로 넘겨도 요청 컨텍스트(취소, 요청 ID, 추적 정보)를 따르도록 설정
+	router.ContextWithFallback = true
 
-	// 로거 생성
-	logger := utils.NewLogger(container.CloudWatchClient)
+	// 로거 (로그는 백그라운드에서 모아 LOG_SINKS에 설정한 싱크로 전송)
+	logger := container.Logger
+
+	// 요청 수 제한에 사용할 클라이언트 IP는 신뢰할 수 있는 프록시가 보낸 X-Forwarded-For만 반영
+	if err := router.SetTrustedProxies(config.TrustedProxies()); err != nil {
+		log.Printf("신뢰할 수 있는 프록시 설정 실패: %v", err)
+	}
+	rateLimitStore := middleware.NewMemoryRateLimitStore()
 
 	// 로깅과 복구 미들웨어 추가
 	router.Use(middleware.RecoveryWithLogger(logger))
-	router.Use(middleware.LoggingMiddleware(logger))
+	router.Use(middleware.LoggingMiddleware(logger, middleware.NewRedactor(middleware.RedactOptions{
+		Keys:        config.LogRedactKeys(),
+		MaxBodySize: config.LogBodyMaxSize(),
+	})))
 	router.Use(middleware.ErrorHandlingMiddleware(logger))
-	router.Use(sessions.Sessions(SessionStoreName, newSessionStore()))
+	router.Use(middleware.RateLimitMiddleware("global", rateLimit("global", 300, time.Minute), rateLimitStore, logger))
+	router.Use(sessions.Sessions(SessionStoreName, newSessionStore(container.SessionRepository)))
 
 	// 루트 경로를 스웨거 문서로 리다이렉션
 	router.GET("/", func(c *gin.Context) {
@@ -38,41 +53,117 @@ func SetupRouter(container *container.Container) *gin.Engine {
 	// Static 파일 제공
 	router.StaticFile("/robots.txt", "./static/robots.txt")
 
-	// sitemap.xml 제공
-	router.GET("/sitemap.xml", handler.GetSitemap(container.PostRepository, container.CategoryRepository, logger))
+	// sitemap.xml 제공 (URL이 많으면 사이트맵 색인과 나뉜 사이트맵 파일)
+	router.GET("/sitemap.xml", handler.GetSitemap(container.Sitemap, logger))
+	router.GET("/sitemaps/:page", handler.GetSitemapPage(container.Sitemap, logger))
+
+	// 구독 피드 (RSS 2.0, Atom 1.0, JSON Feed 1.1)
+	router.GET("/feed.xml", handler.GetRSSFeed(container.PostRepository, logger))
+	router.GET("/atom.xml", handler.GetAtomFeed(container.PostRepository, logger))
+	router.GET("/feed.json", handler.GetJSONFeed(container.PostRepository, logger))
+	router.GET("/category/:name/feed.xml", handler.GetRSSFeed(container.PostRepository, logger))
+	router.GET("/category/:name/atom.xml", handler.GetAtomFeed(container.PostRepository, logger))
+	router.GET("/category/:name/feed.json", handler.GetJSONFeed(container.PostRepository, logger))
 
 	// Swagger
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
 	// Public Endpoints
-	router.POST("/login", func(c *gin.Context) {
-		handler.PostLogin(c, logger)
-	})
+	// 로그인 무차별 대입과 댓글 도배를 막기 위해 별도로 제한
+	loginRateLimit := middleware.RateLimitMiddleware("login", rateLimit("login", 5, time.Minute), rateLimitStore, logger)
+	commentRateLimit := middleware.RateLimitMiddleware("comment", rateLimit("comment", 5, time.Minute), rateLimitStore, logger)
+
+	router.POST("/login", loginRateLimit, handler.PostLogin(container.UserRepository, container.LoginThrottle, logger))
+	router.POST("/login/totp", loginRateLimit, handler.PostLoginTOTP(container.UserRepository, container.LoginThrottle, logger))
+	router.POST("/logout", handler.PostLogout(logger))
+	router.GET("/csrf", middleware.SessionAuthMiddleware(container.UserRepository, logger), handler.GetCSRFToken(logger))
 	router.GET("/posts", handler.GetPosts(container.PostRepository, logger))
 	router.GET("/posts/:id", handler.GetPostByID(container.PostRepository, logger))
+	router.GET("/search", handler.SearchPosts(container.SearchIndex, logger))
 	router.GET("/comments/:id", handler.GetCommentsByPostID(container.CommentRepository, logger))
-	router.POST("/comments/:postId", handler.CreateComment(container.CommentRepository, container.PostRepository, logger))
+	router.GET("/comments/:id/form", handler.GetCommentForm(container.PostRepository, container.FormTokens, logger))
+	router.POST("/comments/:postId", commentRateLimit, handler.CreateComment(container.CommentRepository, container.PostRepository, config.CommentModerationEnabled(), container.SpamFilter, logger))
 	router.GET("/categories", handler.GetCategories(container.CategoryRepository, logger))
+	router.GET("/tags", handler.GetTags(container.TagRepository, logger))
 
 	// Secured Endpoints
+	// 로그인 세션 또는 Authorization: Bearer API 토큰으로 인증
+	// 로그인 세션의 변경 요청은 X-CSRF-Token 헤더도 확인
 	admin := router.Group("/admin")
-	admin.Use(middleware.SessionAuthMiddleware())
-	admin.POST("/posts", handler.CreatePost(container.PostRepository, logger))
-	admin.PUT("/posts/:id", handler.UpdatePost(container.PostRepository, logger))
-	admin.DELETE("/posts/:id", handler.DeletePost(container.PostRepository, container.CommentRepository, logger))
-	admin.DELETE("/comments/:commentId", handler.DeleteComment(container.CommentRepository, logger))
-	admin.PUT("/categories", handler.UpdateCategory(container.CategoryRepository, logger))
-	admin.POST("/images", handler.UploadImage(container.S3Client, logger))
+	admin.Use(middleware.AuthMiddleware(container.UserRepository, container.APITokenRepository, logger))
+	admin.Use(middleware.CSRFMiddleware())
+
+	// 역할별 권한: owner는 모든 작업, editor는 게시글/카테고리/태그/이미지와 댓글, moderator는 댓글 관리만 가능
+	editor := middleware.RequireRole(model.UserRoleEditor)
+	moderator := middleware.RequireRole(model.UserRoleEditor, model.UserRoleModerator)
+	owner := middleware.RequireRole()
+
+	// API 토큰은 역할에 더해 토큰의 권한 범위도 확인
+	postsRead := middleware.RequireScope(model.APITokenScopePostsRead)
+	postsWrite := middleware.RequireScope(model.APITokenScopePostsWrite)
+	imagesWrite := middleware.RequireScope(model.APITokenScopeImagesWrite)
+	commentsRead := middleware.RequireScope(model.APITokenScopeCommentsRead)
+	commentsWrite := middleware.RequireScope(model.APITokenScopeCommentsWrite)
+	taxonomyWrite := middleware.RequireScope(model.APITokenScopeTaxonomyWrite)
+
+	admin.GET("/posts", editor, postsRead, handler.GetAdminPosts(container.PostRepository, logger))
+	admin.GET("/posts/:id", editor, postsRead, handler.GetAdminPostByID(container.PostRepository, logger))
+	admin.POST("/posts", editor, postsWrite, handler.CreatePost(container.PostRepository, container.RevisionRepository, logger))
+	admin.PUT("/posts/:id", editor, postsWrite, handler.UpdatePost(container.PostRepository, container.RevisionRepository, logger))
+	admin.PUT("/posts/:id/status", editor, postsWrite, handler.UpdatePostStatus(container.PostRepository, logger))
+	admin.GET("/posts/:id/revisions", editor, postsRead, handler.GetPostRevisions(container.PostRepository, container.RevisionRepository, logger))
+	admin.GET("/posts/:id/revisions/:rev/diff", editor, postsRead, handler.GetPostRevisionDiff(container.PostRepository, container.RevisionRepository, logger))
+	admin.POST("/posts/:id/revisions/:rev/restore", editor, postsWrite, handler.RestorePostRevision(container.PostRepository, container.RevisionRepository, logger))
+	admin.DELETE("/posts/:id", editor, postsWrite, handler.DeletePost(container.PostRepository, container.CommentRepository, container.RevisionRepository, logger))
+	admin.GET("/comments", moderator, commentsRead, handler.GetComments(container.CommentRepository, logger))
+	admin.POST("/comments/moderate", moderator, commentsWrite, handler.ModerateComments(container.CommentRepository, logger))
+	admin.DELETE("/comments/:commentId", moderator, commentsWrite, handler.DeleteComment(container.CommentRepository, logger))
+	admin.PUT("/categories", editor, taxonomyWrite, handler.UpdateCategory(container.CategoryRepository, logger))
+	admin.PUT("/tags/:tag", editor, taxonomyWrite, handler.RenameTag(container.PostRepository, container.TagRepository, logger))
+	admin.POST("/tags/:tag/merge", editor, taxonomyWrite, handler.MergeTag(container.PostRepository, container.TagRepository, logger))
+	admin.POST("/images", editor, imagesWrite, handler.UploadImage(container.S3Client, logger))
+
+	// 계정, 2단계 인증, 로그인 세션, API 토큰 관리는 로그인 세션으로만 가능
+	account := admin.Group("", middleware.SessionOnly())
+	account.GET("/me", handler.GetCurrentUser(container.UserRepository, logger))
+	account.POST("/me/totp", handler.EnrollTOTP(container.UserRepository, config.TOTPIssuer(), logger))
+	account.POST("/me/totp/confirm", handler.ConfirmTOTP(container.UserRepository, container.LoginThrottle, logger))
+	account.DELETE("/me/totp", handler.DisableTOTP(container.UserRepository, container.LoginThrottle, logger))
+	account.GET("/sessions", handler.GetSessions(container.SessionRepository, logger))
+	account.DELETE("/sessions/:id", handler.DeleteSession(container.SessionRepository, logger))
+	account.GET("/tokens", handler.GetAPITokens(container.APITokenRepository, logger))
+	account.POST("/tokens", handler.CreateAPIToken(container.APITokenRepository, logger))
+	account.DELETE("/tokens/:id", handler.DeleteAPIToken(container.APITokenRepository, logger))
+	account.GET("/users", owner, handler.GetUsers(container.UserRepository, logger))
+	account.POST("/users", owner, handler.CreateUser(container.UserRepository, logger))
+	account.PUT("/users/:username", owner, handler.UpdateUser(container.UserRepository, logger))
+	account.DELETE("/users/:username", owner, handler.DeleteUser(container.UserRepository, container.APITokenRepository, logger))
 
 	return router
 }
 
-func newSessionStore() sessions.Store {
-	store := cookie.NewStore([]byte(os.Getenv("SESSION_SECRET")))
-	store.Options(sessions.Options{
-		MaxAge:   7200,
+// rateLimit은 환경 변수(RATE_LIMIT_<그룹>)로 덮어쓸 수 있는 라우트 그룹의 요청 수 제한을 생성합니다.
+func rateLimit(group string, defaultRequests int, defaultPer time.Duration) middleware.RateLimit {
+	requests, per := config.RateLimit(group, defaultRequests, defaultPer)
+	return middleware.RateLimit{Requests: requests, Per: per}
+}
+
+// newSessionStore는 로그인 세션을 저장소에 보관하는 세션 저장소를 생성합니다.
+// 쿠키의 Secure, SameSite 속성과 세션 만료 시간은 환경 변수(SESSION_*)로 설정합니다.
+func newSessionStore(sessionRepo repository.SessionRepositoryInterface) sessions.Store {
+	secure := config.SessionCookieSecure()
+	sameSite := config.SessionCookieSameSite()
+	// 브라우저는 Secure가 아닌 SameSite=None 쿠키를 거부함
+	if sameSite == http.SameSiteNoneMode && !secure {
+		log.Printf("SESSION_COOKIE_SAMESITE=none은 Secure 쿠키에만 사용할 수 있어 Secure 속성을 설정합니다")
+		secure = true
+	}
+
+	return session.NewStore(sessionRepo, config.SessionIdleTimeout(), sessions.Options{
+		MaxAge:   int(config.SessionMaxAge().Seconds()),
 		Path:     "/",
 		HttpOnly: true,
+		Secure:   secure,
+		SameSite: sameSite,
 	})
-	return store
 }
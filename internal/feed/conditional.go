@@ -0,0 +1,41 @@
+package feed
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ETag는 피드 본문의 해시로 강한 ETag 값을 생성합니다.
+func ETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:8]) + `"`
+}
+
+// NotModified는 조건부 GET 헤더로 보아 클라이언트의 캐시가 유효한지 확인합니다.
+// If-None-Match가 있으면 If-Modified-Since는 무시합니다 (RFC 9110 13.2.2).
+// lastModified가 zero 값이면 If-Modified-Since로는 판단하지 않습니다.
+func NotModified(ifNoneMatch, ifModifiedSince, etag string, lastModified time.Time) bool {
+	if strings.TrimSpace(ifNoneMatch) != "" {
+		// If-None-Match는 약한 비교를 사용하므로 W/ 접두사를 무시
+		for _, candidate := range strings.Split(ifNoneMatch, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == "*" || candidate == etag {
+				return true
+			}
+		}
+		return false
+	}
+
+	if ifModifiedSince == "" || lastModified.IsZero() {
+		return false
+	}
+	since, err := http.ParseTime(ifModifiedSince)
+	if err != nil {
+		return false
+	}
+	// HTTP 날짜는 초 단위이므로 비교 전에 절삭
+	return !lastModified.Truncate(time.Second).After(since)
+}
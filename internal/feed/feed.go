@@ -0,0 +1,46 @@
+package feed
+
+import (
+	"time"
+
+	"github.com/russross/blackfriday/v2"
+)
+
+// Feed는 RSS, Atom, JSON Feed로 변환할 수 있는 구독 피드입니다.
+type Feed struct {
+	Title       string
+	Description string
+	Language    string
+	Author      string
+	SiteURL     string // 블로그 주소
+	FeedURL     string // 피드 자신의 주소 (self 링크)
+	Items       []Item
+}
+
+// Item은 피드 항목 하나(게시글)입니다.
+type Item struct {
+	ID          string // 항목의 고유 식별자 (게시글 URL)
+	URL         string
+	Title       string
+	Summary     string
+	ContentHTML string // 비어 있으면 요약만 포함
+	Categories  []string
+	Published   time.Time
+	Updated     time.Time
+}
+
+// Updated는 피드 항목 중 가장 최근 수정 시간을 반환합니다. 항목이 없으면 zero 값입니다.
+func (f *Feed) Updated() time.Time {
+	var updated time.Time
+	for _, item := range f.Items {
+		if item.Updated.After(updated) {
+			updated = item.Updated
+		}
+	}
+	return updated
+}
+
+// RenderMarkdown은 게시글 본문(Markdown)을 피드에 넣을 HTML로 변환합니다.
+func RenderMarkdown(markdown string) string {
+	return string(blackfriday.Run([]byte(markdown)))
+}
@@ -0,0 +1,196 @@
+package feed
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"bumsiku/internal/feed"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var baseTime = time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+
+func newFeed(items ...feed.Item) *feed.Feed {
+	return &feed.Feed{
+		Title:       "bumsiku.kr",
+		Description: "새 글",
+		Language:    "ko",
+		Author:      "bumsiku",
+		SiteURL:     "https://bumsiku.kr",
+		FeedURL:     "https://bumsiku.kr/feed.xml",
+		Items:       items,
+	}
+}
+
+func newItem(id, title string, offset time.Duration) feed.Item {
+	return feed.Item{
+		ID:         "https://bumsiku.kr/post/" + id,
+		URL:        "https://bumsiku.kr/post/" + id,
+		Title:      title,
+		Summary:    title + " 요약",
+		Categories: []string{"tech", "golang"},
+		Published:  baseTime.Add(offset),
+		Updated:    baseTime.Add(offset),
+	}
+}
+
+// [GIVEN] 본문을 포함한 항목과 특수 문자가 있는 제목
+// [WHEN] RSS 생성
+// [THEN] 올바른 XML이며 self 링크, content:encoded, 이스케이프된 제목 확인
+func TestRSS(t *testing.T) {
+	item := newItem("1", "제목 <&>", 0)
+	item.ContentHTML = "<p>본문 ]]> 끝</p>"
+	f := newFeed(item)
+
+	body, err := f.RSS()
+	require.NoError(t, err)
+
+	var parsed struct {
+		Channel struct {
+			Title string `xml:"title"`
+			Items []struct {
+				Title   string `xml:"title"`
+				GUID    string `xml:"guid"`
+				PubDate string `xml:"pubDate"`
+				Content string `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+			} `xml:"item"`
+		} `xml:"channel"`
+	}
+	require.NoError(t, xml.Unmarshal(body, &parsed))
+
+	require.Len(t, parsed.Channel.Items, 1)
+	assert.Equal(t, "제목 <&>", parsed.Channel.Items[0].Title)
+	assert.Equal(t, item.URL, parsed.Channel.Items[0].GUID)
+	assert.Equal(t, "Wed, 01 May 2024 09:00:00 +0000", parsed.Channel.Items[0].PubDate)
+	assert.Equal(t, item.ContentHTML, parsed.Channel.Items[0].Content)
+	assert.Contains(t, string(body), `<atom:link href="https://bumsiku.kr/feed.xml" rel="self"`)
+}
+
+// [GIVEN] 본문이 없는 항목
+// [WHEN] RSS 생성
+// [THEN] content:encoded가 생략됨 확인
+func TestRSS_WithoutContent(t *testing.T) {
+	body, err := newFeed(newItem("1", "제목", 0)).RSS()
+	require.NoError(t, err)
+
+	assert.NotContains(t, string(body), "content:encoded")
+	assert.Contains(t, string(body), "<description>제목 요약</description>")
+}
+
+// [GIVEN] 수정 시간이 다른 항목 두 개
+// [WHEN] Atom 생성
+// [THEN] 피드 updated가 가장 최근 수정 시간이고 항목이 모두 포함됨 확인
+func TestAtom(t *testing.T) {
+	f := newFeed(newItem("2", "새 글", time.Hour), newItem("1", "이전 글", 0))
+
+	body, err := f.Atom()
+	require.NoError(t, err)
+
+	var parsed struct {
+		XMLName xml.Name `xml:"http://www.w3.org/2005/Atom feed"`
+		ID      string   `xml:"id"`
+		Updated string   `xml:"updated"`
+		Entries []struct {
+			ID       string `xml:"id"`
+			Title    string `xml:"title"`
+			Category []struct {
+				Term string `xml:"term,attr"`
+			} `xml:"category"`
+		} `xml:"entry"`
+	}
+	require.NoError(t, xml.Unmarshal(body, &parsed))
+
+	assert.Equal(t, "https://bumsiku.kr/feed.xml", parsed.ID)
+	assert.Equal(t, "2024-05-01T10:00:00Z", parsed.Updated)
+	require.Len(t, parsed.Entries, 2)
+	assert.Equal(t, "새 글", parsed.Entries[0].Title)
+	require.Len(t, parsed.Entries[0].Category, 2)
+	assert.Equal(t, "golang", parsed.Entries[0].Category[1].Term)
+}
+
+// [GIVEN] 본문이 있는 항목과 없는 항목
+// [WHEN] JSON Feed 생성
+// [THEN] 버전과 content_html, content_text 대체 확인
+func TestJSON(t *testing.T) {
+	withContent := newItem("2", "본문 있음", time.Hour)
+	withContent.ContentHTML = "<p>본문</p>"
+	f := newFeed(withContent, newItem("1", "본문 없음", 0))
+
+	body, err := f.JSON()
+	require.NoError(t, err)
+
+	var parsed map[string]interface{}
+	require.NoError(t, json.Unmarshal(body, &parsed))
+
+	assert.Equal(t, feed.JSONFeedVersion, parsed["version"])
+	assert.Equal(t, "https://bumsiku.kr/feed.xml", parsed["feed_url"])
+
+	items := parsed["items"].([]interface{})
+	require.Len(t, items, 2)
+	first := items[0].(map[string]interface{})
+	second := items[1].(map[string]interface{})
+	assert.Equal(t, "<p>본문</p>", first["content_html"])
+	assert.NotContains(t, first, "content_text")
+	assert.Equal(t, "본문 없음 요약", second["content_text"])
+	assert.Equal(t, "2024-05-01T09:00:00Z", second["date_published"])
+}
+
+// [GIVEN] Markdown 본문
+// [WHEN] RenderMarkdown 호출
+// [THEN] HTML 변환 확인
+func TestRenderMarkdown(t *testing.T) {
+	html := feed.RenderMarkdown("# 제목\n\n**굵게** 그리고 `코드`")
+
+	assert.Contains(t, html, "<h1>제목</h1>")
+	assert.Contains(t, html, "<strong>굵게</strong>")
+	assert.Contains(t, html, "<code>코드</code>")
+}
+
+// [GIVEN] 같은 본문과 다른 본문
+// [WHEN] ETag 생성
+// [THEN] 같은 본문은 같은 값, 다른 본문은 다른 값이며 따옴표로 감싸짐 확인
+func TestETag(t *testing.T) {
+	etag := feed.ETag([]byte("body"))
+
+	assert.Equal(t, etag, feed.ETag([]byte("body")))
+	assert.NotEqual(t, etag, feed.ETag([]byte("other")))
+	assert.True(t, strings.HasPrefix(etag, `"`) && strings.HasSuffix(etag, `"`))
+}
+
+// [GIVEN] 여러 조건부 GET 헤더 조합
+// [WHEN] NotModified 호출
+// [THEN] 캐시 유효 여부 확인
+func TestNotModified(t *testing.T) {
+	etag := `"abc"`
+	lastModified := baseTime.Add(500 * time.Millisecond)
+
+	tests := []struct {
+		name            string
+		ifNoneMatch     string
+		ifModifiedSince string
+		expected        bool
+	}{
+		{"조건 없음", "", "", false},
+		{"ETag 일치", `"abc"`, "", true},
+		{"약한 ETag 일치", `W/"abc"`, "", true},
+		{"목록 중 일치", `"xyz", "abc"`, "", true},
+		{"와일드카드", "*", "", true},
+		{"ETag 불일치", `"xyz"`, "", false},
+		{"ETag 불일치 시 날짜 무시", `"xyz"`, baseTime.Add(time.Hour).Format(http.TimeFormat), false},
+		{"수정 이후 날짜", "", baseTime.Format(http.TimeFormat), true},
+		{"수정 이전 날짜", "", baseTime.Add(-time.Second).Format(http.TimeFormat), false},
+		{"잘못된 날짜", "", "yesterday", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, feed.NotModified(tt.ifNoneMatch, tt.ifModifiedSince, etag, lastModified))
+		})
+	}
+}
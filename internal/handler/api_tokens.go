@@ -0,0 +1,214 @@
+package handler
+
+import (
+	"bumsiku/internal/auth"
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+	"bumsiku/internal/utils"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// API 토큰 유효 기간 (일)
+const (
+	defaultAPITokenExpiryDays = 90
+	maxAPITokenExpiryDays     = 365
+)
+
+// GetAPITokensResponse API 토큰 목록 응답 구조체
+type GetAPITokensResponse struct {
+	Tokens []model.APIToken `json:"tokens"` // 발급 시간순 토큰 목록 (토큰 값은 포함하지 않음)
+}
+
+// CreateAPITokenRequest API 토큰 발급 요청 구조체
+type CreateAPITokenRequest struct {
+	Name          string   `json:"name" binding:"required,max=100" example:"GitHub Actions 배포"`        // 토큰 이름 (용도)
+	Scopes        []string `json:"scopes" binding:"required,min=1" example:"posts:write,images:write"` // 권한 범위
+	ExpiresInDays int      `json:"expiresInDays,omitempty" example:"90"`                               // 유효 기간 (일, 기본 90일, 최대 365일)
+}
+
+// CreateAPITokenResponse API 토큰 발급 응답 구조체
+type CreateAPITokenResponse struct {
+	model.APIToken
+	Token string `json:"token" example:"bsk_3f2a9c1d7e5b4a60_Q2hhbmdlTWUhQ2hhbmdlTWUhQ2hhbmdlTWUh"` // 토큰 값 (다시 조회할 수 없음)
+}
+
+// @Summary     API 토큰 목록 조회
+// @Description 로그인한 계정이 발급한 API 토큰 목록을 조회합니다 (토큰 값은 포함하지 않음)
+// @Tags        인증
+// @Accept      json
+// @Produce     json
+// @Security    AdminAuth
+// @Success     200 {object} GetAPITokensResponse
+// @Failure     401 {object} ErrorResponse "인증 실패"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /admin/tokens [get]
+func GetAPITokens(tokenRepo repository.APITokenRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		username := c.GetString("username")
+		tokens, err := tokenRepo.GetTokens(c.Request.Context(), username)
+		if err != nil {
+			contextInfo := map[string]string{
+				"handler":  "GetAPITokens",
+				"step":     "토큰 목록 조회",
+				"username": username,
+			}
+			SendInternalServerErrorWithLogging(c, logger, "API 토큰 목록 조회에 실패했습니다", err, contextInfo)
+			return
+		}
+
+		SendSuccess(c, http.StatusOK, GetAPITokensResponse{Tokens: tokens})
+	}
+}
+
+// @Summary     API 토큰 발급
+// @Description 자동화와 CI 배포에 사용할 API 토큰을 발급합니다. 토큰 값은 응답에서 한 번만 확인할 수 있습니다
+// @Description 토큰은 Authorization: Bearer 헤더로 사용하며, 발급한 계정의 역할이 허용하는 작업 중 권한 범위에 포함된 작업만 할 수 있습니다
+// @Description 권한 범위: posts:read, posts:write, images:write, comments:read, comments:write, taxonomy:write
+// @Tags        인증
+// @Accept      json
+// @Produce     json
+// @Security    AdminAuth
+// @Param       request body CreateAPITokenRequest true "토큰 정보"
+// @Success     201 {object} CreateAPITokenResponse
+// @Failure     400 {object} ErrorResponse "잘못된 요청"
+// @Failure     401 {object} ErrorResponse "인증 실패"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /admin/tokens [post]
+func CreateAPIToken(tokenRepo repository.APITokenRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		contextInfo := map[string]string{
+			"handler":  "CreateAPIToken",
+			"username": c.GetString("username"),
+		}
+
+		// 1. 요청 검증
+		var req CreateAPITokenRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			contextInfo["step"] = "요청 검증"
+			SendBadRequestErrorWithLogging(c, logger, "요청 형식이 올바르지 않습니다", err, contextInfo)
+			return
+		}
+		contextInfo["scopes"] = strings.Join(req.Scopes, ",")
+
+		scopes, message := normalizeAPITokenScopes(req.Scopes)
+		if message == "" && (req.ExpiresInDays < 0 || req.ExpiresInDays > maxAPITokenExpiryDays) {
+			message = fmt.Sprintf("유효 기간은 1~%d일이어야 합니다", maxAPITokenExpiryDays)
+		}
+		if message != "" {
+			contextInfo["step"] = "요청 검증"
+			SendBadRequestErrorWithLogging(c, logger, message, nil, contextInfo)
+			return
+		}
+
+		expiresInDays := req.ExpiresInDays
+		if expiresInDays == 0 {
+			expiresInDays = defaultAPITokenExpiryDays
+		}
+
+		// 2. 토큰 생성
+		value, tokenID, hash, err := auth.GenerateAPIToken()
+		if err != nil {
+			contextInfo["step"] = "토큰 생성"
+			SendInternalServerErrorWithLogging(c, logger, "API 토큰 발급에 실패했습니다", err, contextInfo)
+			return
+		}
+
+		now := time.Now()
+		token := model.APIToken{
+			TokenID:   tokenID,
+			Username:  c.GetString("username"),
+			Name:      strings.TrimSpace(req.Name),
+			Scopes:    scopes,
+			TokenHash: hash,
+			CreatedAt: now,
+			ExpiresAt: now.AddDate(0, 0, expiresInDays),
+		}
+
+		// 3. 토큰 저장
+		if err := tokenRepo.CreateToken(c.Request.Context(), &token); err != nil {
+			contextInfo["step"] = "토큰 저장"
+			SendInternalServerErrorWithLogging(c, logger, "API 토큰 발급에 실패했습니다", err, contextInfo)
+			return
+		}
+
+		contextInfo["tokenId"] = tokenID
+		logger.Info(c.Request.Context(), "API 토큰 발급", contextInfo)
+		SendSuccess(c, http.StatusCreated, CreateAPITokenResponse{APIToken: token, Token: value})
+	}
+}
+
+// @Summary     API 토큰 폐기
+// @Description 로그인한 계정이 발급한 API 토큰을 폐기합니다. owner는 모든 계정의 토큰을 폐기할 수 있습니다
+// @Tags        인증
+// @Accept      json
+// @Produce     json
+// @Security    AdminAuth
+// @Param       id path string true "토큰 ID"
+// @Success     200 {object} map[string]string "폐기 성공"
+// @Failure     401 {object} ErrorResponse "인증 실패"
+// @Failure     404 {object} ErrorResponse "토큰을 찾을 수 없음"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /admin/tokens/{id} [delete]
+func DeleteAPIToken(tokenRepo repository.APITokenRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		tokenID := c.Param("id")
+		contextInfo := map[string]string{
+			"handler":  "DeleteAPIToken",
+			"tokenId":  tokenID,
+			"username": c.GetString("username"),
+		}
+
+		token, err := tokenRepo.GetToken(c.Request.Context(), tokenID)
+		if err != nil {
+			contextInfo["step"] = "토큰 조회"
+			SendInternalServerErrorWithLogging(c, logger, "API 토큰 폐기에 실패했습니다", err, contextInfo)
+			return
+		}
+		// 다른 계정의 토큰은 존재 여부를 드러내지 않음
+		if token == nil || (token.Username != c.GetString("username") && c.GetString("role") != model.UserRoleOwner) {
+			contextInfo["step"] = "토큰 조회"
+			SendNotFoundErrorWithLogging(c, logger, "존재하지 않는 API 토큰입니다", nil, contextInfo)
+			return
+		}
+
+		err = tokenRepo.DeleteToken(c.Request.Context(), tokenID)
+		if _, ok := err.(*repository.APITokenNotFoundError); ok {
+			contextInfo["step"] = "토큰 삭제"
+			SendNotFoundErrorWithLogging(c, logger, "존재하지 않는 API 토큰입니다", err, contextInfo)
+			return
+		}
+		if err != nil {
+			contextInfo["step"] = "토큰 삭제"
+			SendInternalServerErrorWithLogging(c, logger, "API 토큰 폐기에 실패했습니다", err, contextInfo)
+			return
+		}
+
+		contextInfo["owner"] = token.Username
+		logger.Info(c.Request.Context(), "API 토큰 폐기", contextInfo)
+		SendSuccess(c, http.StatusOK, map[string]string{
+			"message": "API 토큰이 폐기되었습니다",
+		})
+	}
+}
+
+// normalizeAPITokenScopes는 권한 범위를 검증하고 중복을 제거합니다. 잘못된 범위가 있으면 오류 메시지를 반환합니다.
+func normalizeAPITokenScopes(scopes []string) ([]string, string) {
+	seen := make(map[string]bool, len(scopes))
+	normalized := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		scope = strings.TrimSpace(scope)
+		if !model.IsValidAPITokenScope(scope) {
+			return nil, fmt.Sprintf("유효하지 않은 권한 범위입니다: %s (%s)", scope, strings.Join(model.APITokenScopes, ", "))
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			normalized = append(normalized, scope)
+		}
+	}
+	return normalized, ""
+}
@@ -3,20 +3,29 @@ package handler
 import (
 	"bumsiku/internal/model"
 	"bumsiku/internal/repository"
+	"bumsiku/internal/spam"
 	"bumsiku/internal/utils"
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
 // CreateCommentRequest는 댓글 생성 요청 구조체입니다.
 type CreateCommentRequest struct {
-	Nickname string `json:"nickname" binding:"required" example:"익명사용자"`   // 닉네임
-	Content  string `json:"content" binding:"required" example:"좋은 글이네요!"` // 댓글 내용
+	Nickname        string `json:"nickname" binding:"required" example:"익명사용자"`   // 닉네임
+	Content         string `json:"content" binding:"required" example:"좋은 글이네요!"` // 댓글 내용
+	ParentCommentID string `json:"parentCommentId" example:"comment-100"`         // 답글을 달 댓글 ID (선택)
+	FormToken       string `json:"formToken" example:"1700000000.c2lnbmF0dXJl"`   // 댓글 작성 폼 토큰 (GET /comments/{id}/form에서 발급)
+	Website         string `json:"website" example:""`                            // 스팸 방지용 숨김 필드 (항상 비워 둠)
 }
 
 // @Summary     댓글 등록
 // @Description 특정 게시물에 새 댓글을 등록합니다
+// @Description parentCommentId를 지정하면 해당 댓글의 답글로 등록하며, 최상위 댓글을 포함해 3단계까지 중첩할 수 있습니다
+// @Description 댓글 검토가 켜져 있으면 pending 상태로 등록되어 관리자가 승인한 뒤 공개됩니다
+// @Description 스팸 필터가 스팸으로 판정한 댓글은 spam 상태로 등록되어 공개되지 않으며, 봇으로 판정된 요청은 거부됩니다
 // @Tags        댓글
 // @Accept      json
 // @Produce     json
@@ -24,13 +33,17 @@ type CreateCommentRequest struct {
 // @Param       request body CreateCommentRequest true "댓글 정보"
 // @Success     201 {object} model.Comment
 // @Failure     400 {object} ErrorResponse "잘못된 요청"
-// @Failure     404 {object} ErrorResponse "게시물을 찾을 수 없음"
+// @Failure     404 {object} ErrorResponse "게시물 또는 부모 댓글을 찾을 수 없음"
+// @Failure     429 {object} ErrorResponse "요청 수 제한 초과 (Retry-After 헤더 참고)"
 // @Failure     500 {object} ErrorResponse "서버 오류"
 // @Router      /comments/{postId} [post]
 // CreateComment는 특정 게시글에 댓글을 등록하는 핸들러입니다.
+// moderate가 true이면 댓글을 검토 대기 상태로 등록하고, spamFilter가 nil이면 스팸 검사를 하지 않습니다.
 func CreateComment(
 	commentRepo repository.CommentRepositoryInterface,
 	postRepo repository.PostRepositoryInterface,
+	moderate bool,
+	spamFilter spam.Filter,
 	logger *utils.Logger,
 ) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -71,7 +84,7 @@ func CreateComment(
 				SendInternalServerErrorWithLogging(c, logger, "게시글 확인 중 오류가 발생했습니다", err, contextInfo)
 				return
 			}
-			if post == nil {
+			if post == nil || !post.IsPublished() {
 				contextInfo := map[string]string{
 					"handler":  "CreateComment",
 					"step":     "게시글 확인",
@@ -88,9 +101,90 @@ func CreateComment(
 			PostID:   postID,
 			Nickname: req.Nickname,
 			Content:  req.Content,
+			Status:   model.CommentStatusApproved,
+		}
+		if moderate {
+			comment.Status = model.CommentStatusPending
+		}
+
+		// 5. 스팸 검사
+		if spamFilter != nil {
+			contextInfo := map[string]string{
+				"handler":  "CreateComment",
+				"step":     "스팸 검사",
+				"postID":   postID,
+				"nickname": req.Nickname,
+				"clientIP": c.ClientIP(),
+			}
+
+			verdict, err := spamFilter.Check(c.Request.Context(), &spam.Submission{
+				PostID:      postID,
+				Nickname:    req.Nickname,
+				Content:     req.Content,
+				ClientIP:    c.ClientIP(),
+				Honeypot:    req.Website,
+				FormToken:   req.FormToken,
+				SubmittedAt: time.Now(),
+			})
+			if err != nil {
+				// 스팸 검사 실패로 댓글 작성을 막지 않음
+				contextInfo["error"] = err.Error()
+				logger.Error(c.Request.Context(), "스팸 검사 실패", contextInfo)
+			} else if verdict != nil {
+				contextInfo["filter"] = verdict.Filter
+				contextInfo["reason"] = verdict.Reason
+				if verdict.Reject {
+					// 어떤 필터에 걸렸는지는 응답에 노출하지 않음
+					SendBadRequestErrorWithLogging(c, logger, "댓글을 등록할 수 없습니다", nil, contextInfo)
+					return
+				}
+				logger.Warn(c.Request.Context(), "스팸 댓글 판정", contextInfo)
+				comment.Status = model.CommentStatusSpam
+			}
+		}
+
+		// 6. 답글이면 부모 댓글 확인 후 중첩 단계 결정
+		if req.ParentCommentID != "" {
+			contextInfo := map[string]string{
+				"handler":         "CreateComment",
+				"step":            "부모 댓글 확인",
+				"postID":          postID,
+				"parentCommentID": req.ParentCommentID,
+				"clientIP":        c.ClientIP(),
+			}
+
+			comments, err := commentRepo.GetComments(c.Request.Context(), &repository.GetCommentsInput{PostID: &postID})
+			if err != nil {
+				SendInternalServerErrorWithLogging(c, logger, "부모 댓글 확인 중 오류가 발생했습니다", err, contextInfo)
+				return
+			}
+
+			var parent *model.Comment
+			for i := range comments {
+				if comments[i].CommentID == req.ParentCommentID {
+					parent = &comments[i]
+					break
+				}
+			}
+			// 공개되지 않은 댓글은 없는 댓글로 취급
+			if parent == nil || !parent.IsApproved() {
+				SendNotFoundErrorWithLogging(c, logger, "답글을 달 댓글을 찾을 수 없습니다", nil, contextInfo)
+				return
+			}
+			if parent.Deleted {
+				SendBadRequestErrorWithLogging(c, logger, "삭제된 댓글에는 답글을 달 수 없습니다", nil, contextInfo)
+				return
+			}
+			if parent.Depth+1 >= model.MaxCommentDepth {
+				SendBadRequestErrorWithLogging(c, logger, fmt.Sprintf("답글은 %d단계까지만 달 수 있습니다", model.MaxCommentDepth-1), nil, contextInfo)
+				return
+			}
+
+			comment.ParentCommentID = parent.CommentID
+			comment.Depth = parent.Depth + 1
 		}
 
-		// 5. 댓글 저장
+		// 7. 댓글 저장
 		createdComment, err := commentRepo.CreateComment(c.Request.Context(), comment)
 		if err != nil {
 			contextInfo := map[string]string{
@@ -110,10 +204,11 @@ func CreateComment(
 			"postID":    postID,
 			"nickname":  req.Nickname,
 			"commentID": createdComment.CommentID,
+			"status":    createdComment.Status,
 			"clientIP":  c.ClientIP(),
 		})
 
-		// 6. 성공 응답
+		// 8. 성공 응답
 		SendSuccess(c, http.StatusCreated, createdComment)
 	}
 }
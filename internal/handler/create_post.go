@@ -13,10 +13,13 @@ import (
 
 // CreatePostRequest는 게시글 생성 요청 구조체입니다.
 type CreatePostRequest struct {
-	Title    string `json:"title" binding:"required" example:"새로운 블로그 게시물"`    // 게시물 제목
-	Content  string `json:"content" binding:"required" example:"게시물 본문 내용..."` // 게시물 내용
-	Summary  string `json:"summary" binding:"required" example:"게시물 요약..."`    // 게시물 요약
-	Category string `json:"category" binding:"required" example:"technology"`  // 카테고리
+	Title     string     `json:"title" binding:"required" example:"새로운 블로그 게시물"`          // 게시물 제목
+	Content   string     `json:"content" binding:"required" example:"게시물 본문 내용..."`       // 게시물 내용
+	Summary   string     `json:"summary" binding:"required" example:"게시물 요약..."`          // 게시물 요약
+	Category  string     `json:"category" binding:"required" example:"technology"`        // 카테고리
+	Tags      []string   `json:"tags,omitempty" example:"golang,concurrency"`             // 태그 (최대 10개, 소문자로 정규화)
+	Status    string     `json:"status,omitempty" example:"draft"`                        // 공개 상태 (기본값: published)
+	PublishAt *time.Time `json:"publishAt,omitempty" example:"2030-01-01T09:00:00+09:00"` // 발행 예정 시간 (scheduled인 경우 필수)
 }
 
 // @Summary     게시물 작성
@@ -25,6 +28,7 @@ type CreatePostRequest struct {
 // @Accept      json
 // @Produce     json
 // @Security    AdminAuth
+// @Security    BearerAuth
 // @Param       request body CreatePostRequest true "게시물 정보"
 // @Success     201 {object} model.Post
 // @Failure     400 {object} ErrorResponse "잘못된 요청"
@@ -32,7 +36,7 @@ type CreatePostRequest struct {
 // @Failure     500 {object} ErrorResponse "서버 오류"
 // @Router      /admin/posts [post]
 // CreatePost는 관리자 전용 게시글 작성 핸들러입니다.
-func CreatePost(postRepo repository.PostRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
+func CreatePost(postRepo repository.PostRepositoryInterface, revisionRepo repository.RevisionRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// 1. 요청 바디 검증
 		var req CreatePostRequest
@@ -45,6 +49,30 @@ func CreatePost(postRepo repository.PostRepositoryInterface, logger *utils.Logge
 			return
 		}
 
+		// 상태를 지정하지 않으면 기존 동작대로 즉시 발행
+		if req.Status == "" {
+			req.Status = model.PostStatusPublished
+		}
+		if !model.IsValidPostStatus(req.Status) {
+			contextInfo := map[string]string{
+				"handler": "CreatePost",
+				"step":    "요청 검증",
+				"status":  req.Status,
+			}
+			SendBadRequestErrorWithLogging(c, logger, "지원하지 않는 게시글 상태입니다", nil, contextInfo)
+			return
+		}
+
+		tags, err := model.NormalizeTags(req.Tags)
+		if err != nil {
+			contextInfo := map[string]string{
+				"handler": "CreatePost",
+				"step":    "요청 검증",
+			}
+			SendBadRequestErrorWithLogging(c, logger, err.Error(), nil, contextInfo)
+			return
+		}
+
 		// 2. nanoid 12자리 생성
 		postID, err := gonanoid.New(12)
 		if err != nil {
@@ -56,8 +84,18 @@ func CreatePost(postRepo repository.PostRepositoryInterface, logger *utils.Logge
 			return
 		}
 
-		// 3. 현재 시간 설정
+		// 3. 현재 시간 설정 및 발행 시간 결정
 		now := time.Now()
+		publishAt, err := resolvePublishAt(req.Status, req.PublishAt, now)
+		if err != nil {
+			contextInfo := map[string]string{
+				"handler": "CreatePost",
+				"step":    "발행 시간 검증",
+				"status":  req.Status,
+			}
+			SendBadRequestErrorWithLogging(c, logger, err.Error(), nil, contextInfo)
+			return
+		}
 
 		// 4. Post 모델 생성
 		post := &model.Post{
@@ -66,6 +104,10 @@ func CreatePost(postRepo repository.PostRepositoryInterface, logger *utils.Logge
 			Content:   req.Content,
 			Summary:   req.Summary,
 			Category:  req.Category,
+			Tags:      tags,
+			Status:    req.Status,
+			PublishAt: publishAt,
+			AuthorID:  sessionUsername(c),
 			CreatedAt: now,
 			UpdatedAt: now,
 		}
@@ -84,12 +126,24 @@ func CreatePost(postRepo repository.PostRepositoryInterface, logger *utils.Logge
 			return
 		}
 
+		// 작성 내용을 첫 리비전으로 기록
+		if err := revisionRepo.CreateRevision(c.Request.Context(), newPostRevision(post, sessionUsername(c), now)); err != nil {
+			// 리비전 기록 실패 로그를 남기지만, 사용자에게는 등록 성공으로 응답
+			logger.Warn(c.Request.Context(), "게시글 등록 성공 후 리비전 기록 실패", map[string]string{
+				"handler": "CreatePost",
+				"step":    "리비전 기록",
+				"postID":  postID,
+				"error":   err.Error(),
+			})
+		}
+
 		// 로그 남기기 - 성공 케이스
 		logger.Info(c.Request.Context(), "게시글이 성공적으로 생성되었습니다", map[string]string{
 			"handler":  "CreatePost",
 			"postID":   postID,
 			"category": req.Category,
 			"title":    req.Title,
+			"status":   req.Status,
 		})
 
 		// 6. 성공 응답
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"bumsiku/internal/auth"
+	"bumsiku/internal/utils"
+	"net/http"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+)
+
+// CSRF 토큰 세션 키와 요청 헤더
+const (
+	// SessionKeyCSRFToken은 로그인할 때 발급한 CSRF 토큰을 세션에 저장하는 키입니다.
+	SessionKeyCSRFToken = "csrfToken"
+	// CSRFHeaderName은 로그인 세션으로 변경 요청(POST, PUT, DELETE)을 보낼 때 CSRF 토큰을 담는 헤더입니다.
+	CSRFHeaderName = "X-CSRF-Token"
+)
+
+// CSRFTokenResponse CSRF 토큰 응답 구조체
+type CSRFTokenResponse struct {
+	CSRFToken string `json:"csrfToken" example:"Q2hhbmdlTWUhQ2hhbmdlTWUhQ2hhbmdlTWUhQ2hhbmdlTWU"` // 변경 요청의 X-CSRF-Token 헤더에 보낼 토큰
+}
+
+// @Summary     CSRF 토큰 조회
+// @Description 로그인 세션의 CSRF 토큰을 조회합니다. 토큰은 로그인할 때 발급되며 로그아웃할 때까지 유지됩니다
+// @Description 로그인 세션으로 /admin에 POST, PUT, DELETE 요청을 보낼 때 X-CSRF-Token 헤더에 담아야 합니다 (API 토큰 요청은 제외)
+// @Tags        인증
+// @Produce     json
+// @Security    AdminAuth
+// @Success     200 {object} CSRFTokenResponse
+// @Failure     401 {object} ErrorResponse "인증 실패"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /csrf [get]
+// GetCSRFToken은 로그인 세션의 CSRF 토큰을 반환하는 핸들러입니다.
+// CSRF 보호 도입 이전에 로그인한 세션에는 토큰을 새로 발급합니다.
+func GetCSRFToken(logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		session := sessions.Default(c)
+		token, _ := session.Get(SessionKeyCSRFToken).(string)
+		if token == "" {
+			var err error
+			if token, err = issueCSRFToken(session); err == nil {
+				err = session.Save()
+			}
+			if err != nil {
+				contextInfo := map[string]string{
+					"handler":  "GetCSRFToken",
+					"step":     "토큰 발급",
+					"username": c.GetString("username"),
+				}
+				SendInternalServerErrorWithLogging(c, logger, "CSRF 토큰 발급에 실패했습니다", err, contextInfo)
+				return
+			}
+		}
+
+		SendSuccess(c, http.StatusOK, CSRFTokenResponse{CSRFToken: token})
+	}
+}
+
+// issueCSRFToken은 새 CSRF 토큰을 발급해 세션에 기록합니다. 세션 저장은 호출하는 쪽에서 합니다.
+func issueCSRFToken(session sessions.Session) (string, error) {
+	token, err := auth.GenerateCSRFToken()
+	if err != nil {
+		return "", err
+	}
+	session.Set(SessionKeyCSRFToken, token)
+	return token, nil
+}
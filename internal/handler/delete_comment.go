@@ -10,10 +10,12 @@ import (
 
 // @Summary     댓글 삭제
 // @Description 블로그 댓글을 삭제합니다 (관리자 전용)
+// @Description 답글이 있는 댓글은 답글을 유지하도록 작성자와 내용만 지우고 삭제 표시합니다
 // @Tags        댓글
 // @Accept      json
 // @Produce     json
 // @Security    AdminAuth
+// @Security    BearerAuth
 // @Param       commentId path string true "댓글 ID"
 // @Success     200 {object} map[string]string "삭제 성공 메시지"
 // @Failure     400 {object} ErrorResponse "잘못된 요청"
@@ -9,11 +9,12 @@ import (
 )
 
 // @Summary     게시물 삭제
-// @Description 블로그 게시물과 관련 댓글을 삭제합니다 (관리자 전용)
+// @Description 블로그 게시물과 관련 댓글, 리비전을 삭제합니다 (관리자 전용)
 // @Tags        게시물
 // @Accept      json
 // @Produce     json
 // @Security    AdminAuth
+// @Security    BearerAuth
 // @Param       id path string true "게시물 ID"
 // @Success     200 {object} map[string]string "삭제 성공 메시지"
 // @Failure     400 {object} ErrorResponse "잘못된 요청"
@@ -22,7 +23,7 @@ import (
 // @Failure     500 {object} ErrorResponse "서버 오류"
 // @Router      /admin/posts/{id} [delete]
 // DeletePost는 관리자 전용 게시글 삭제 핸들러입니다.
-func DeletePost(postRepo repository.PostRepositoryInterface, commentRepo repository.CommentRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
+func DeletePost(postRepo repository.PostRepositoryInterface, commentRepo repository.CommentRepositoryInterface, revisionRepo repository.RevisionRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// 1. 경로 파라미터 확인
 		postID := c.Param("id")
@@ -80,6 +81,18 @@ func DeletePost(postRepo repository.PostRepositoryInterface, commentRepo reposit
 			})
 		}
 
+		// 4. 리비전 삭제
+		err = revisionRepo.DeleteRevisionsByPostID(c.Request.Context(), postID)
+		if err != nil {
+			// 리비전 삭제 실패 로그를 남기지만, 사용자에게는 게시글 삭제 성공으로 응답
+			logger.Warn(c.Request.Context(), "게시글 삭제 성공 후 리비전 삭제 실패", map[string]string{
+				"handler": "DeletePost",
+				"step":    "리비전 삭제",
+				"postID":  postID,
+				"error":   err.Error(),
+			})
+		}
+
 		// 로그 남기기 - 성공 케이스
 		contextInfo := map[string]string{
 			"handler": "DeletePost",
@@ -93,7 +106,7 @@ func DeletePost(postRepo repository.PostRepositoryInterface, commentRepo reposit
 		}
 		logger.Info(c.Request.Context(), "게시글이 성공적으로 삭제되었습니다", contextInfo)
 
-		// 4. 성공 응답
+		// 5. 성공 응답
 		SendSuccess(c, http.StatusOK, map[string]string{
 			"message": "게시글이 성공적으로 삭제되었습니다",
 		})
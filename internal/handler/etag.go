@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// postETag는 게시글 버전으로 ETag 값을 생성합니다.
+func postETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// parseIfMatch는 If-Match 헤더에서 수정 대상 게시글의 기대 버전을 추출합니다.
+// 헤더가 없거나 "*"이면 nil을 반환하여 버전 확인 없이 수정합니다.
+// If-Match는 강한 비교를 사용하므로 약한 ETag(W/)는 허용하지 않습니다.
+func parseIfMatch(header string) (*int64, error) {
+	header = strings.TrimSpace(header)
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+
+	if len(header) < 2 || !strings.HasPrefix(header, `"`) || !strings.HasSuffix(header, `"`) {
+		return nil, errors.New("If-Match 헤더 형식이 올바르지 않습니다")
+	}
+
+	version, err := strconv.ParseInt(header[1:len(header)-1], 10, 64)
+	if err != nil || version < 0 {
+		return nil, errors.New("If-Match 헤더 형식이 올바르지 않습니다")
+	}
+	return &version, nil
+}
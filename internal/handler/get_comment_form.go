@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"bumsiku/internal/repository"
+	"bumsiku/internal/spam"
+	"bumsiku/internal/utils"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CommentFormResponse 댓글 작성 폼 정보 응답 구조체
+type CommentFormResponse struct {
+	FormToken       string `json:"formToken" example:"1700000000.c2lnbmF0dXJl"` // 댓글 등록 시 함께 보낼 폼 토큰
+	HoneypotField   string `json:"honeypotField" example:"website"`             // 화면에 숨기고 비워 둬야 하는 입력 필드 이름
+	MinDelaySeconds int    `json:"minDelaySeconds" example:"3"`                 // 폼 토큰 발급 후 댓글 등록까지 기다려야 하는 최소 시간 (초)
+}
+
+// @Summary     댓글 작성 폼 정보 조회
+// @Description 댓글 작성 폼을 열 때 호출해 폼 토큰과 스팸 방지용 숨김 필드 이름을 받습니다
+// @Description 폼 토큰은 게시물별로 발급되며, 발급 직후 제출하거나 만료된 토큰으로 제출한 댓글은 거부될 수 있습니다
+// @Tags        댓글
+// @Accept      json
+// @Produce     json
+// @Param       id path string true "게시물 ID"
+// @Success     200 {object} CommentFormResponse
+// @Failure     404 {object} ErrorResponse "게시물을 찾을 수 없음"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /comments/{id}/form [get]
+// GetCommentForm은 댓글 작성 폼 토큰을 발급하는 핸들러입니다.
+func GetCommentForm(postRepo repository.PostRepositoryInterface, tokens *spam.FormTokens, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		postID := c.Param("id")
+		contextInfo := map[string]string{
+			"handler":  "GetCommentForm",
+			"step":     "게시글 확인",
+			"postID":   postID,
+			"clientIP": c.ClientIP(),
+		}
+
+		// 댓글을 달 수 있는 게시글에만 토큰 발급
+		post, err := postRepo.GetPostByID(c.Request.Context(), postID)
+		if err != nil {
+			SendInternalServerErrorWithLogging(c, logger, "게시글 확인 중 오류가 발생했습니다", err, contextInfo)
+			return
+		}
+		if post == nil || !post.IsPublished() {
+			SendNotFoundErrorWithLogging(c, logger, "존재하지 않는 게시글입니다", nil, contextInfo)
+			return
+		}
+
+		SendSuccess(c, http.StatusOK, CommentFormResponse{
+			FormToken:       tokens.Issue(postID, time.Now()),
+			HoneypotField:   spam.HoneypotField,
+			MinDelaySeconds: int(tokens.MinDelay / time.Second),
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"bumsiku/internal/model"
 	"bumsiku/internal/repository"
 	"bumsiku/internal/utils"
 	"fmt"
@@ -9,8 +10,28 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-// GetComments는 전체 댓글 또는 특정 게시글의 댓글을 조회하는 핸들러입니다.
-// 쿼리 파라미터로 postId를 받아 해당 게시글의 댓글만 필터링할 수 있습니다.
+// GetCommentsResponse 게시물 댓글 트리 응답 구조체
+type GetCommentsResponse struct {
+	Comments []model.CommentThread `json:"comments"` // 등록순 최상위 댓글과 답글 트리
+}
+
+// @Summary     관리자용 댓글 목록 조회
+// @Description 검토 상태와 관계없이 댓글을 등록순으로 조회합니다 (관리자 전용)
+// @Description status로 검토 대기(pending) 등 상태별 목록을, postId로 특정 게시물의 댓글을 조회할 수 있습니다
+// @Tags        댓글
+// @Accept      json
+// @Produce     json
+// @Security    AdminAuth
+// @Security    BearerAuth
+// @Param       status query string false "검토 상태 (pending, approved, rejected, spam)"
+// @Param       postId query string false "게시물 ID"
+// @Success     200 {object} map[string]interface{} "댓글 목록"
+// @Failure     400 {object} ErrorResponse "잘못된 요청"
+// @Failure     401 {object} ErrorResponse "인증 실패"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /admin/comments [get]
+// GetComments는 전체 댓글 또는 특정 게시글의 댓글을 조회하는 관리자용 핸들러입니다.
+// 쿼리 파라미터로 postId, status를 받아 해당 게시글이나 검토 상태의 댓글만 필터링할 수 있습니다.
 func GetComments(commentRepo repository.CommentRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var input repository.GetCommentsInput
@@ -21,6 +42,20 @@ func GetComments(commentRepo repository.CommentRepositoryInterface, logger *util
 			input.PostID = &postID
 		}
 
+		// 검토 상태 검증
+		status := c.Query("status")
+		if status != "" && !model.IsValidCommentStatus(status) {
+			contextInfo := map[string]string{
+				"handler":  "GetComments",
+				"step":     "파라미터 검증",
+				"status":   status,
+				"clientIP": c.ClientIP(),
+			}
+			SendBadRequestErrorWithLogging(c, logger, "유효하지 않은 댓글 상태입니다 (pending, approved, rejected, spam)", nil, contextInfo)
+			return
+		}
+		input.Status = status
+
 		// 댓글 조회
 		comments, err := commentRepo.GetComments(c.Request.Context(), &input)
 		if err != nil {
@@ -45,6 +80,9 @@ func GetComments(commentRepo repository.CommentRepositoryInterface, logger *util
 		if postID != "" {
 			contextInfo["postID"] = postID
 		}
+		if status != "" {
+			contextInfo["status"] = status
+		}
 
 		logger.Info(c.Request.Context(), "댓글 조회 성공", contextInfo)
 
@@ -55,16 +93,17 @@ func GetComments(commentRepo repository.CommentRepositoryInterface, logger *util
 }
 
 // @Summary     게시물 댓글 조회
-// @Description 특정 게시물에 작성된 댓글 목록을 조회합니다
+// @Description 특정 게시물에 작성된 댓글을 답글 트리로 조회합니다
+// @Description 승인된 댓글만 공개하며, 답글이 남아 있는 삭제되거나 공개되지 않은 댓글은 작성자와 내용 없이 deleted=true로 표시됩니다
 // @Tags        댓글
 // @Accept      json
 // @Produce     json
 // @Param       id path string true "게시물 ID"
-// @Success     200 {object} map[string]interface{} "댓글 목록"
+// @Success     200 {object} GetCommentsResponse "댓글 트리"
 // @Failure     400 {object} ErrorResponse "잘못된 요청"
 // @Failure     500 {object} ErrorResponse "서버 오류"
 // @Router      /comments/{id} [get]
-// GetCommentsByPostID는 특정 게시글의 댓글만 조회하는 핸들러입니다.
+// GetCommentsByPostID는 특정 게시글의 댓글을 답글 트리로 조회하는 핸들러입니다.
 // URL 파라미터로 게시글 ID를 받습니다.
 func GetCommentsByPostID(commentRepo repository.CommentRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -104,8 +143,22 @@ func GetCommentsByPostID(commentRepo repository.CommentRepositoryInterface, logg
 			"clientIP":     c.ClientIP(),
 		})
 
-		SendSuccess(c, http.StatusOK, map[string]interface{}{
-			"comments": comments,
+		SendSuccess(c, http.StatusOK, GetCommentsResponse{
+			Comments: model.BuildCommentThreads(publicComments(comments)),
 		})
 	}
 }
+
+// publicComments는 승인되지 않은 댓글의 작성자와 내용을 지웁니다.
+// 트리를 만들 때 답글이 없는 삭제 표시 댓글은 제외되므로, 승인된 답글이 달린 경우에만 자리가 남습니다.
+func publicComments(comments []model.Comment) []model.Comment {
+	public := make([]model.Comment, 0, len(comments))
+	for _, comment := range comments {
+		if !comment.IsApproved() {
+			comment = comment.Tombstone()
+			comment.Status = ""
+		}
+		public = append(public, comment)
+	}
+	return public
+}
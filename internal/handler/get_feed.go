@@ -0,0 +1,254 @@
+package handler
+
+import (
+	"bumsiku/internal/config"
+	"bumsiku/internal/feed"
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+	"bumsiku/internal/utils"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 구독 피드에 표시할 블로그 정보 (주소는 config.SiteURL)
+const (
+	siteTitle       = "bumsiku.kr"
+	siteDescription = "bumsiku 블로그의 새 글"
+	siteAuthor      = "bumsiku"
+)
+
+// feedSize는 구독 피드에 포함하는 최신 게시글 수입니다.
+const feedSize = 20
+
+// 구독 피드 형식
+const (
+	feedFormatRSS  = "rss"
+	feedFormatAtom = "atom"
+	feedFormatJSON = "json"
+)
+
+// feedContentTypes는 피드 형식별 응답 Content-Type입니다.
+var feedContentTypes = map[string]string{
+	feedFormatRSS:  "application/rss+xml; charset=utf-8",
+	feedFormatAtom: "application/atom+xml; charset=utf-8",
+	feedFormatJSON: "application/feed+json; charset=utf-8",
+}
+
+// feedFileNames는 피드 형식별 경로의 파일 이름입니다.
+var feedFileNames = map[string]string{
+	feedFormatRSS:  "feed.xml",
+	feedFormatAtom: "atom.xml",
+	feedFormatJSON: "feed.json",
+}
+
+// @Summary     RSS 피드
+// @Description 최신 발행 게시물 20개를 RSS 2.0 형식으로 제공합니다
+// @Description full=true이면 본문을 HTML로 변환하여 content:encoded에 포함합니다
+// @Description ETag, Last-Modified를 제공하며 If-None-Match, If-Modified-Since 조건이 맞으면 304를 반환합니다
+// @Tags        피드
+// @Produce     xml
+// @Param       name path string false "카테고리 (카테고리별 피드)"
+// @Param       full query bool false "본문 포함 여부 (기본값: false)"
+// @Success     200 {string} string "RSS 2.0 문서"
+// @Success     304 {string} string "변경 없음"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /feed.xml [get]
+// @Router      /category/{name}/feed.xml [get]
+// GetRSSFeed는 RSS 2.0 피드 핸들러입니다.
+func GetRSSFeed(postRepo repository.PostRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		serveFeed(c, postRepo, logger, feedFormatRSS)
+	}
+}
+
+// @Summary     Atom 피드
+// @Description 최신 발행 게시물 20개를 Atom 1.0 형식으로 제공합니다
+// @Description full=true이면 본문을 HTML로 변환하여 content에 포함합니다
+// @Description ETag, Last-Modified를 제공하며 If-None-Match, If-Modified-Since 조건이 맞으면 304를 반환합니다
+// @Tags        피드
+// @Produce     xml
+// @Param       name path string false "카테고리 (카테고리별 피드)"
+// @Param       full query bool false "본문 포함 여부 (기본값: false)"
+// @Success     200 {string} string "Atom 1.0 문서"
+// @Success     304 {string} string "변경 없음"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /atom.xml [get]
+// @Router      /category/{name}/atom.xml [get]
+// GetAtomFeed는 Atom 1.0 피드 핸들러입니다.
+func GetAtomFeed(postRepo repository.PostRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		serveFeed(c, postRepo, logger, feedFormatAtom)
+	}
+}
+
+// @Summary     JSON 피드
+// @Description 최신 발행 게시물 20개를 JSON Feed 1.1 형식으로 제공합니다
+// @Description full=true이면 본문을 HTML로 변환하여 content_html에 포함하고, 아니면 요약을 content_text로 제공합니다
+// @Description ETag, Last-Modified를 제공하며 If-None-Match, If-Modified-Since 조건이 맞으면 304를 반환합니다
+// @Tags        피드
+// @Produce     json
+// @Param       name path string false "카테고리 (카테고리별 피드)"
+// @Param       full query bool false "본문 포함 여부 (기본값: false)"
+// @Success     200 {string} string "JSON Feed 1.1 문서"
+// @Success     304 {string} string "변경 없음"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /feed.json [get]
+// @Router      /category/{name}/feed.json [get]
+// GetJSONFeed는 JSON Feed 1.1 피드 핸들러입니다.
+func GetJSONFeed(postRepo repository.PostRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		serveFeed(c, postRepo, logger, feedFormatJSON)
+	}
+}
+
+// serveFeed는 최신 발행 게시글로 피드를 생성하고 조건부 GET을 처리하여 응답합니다.
+// 경로에 카테고리(name)가 있으면 해당 카테고리의 게시글만 포함합니다.
+func serveFeed(c *gin.Context, postRepo repository.PostRepositoryInterface, logger *utils.Logger, format string) {
+	category := c.Param("name")
+	full, _ := strconv.ParseBool(c.Query("full"))
+
+	contextInfo := map[string]string{
+		"handler":  "GetFeed",
+		"format":   format,
+		"full":     strconv.FormatBool(full),
+		"clientIP": c.ClientIP(),
+	}
+	if category != "" {
+		contextInfo["category"] = category
+	}
+
+	posts, err := feedPosts(c, postRepo, category, full)
+	if err != nil {
+		contextInfo["step"] = "게시글 조회"
+		SendInternalServerErrorWithLogging(c, logger, "피드 생성에 실패했습니다", err, contextInfo)
+		return
+	}
+
+	f := newFeed(posts, category, format, full)
+
+	var body []byte
+	switch format {
+	case feedFormatAtom:
+		body, err = f.Atom()
+	case feedFormatJSON:
+		body, err = f.JSON()
+	default:
+		body, err = f.RSS()
+	}
+	if err != nil {
+		contextInfo["step"] = "피드 변환"
+		SendInternalServerErrorWithLogging(c, logger, "피드 생성에 실패했습니다", err, contextInfo)
+		return
+	}
+
+	// 조건부 GET 처리 (304 응답에도 검증자 헤더 포함)
+	etag := feed.ETag(body)
+	lastModified := f.Updated()
+	c.Header("ETag", etag)
+	if !lastModified.IsZero() {
+		c.Header("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+	}
+	c.Header("Cache-Control", "public, max-age=300")
+
+	if feed.NotModified(c.GetHeader("If-None-Match"), c.GetHeader("If-Modified-Since"), etag, lastModified) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	// 성공 로깅
+	contextInfo["itemCount"] = fmt.Sprintf("%d", len(posts))
+	logger.Info(c.Request.Context(), "피드 생성 성공", contextInfo)
+
+	c.Data(http.StatusOK, feedContentTypes[format], body)
+}
+
+// feedPosts는 피드에 포함할 최신 발행 게시글을 조회합니다.
+// 목록 조회에는 본문이 없으므로 full이면 게시글마다 상세 조회합니다.
+func feedPosts(c *gin.Context, postRepo repository.PostRepositoryInterface, category string, full bool) ([]model.Post, error) {
+	input := &repository.GetPostsInput{
+		Status:   model.PostStatusPublished,
+		Sort:     repository.SortNewest,
+		Page:     1,
+		PageSize: feedSize,
+	}
+	if category != "" {
+		input.Category = &category
+	}
+
+	output, err := postRepo.GetPosts(c.Request.Context(), input)
+	if err != nil {
+		return nil, err
+	}
+	if !full {
+		return output.Posts, nil
+	}
+
+	posts := make([]model.Post, 0, len(output.Posts))
+	for _, summary := range output.Posts {
+		post, err := postRepo.GetPostByID(c.Request.Context(), summary.PostID)
+		if err != nil {
+			return nil, err
+		}
+		if post != nil {
+			posts = append(posts, *post)
+		}
+	}
+	return posts, nil
+}
+
+// newFeed는 게시글 목록으로 피드를 구성합니다.
+func newFeed(posts []model.Post, category, format string, full bool) *feed.Feed {
+	siteURL := config.SiteURL()
+	f := &feed.Feed{
+		Title:       siteTitle,
+		Description: siteDescription,
+		Language:    "ko",
+		Author:      siteAuthor,
+		SiteURL:     siteURL,
+		FeedURL:     siteURL + "/" + feedFileNames[format],
+		Items:       make([]feed.Item, 0, len(posts)),
+	}
+	if category != "" {
+		escaped := url.PathEscape(category)
+		f.Title = siteTitle + " - " + category
+		f.SiteURL = siteURL + "/category/" + escaped
+		f.FeedURL = siteURL + "/category/" + escaped + "/" + feedFileNames[format]
+	}
+	if full {
+		f.FeedURL += "?full=true"
+	}
+
+	for _, post := range posts {
+		link := siteURL + "/post/" + url.PathEscape(post.PostID)
+
+		// 예약 발행된 게시글은 작성 시간이 아닌 발행 시간을 게시 시간으로 사용
+		published := post.CreatedAt
+		if post.PublishAt != nil {
+			published = *post.PublishAt
+		}
+		updated := post.UpdatedAt
+		if updated.Before(published) {
+			updated = published
+		}
+
+		item := feed.Item{
+			ID:         link,
+			URL:        link,
+			Title:      post.Title,
+			Summary:    post.Summary,
+			Categories: append([]string{post.Category}, post.Tags...),
+			Published:  published,
+			Updated:    updated,
+		}
+		if full {
+			item.ContentHTML = feed.RenderMarkdown(post.Content)
+		}
+		f.Items = append(f.Items, item)
+	}
+
+	return f
+}
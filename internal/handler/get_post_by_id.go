@@ -15,52 +15,84 @@ import (
 // @Produce     json
 // @Param       id path string true "게시물 ID"
 // @Success     200 {object} model.Post
+// @Header      200 {string} ETag "게시물 버전 (수정 시 If-Match로 전달)"
 // @Failure     400 {object} ErrorResponse "잘못된 요청"
 // @Failure     404 {object} ErrorResponse "게시물을 찾을 수 없음"
 // @Failure     500 {object} ErrorResponse "서버 오류"
 // @Router      /posts/{id} [get]
 func GetPostByID(postRepo repository.PostRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		postID := c.Param("id")
-		if postID == "" {
-			contextInfo := map[string]string{
-				"handler": "GetPostByID",
-				"step":    "파라미터 검증",
-			}
-			SendBadRequestErrorWithLogging(c, logger, "게시글 ID가 필요합니다", nil, contextInfo)
-			return
+		getPost(c, postRepo, logger, "GetPostByID", true)
+	}
+}
+
+// @Summary     관리자 게시물 상세 조회
+// @Description 공개 상태와 관계없이 게시물 상세 정보를 조회합니다 (관리자 전용)
+// @Tags        게시물
+// @Accept      json
+// @Produce     json
+// @Security    AdminAuth
+// @Security    BearerAuth
+// @Param       id path string true "게시물 ID"
+// @Success     200 {object} model.Post
+// @Header      200 {string} ETag "게시물 버전 (수정 시 If-Match로 전달)"
+// @Failure     400 {object} ErrorResponse "잘못된 요청"
+// @Failure     401 {object} ErrorResponse "인증 실패"
+// @Failure     404 {object} ErrorResponse "게시물을 찾을 수 없음"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /admin/posts/{id} [get]
+// GetAdminPostByID는 관리자 전용 게시글 상세 조회 핸들러입니다.
+func GetAdminPostByID(postRepo repository.PostRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		getPost(c, postRepo, logger, "GetAdminPostByID", false)
+	}
+}
+
+// getPost는 경로의 게시글 ID로 게시글을 조회하고 응답합니다.
+// publishedOnly가 true이면 발행되지 않은 게시글은 404로 응답합니다.
+func getPost(c *gin.Context, postRepo repository.PostRepositoryInterface, logger *utils.Logger, handlerName string, publishedOnly bool) {
+	postID := c.Param("id")
+	if postID == "" {
+		contextInfo := map[string]string{
+			"handler": handlerName,
+			"step":    "파라미터 검증",
 		}
+		SendBadRequestErrorWithLogging(c, logger, "게시글 ID가 필요합니다", nil, contextInfo)
+		return
+	}
 
-		post, err := postRepo.GetPostByID(c.Request.Context(), postID)
-		if err != nil {
-			contextInfo := map[string]string{
-				"handler": "GetPostByID",
-				"step":    "게시글 조회",
-				"postID":  postID,
-			}
-			SendInternalServerErrorWithLogging(c, logger, "게시글 조회에 실패했습니다", err, contextInfo)
-			return
+	post, err := postRepo.GetPostByID(c.Request.Context(), postID)
+	if err != nil {
+		contextInfo := map[string]string{
+			"handler": handlerName,
+			"step":    "게시글 조회",
+			"postID":  postID,
 		}
+		SendInternalServerErrorWithLogging(c, logger, "게시글 조회에 실패했습니다", err, contextInfo)
+		return
+	}
 
-		if post == nil {
-			contextInfo := map[string]string{
-				"handler": "GetPostByID",
-				"step":    "결과 확인",
-				"postID":  postID,
-			}
-			SendNotFoundErrorWithLogging(c, logger, "게시글을 찾을 수 없습니다", nil, contextInfo)
-			return
+	// 공개 조회에서는 발행되지 않은 게시글을 존재하지 않는 것처럼 처리
+	if post == nil || (publishedOnly && !post.IsPublished()) {
+		contextInfo := map[string]string{
+			"handler": handlerName,
+			"step":    "결과 확인",
+			"postID":  postID,
 		}
+		SendNotFoundErrorWithLogging(c, logger, "게시글을 찾을 수 없습니다", nil, contextInfo)
+		return
+	}
 
-		// 성공 로깅 - 성능 모니터링에 유용
-		logger.Info(c.Request.Context(), "게시글 상세 조회 성공", map[string]string{
-			"handler":  "GetPostByID",
-			"postID":   postID,
-			"title":    post.Title,
-			"category": post.Category,
-			"clientIP": c.ClientIP(),
-		})
+	// 성공 로깅 - 성능 모니터링에 유용
+	logger.Info(c.Request.Context(), "게시글 상세 조회 성공", map[string]string{
+		"handler":  handlerName,
+		"postID":   postID,
+		"title":    post.Title,
+		"category": post.Category,
+		"clientIP": c.ClientIP(),
+	})
 
-		SendSuccess(c, http.StatusOK, post)
-	}
+	// 수정 요청의 If-Match 비교에 사용할 버전
+	c.Header("ETag", postETag(post.Version))
+	SendSuccess(c, http.StatusOK, post)
 }
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"bumsiku/internal/model"
 	"bumsiku/internal/repository"
 	"bumsiku/internal/utils"
 	"fmt"
@@ -10,101 +11,210 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+// maxPageSize는 목록과 검색 결과의 페이지 크기 최댓값입니다. 더 큰 값을 요청하면 이 값으로 줄입니다.
+const maxPageSize = 100
+
 // GetPostsResponse 게시물 목록 응답 구조체
 type GetPostsResponse struct {
-	Posts       interface{} `json:"posts" swaggertype:"array,object"` // 게시물 목록
-	TotalCount  int64       `json:"totalCount" example:"100"`         // 전체 게시물 수
-	CurrentPage int32       `json:"currentPage" example:"1"`          // 현재 페이지
-	TotalPages  int32       `json:"totalPages" example:"10"`          // 전체 페이지 수
+	Posts       interface{} `json:"posts" swaggertype:"array,object"`                // 게시물 목록
+	TotalCount  int64       `json:"totalCount" example:"100"`                        // 전체 게시물 수
+	CurrentPage int32       `json:"currentPage" example:"1"`                         // 현재 페이지
+	TotalPages  int32       `json:"totalPages" example:"10"`                         // 전체 페이지 수
+	NextCursor  string      `json:"nextCursor,omitempty" example:"eyJzIjoiIn0.c2ln"` // 다음 페이지 커서 (마지막 페이지면 생략)
 }
 
 // @Summary     게시물 목록 조회
 // @Description 블로그 게시물 목록을 페이지네이션하여 조회합니다
+// @Description cursor를 지정하면 해당 위치부터 조회하며, 이때 totalCount와 totalPages는 계산하지 않습니다
 // @Tags        게시물
 // @Accept      json
 // @Produce     json
 // @Param       category query string false "카테고리 필터"
+// @Param       tag query string false "태그 필터"
+// @Param       sort query string false "정렬 방식 (기본값: newest)" Enums(newest, oldest, updated)
 // @Param       page query int false "페이지 번호 (기본값: 1)"
-// @Param       pageSize query int false "페이지 크기 (기본값: 10)"
+// @Param       pageSize query int false "페이지 크기 (기본값: 10, 최대: 100)"
+// @Param       cursor query string false "이전 응답의 nextCursor (지정 시 page는 무시)"
 // @Success     200 {object} GetPostsResponse
+// @Failure     400 {object} ErrorResponse "유효하지 않은 커서, 정렬 방식 또는 태그"
 // @Failure     500 {object} ErrorResponse "서버 오류"
 // @Router      /posts [get]
 func GetPosts(postRepo repository.PostRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// 쿼리 파라미터 파싱
-		category := c.Query("category")
-		pageStr := c.Query("page")
-		pageSizeStr := c.Query("pageSize")
-
-		// 기본값 설정
-		page := int32(1)
-		pageSize := int32(10)
-
-		// 페이지 파라미터 처리
-		if p, err := strconv.ParseInt(pageStr, 10, 32); err == nil && p > 0 {
-			page = int32(p)
-		}
+		// 공개 목록에는 발행된 게시글만 노출
+		listPosts(c, postRepo, logger, "GetPosts", model.PostStatusPublished)
+	}
+}
 
-		// 페이지 크기 파라미터 처리
-		if size, err := strconv.ParseInt(pageSizeStr, 10, 32); err == nil && size > 0 {
-			pageSize = int32(size)
+// @Summary     관리자 게시물 목록 조회
+// @Description 초안, 예약, 보관 상태를 포함한 게시물 목록을 조회합니다 (관리자 전용)
+// @Tags        게시물
+// @Accept      json
+// @Produce     json
+// @Security    AdminAuth
+// @Security    BearerAuth
+// @Param       status query string false "상태 필터 (생략 시 전체)" Enums(draft, scheduled, published, archived)
+// @Param       category query string false "카테고리 필터"
+// @Param       tag query string false "태그 필터"
+// @Param       sort query string false "정렬 방식 (기본값: newest)" Enums(newest, oldest, updated)
+// @Param       page query int false "페이지 번호 (기본값: 1)"
+// @Param       pageSize query int false "페이지 크기 (기본값: 10, 최대: 100)"
+// @Param       cursor query string false "이전 응답의 nextCursor (지정 시 page는 무시)"
+// @Success     200 {object} GetPostsResponse
+// @Failure     400 {object} ErrorResponse "잘못된 요청"
+// @Failure     401 {object} ErrorResponse "인증 실패"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /admin/posts [get]
+// GetAdminPosts는 관리자 전용 게시글 목록 조회 핸들러입니다.
+func GetAdminPosts(postRepo repository.PostRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		status := c.Query("status")
+		if status != "" && !model.IsValidPostStatus(status) {
+			contextInfo := map[string]string{
+				"handler": "GetAdminPosts",
+				"step":    "파라미터 검증",
+				"status":  status,
+			}
+			SendBadRequestErrorWithLogging(c, logger, "지원하지 않는 게시글 상태입니다", nil, contextInfo)
+			return
 		}
 
-		// 카테고리 파라미터 처리
-		var categoryPtr *string
-		if category != "" {
-			categoryPtr = &category
-		}
+		listPosts(c, postRepo, logger, "GetAdminPosts", status)
+	}
+}
 
-		// 게시글 목록 조회
-		result, err := postRepo.GetPosts(c.Request.Context(), &repository.GetPostsInput{
-			Category: categoryPtr,
-			Page:     page,
-			PageSize: pageSize,
-		})
+// listPosts는 쿼리 파라미터를 해석하여 게시글 목록을 조회하고 응답합니다.
+// status가 비어 있으면 상태와 관계없이 모든 게시글을 조회합니다.
+func listPosts(c *gin.Context, postRepo repository.PostRepositoryInterface, logger *utils.Logger, handlerName string, status string) {
+	// 쿼리 파라미터 파싱
+	category := c.Query("category")
+	tag := model.NormalizeTag(c.Query("tag"))
+	sort := c.Query("sort")
+	pageStr := c.Query("page")
+	pageSizeStr := c.Query("pageSize")
+	cursor := c.Query("cursor")
+
+	// 기본값 설정
+	page := int32(1)
+	pageSize := int32(10)
+
+	// 페이지 파라미터 처리
+	if p, err := strconv.ParseInt(pageStr, 10, 32); err == nil && p > 0 {
+		page = int32(p)
+	}
+
+	// 페이지 크기 파라미터 처리 (최댓값을 넘으면 최댓값으로 조회)
+	if size, err := strconv.ParseInt(pageSizeStr, 10, 32); err == nil && size > 0 {
+		pageSize = int32(min(size, maxPageSize))
+	}
 
-		if err != nil {
+	// 정렬 파라미터 검증
+	if !repository.IsValidSort(sort) {
+		contextInfo := map[string]string{
+			"handler":  handlerName,
+			"step":     "파라미터 검증",
+			"sort":     sort,
+			"clientIP": c.ClientIP(),
+		}
+		SendBadRequestErrorWithLogging(c, logger, "지원하지 않는 정렬 방식입니다", nil, contextInfo)
+		return
+	}
+
+	// 태그 파라미터 검증 (저장 형식과 같도록 정규화한 값으로 조회)
+	if tag != "" {
+		if err := model.ValidateTag(tag); err != nil {
 			contextInfo := map[string]string{
-				"handler":  "GetPosts",
-				"step":     "게시글 목록 조회",
-				"page":     fmt.Sprintf("%d", page),
-				"pageSize": fmt.Sprintf("%d", pageSize),
+				"handler":  handlerName,
+				"step":     "파라미터 검증",
+				"tag":      tag,
 				"clientIP": c.ClientIP(),
 			}
-
-			if categoryPtr != nil {
-				contextInfo["category"] = *categoryPtr
-			}
-
-			SendInternalServerErrorWithLogging(c, logger, "게시글 목록 조회에 실패했습니다", err, contextInfo)
+			SendBadRequestErrorWithLogging(c, logger, err.Error(), nil, contextInfo)
 			return
 		}
+	}
 
-		// 전체 페이지 수 계산
-		totalPages := (result.TotalCount + int64(pageSize) - 1) / int64(pageSize)
+	// 카테고리 파라미터 처리
+	var categoryPtr *string
+	if category != "" {
+		categoryPtr = &category
+	}
 
-		response := GetPostsResponse{
-			Posts:       result.Posts,
-			TotalCount:  result.TotalCount,
-			CurrentPage: page,
-			TotalPages:  int32(totalPages),
-		}
+	// 커서 파라미터 처리
+	var cursorPtr *string
+	if cursor != "" {
+		cursorPtr = &cursor
+	}
 
-		// 성공 로깅
+	// 게시글 목록 조회
+	result, err := postRepo.GetPosts(c.Request.Context(), &repository.GetPostsInput{
+		Category: categoryPtr,
+		Status:   status,
+		Tag:      tag,
+		Sort:     sort,
+		Page:     page,
+		PageSize: pageSize,
+		Cursor:   cursorPtr,
+	})
+
+	if err != nil {
 		contextInfo := map[string]string{
-			"handler":    "GetPosts",
-			"page":       fmt.Sprintf("%d", page),
-			"pageSize":   fmt.Sprintf("%d", pageSize),
-			"totalCount": fmt.Sprintf("%d", result.TotalCount),
-			"clientIP":   c.ClientIP(),
+			"handler":  handlerName,
+			"step":     "게시글 목록 조회",
+			"page":     fmt.Sprintf("%d", page),
+			"pageSize": fmt.Sprintf("%d", pageSize),
+			"clientIP": c.ClientIP(),
 		}
 
 		if categoryPtr != nil {
 			contextInfo["category"] = *categoryPtr
 		}
 
-		logger.Info(c.Request.Context(), "게시글 목록 조회 성공", contextInfo)
+		// InvalidCursorError 확인
+		if _, ok := err.(*repository.InvalidCursorError); ok {
+			SendBadRequestErrorWithLogging(c, logger, "유효하지 않은 커서입니다", err, contextInfo)
+			return
+		}
+
+		SendInternalServerErrorWithLogging(c, logger, "게시글 목록 조회에 실패했습니다", err, contextInfo)
+		return
+	}
 
-		SendSuccess(c, http.StatusOK, response)
+	response := GetPostsResponse{
+		Posts:      result.Posts,
+		TotalCount: result.TotalCount,
+		NextCursor: result.NextCursor,
 	}
+
+	// 페이지 번호 모드에서만 페이지 정보 계산
+	if cursorPtr == nil {
+		response.CurrentPage = page
+		response.TotalPages = int32((result.TotalCount + int64(pageSize) - 1) / int64(pageSize))
+	}
+
+	// 성공 로깅
+	contextInfo := map[string]string{
+		"handler":    handlerName,
+		"page":       fmt.Sprintf("%d", page),
+		"pageSize":   fmt.Sprintf("%d", pageSize),
+		"totalCount": fmt.Sprintf("%d", result.TotalCount),
+		"clientIP":   c.ClientIP(),
+	}
+
+	if categoryPtr != nil {
+		contextInfo["category"] = *categoryPtr
+	}
+	if cursorPtr != nil {
+		contextInfo["cursor"] = *cursorPtr
+	}
+	if status != "" {
+		contextInfo["status"] = status
+	}
+	if tag != "" {
+		contextInfo["tag"] = tag
+	}
+
+	logger.Info(c.Request.Context(), "게시글 목록 조회 성공", contextInfo)
+
+	SendSuccess(c, http.StatusOK, response)
 }
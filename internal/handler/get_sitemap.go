@@ -1,107 +1,94 @@
 package handler
 
 import (
-	"bumsiku/internal/repository"
+	"bumsiku/internal/feed"
+	"bumsiku/internal/sitemap"
 	"bumsiku/internal/utils"
-	"context"
 	"fmt"
 	"net/http"
-	"time"
+	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
 
-// GetSitemap은 블로그의 모든 게시물과 카테고리를 포함하는 동적 sitemap.xml을 생성합니다.
-func GetSitemap(postRepo *repository.PostRepository, categoryRepo *repository.CategoryRepository, logger *utils.Logger) gin.HandlerFunc {
+// @Summary     사이트맵
+// @Description 메인 페이지, 카테고리, 태그, 발행된 게시물 URL과 게시물 본문의 이미지를 포함한 sitemap.xml을 제공합니다
+// @Description URL이 50,000개를 넘으면 /sitemaps/{page}.xml 파일을 가리키는 사이트맵 색인을 반환합니다
+// @Description 생성 결과는 게시물이나 카테고리가 바뀔 때까지 캐시됩니다
+// @Tags        사이트맵
+// @Produce     xml
+// @Success     200 {string} string "사이트맵 또는 사이트맵 색인"
+// @Success     304 {string} string "변경 없음"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /sitemap.xml [get]
+// GetSitemap은 사이트맵(또는 사이트맵 색인) 핸들러입니다.
+func GetSitemap(generator *sitemap.Generator, logger *utils.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		ctx := context.Background()
+		contextInfo := map[string]string{
+			"handler":  "GetSitemap",
+			"clientIP": c.ClientIP(),
+		}
 
-		// 기본 도메인 URL 설정
-		domain := "https://bumsiku.kr"
+		result, err := generator.Get(c.Request.Context())
+		if err != nil {
+			SendInternalServerErrorWithLogging(c, logger, "사이트맵 생성에 실패했습니다", err, contextInfo)
+			return
+		}
 
-		// 현재 시간 (마지막 수정 시간)
-		now := time.Now().Format("2006-01-02")
+		serveSitemap(c, result, result.Root())
+	}
+}
 
-		// 모든 게시물 가져오기
-		postsInput := &repository.GetPostsInput{
-			Page:     1,
-			PageSize: 999, // 충분히 큰 수로 설정하여 모든 게시물을 가져옵니다
+// @Summary     사이트맵 파일
+// @Description 사이트맵 색인이 가리키는 n번째 사이트맵 파일을 제공합니다
+// @Tags        사이트맵
+// @Produce     xml
+// @Param       page path string true "사이트맵 파일 (예: 1.xml)"
+// @Success     200 {string} string "사이트맵"
+// @Success     304 {string} string "변경 없음"
+// @Failure     404 {object} ErrorResponse "사이트맵 파일 없음"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /sitemaps/{page} [get]
+// GetSitemapPage는 나뉜 사이트맵 파일 핸들러입니다.
+func GetSitemapPage(generator *sitemap.Generator, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pageParam := c.Param("page")
+		contextInfo := map[string]string{
+			"handler":  "GetSitemapPage",
+			"page":     pageParam,
+			"clientIP": c.ClientIP(),
 		}
-		postsOutput, err := postRepo.GetPosts(ctx, postsInput)
+
+		result, err := generator.Get(c.Request.Context())
 		if err != nil {
-			contextInfo := map[string]string{
-				"handler":  "GetSitemap",
-				"step":     "게시물 조회",
-				"clientIP": c.ClientIP(),
-			}
-			logger.Error(ctx, "사이트맵 생성 중 게시물 조회 실패", contextInfo)
-			c.XML(http.StatusInternalServerError, gin.H{"error": "게시물을 가져오는 중 오류가 발생했습니다"})
+			SendInternalServerErrorWithLogging(c, logger, "사이트맵 생성에 실패했습니다", err, contextInfo)
 			return
 		}
 
-		// 모든 카테고리 가져오기
-		categories, err := categoryRepo.GetCategories(ctx)
-		if err != nil {
-			contextInfo := map[string]string{
-				"handler":  "GetSitemap",
-				"step":     "카테고리 조회",
-				"clientIP": c.ClientIP(),
-			}
-			logger.Error(ctx, "사이트맵 생성 중 카테고리 조회 실패", contextInfo)
-			c.XML(http.StatusInternalServerError, gin.H{"error": "카테고리를 가져오는 중 오류가 발생했습니다"})
+		page, err := strconv.Atoi(strings.TrimSuffix(pageParam, ".xml"))
+		if err != nil || !strings.HasSuffix(pageParam, ".xml") || page < 1 || page > len(result.Pages) {
+			SendNotFoundErrorWithLogging(c, logger, "존재하지 않는 사이트맵 파일입니다", fmt.Errorf("invalid sitemap page: %s", pageParam), contextInfo)
 			return
 		}
 
-		// 성공 로깅
-		logger.Info(ctx, "사이트맵 생성 성공", map[string]string{
-			"handler":       "GetSitemap",
-			"postCount":     fmt.Sprintf("%d", len(postsOutput.Posts)),
-			"categoryCount": fmt.Sprintf("%d", len(categories)),
-			"clientIP":      c.ClientIP(),
-			"generatedTime": time.Now().Format(time.RFC3339),
-		})
-
-		// XML 헤더 설정
-		c.Header("Content-Type", "application/xml")
-
-		// sitemap 시작 태그
-		c.String(http.StatusOK, `<?xml version="1.0" encoding="UTF-8"?>
-<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">`)
-
-		// 메인 페이지 URL
-		c.String(http.StatusOK, fmt.Sprintf(`
-  <url>
-    <loc>%s</loc>
-    <lastmod>%s</lastmod>
-    <changefreq>daily</changefreq>
-    <priority>1.0</priority>
-  </url>`, domain, now))
-
-		// 카테고리 URL
-		for _, category := range categories {
-			c.String(http.StatusOK, fmt.Sprintf(`
-  <url>
-    <loc>%s/category/%s</loc>
-    <lastmod>%s</lastmod>
-    <changefreq>weekly</changefreq>
-    <priority>0.8</priority>
-  </url>`, domain, category.Category, now))
-		}
+		serveSitemap(c, result, result.Pages[page-1])
+	}
+}
 
-		// 게시물 URL
-		for _, post := range postsOutput.Posts {
-			lastmod := post.UpdatedAt.Format("2006-01-02")
-			c.String(http.StatusOK, fmt.Sprintf(`
-  <url>
-    <loc>%s/post/%s</loc>
-    <lastmod>%s</lastmod>
-    <changefreq>monthly</changefreq>
-    <priority>0.6</priority>
-  </url>`, domain, post.PostID, lastmod))
-		}
+// serveSitemap은 검증자 헤더를 설정하고 조건부 GET이면 304, 아니면 본문을 응답합니다.
+func serveSitemap(c *gin.Context, result *sitemap.Result, body []byte) {
+	etag := feed.ETag(body)
+	c.Header("ETag", etag)
+	if !result.LastModified.IsZero() {
+		c.Header("Last-Modified", result.LastModified.UTC().Format(http.TimeFormat))
+	}
+	c.Header("Cache-Control", "public, max-age=300")
 
-		// sitemap 종료 태그
-		c.String(http.StatusOK, `
-</urlset>`)
+	if feed.NotModified(c.GetHeader("If-None-Match"), c.GetHeader("If-Modified-Since"), etag, result.LastModified) {
+		c.Status(http.StatusNotModified)
+		return
 	}
+
+	c.Data(http.StatusOK, "application/xml; charset=utf-8", body)
 }
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+	"bumsiku/internal/utils"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetTagsResponse 태그 목록 응답 구조체
+type GetTagsResponse struct {
+	Tags []model.Tag `json:"tags"` // 태그 목록 (게시글 수 내림차순)
+}
+
+// @Summary     태그 목록 조회
+// @Description 발행된 게시물에 달린 태그와 태그별 게시물 수를 게시물 수가 많은 순으로 조회합니다
+// @Tags        태그
+// @Accept      json
+// @Produce     json
+// @Success     200 {object} GetTagsResponse
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /tags [get]
+func GetTags(tagRepo repository.TagRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 태그 목록 조회
+		tags, err := tagRepo.GetTags(c.Request.Context())
+		if err != nil {
+			contextInfo := map[string]string{
+				"handler":  "GetTags",
+				"step":     "태그 목록 조회",
+				"clientIP": c.ClientIP(),
+			}
+			SendInternalServerErrorWithLogging(c, logger, "태그 목록 조회에 실패했습니다", err, contextInfo)
+			return
+		}
+
+		// 성공 로깅
+		logger.Info(c.Request.Context(), "태그 목록 조회 성공", map[string]string{
+			"handler":  "GetTags",
+			"tagCount": fmt.Sprintf("%d", len(tags)),
+			"clientIP": c.ClientIP(),
+		})
+
+		SendSuccess(c, http.StatusOK, GetTagsResponse{Tags: tags})
+	}
+}
@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"bumsiku/internal/auth"
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 핸들러 모의 함수 - 로거를 사용하지 않도록 구현
+func MockCreateAPIToken(tokenRepo repository.APITokenRepositoryInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req struct {
+			Name          string   `json:"name" binding:"required"`
+			Scopes        []string `json:"scopes" binding:"required,min=1"`
+			ExpiresInDays int      `json:"expiresInDays"`
+		}
+		if err := c.ShouldBindJSON(&req); err != nil {
+			sendUserError(c, http.StatusBadRequest, "BAD_REQUEST", "요청 형식이 올바르지 않습니다")
+			return
+		}
+		for _, scope := range req.Scopes {
+			if !model.IsValidAPITokenScope(scope) {
+				sendUserError(c, http.StatusBadRequest, "BAD_REQUEST", "유효하지 않은 권한 범위입니다: "+scope)
+				return
+			}
+		}
+		if req.ExpiresInDays == 0 {
+			req.ExpiresInDays = 90
+		}
+
+		value, tokenID, hash, err := auth.GenerateAPIToken()
+		if err != nil {
+			sendUserError(c, http.StatusInternalServerError, "INTERNAL_SERVER_ERROR", "API 토큰 발급에 실패했습니다")
+			return
+		}
+		now := time.Now()
+		token := model.APIToken{
+			TokenID:   tokenID,
+			Username:  c.GetString("username"),
+			Name:      req.Name,
+			Scopes:    req.Scopes,
+			TokenHash: hash,
+			CreatedAt: now,
+			ExpiresAt: now.AddDate(0, 0, req.ExpiresInDays),
+		}
+		if err := tokenRepo.CreateToken(c.Request.Context(), &token); err != nil {
+			sendUserError(c, http.StatusInternalServerError, "INTERNAL_SERVER_ERROR", "API 토큰 발급에 실패했습니다")
+			return
+		}
+
+		c.JSON(http.StatusCreated, gin.H{
+			"success": true,
+			"data": gin.H{
+				"tokenId":   token.TokenID,
+				"name":      token.Name,
+				"scopes":    token.Scopes,
+				"expiresAt": token.ExpiresAt,
+				"token":     value,
+			},
+		})
+	}
+}
+
+// [GIVEN] 올바른 토큰 이름과 권한 범위
+// [WHEN] CreateAPIToken 핸들러를 호출
+// [THEN] 상태코드 201과 토큰 값을 한 번 반환하고, 저장소에는 해시만 저장됨 확인
+func TestCreateAPIToken_Success(t *testing.T) {
+	tokenRepo := repository.NewMemoryAPITokenRepository()
+	c, w := SetupTestContext("POST", "/admin/tokens", `{"name": "CI", "scopes": ["posts:write", "images:write"]}`)
+	c.Set("username", "editor1")
+
+	MockCreateAPIToken(tokenRepo)(c)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var response map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	data := response["data"].(map[string]interface{})
+	value := data["token"].(string)
+	assert.True(t, strings.HasPrefix(value, auth.APITokenPrefix))
+
+	tokens, err := tokenRepo.GetTokens(context.Background(), "editor1")
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, data["tokenId"], tokens[0].TokenID)
+	assert.Equal(t, auth.HashAPIToken(value), tokens[0].TokenHash)
+	assert.NotContains(t, tokens[0].TokenHash, value)
+	assert.WithinDuration(t, time.Now().AddDate(0, 0, 90), tokens[0].ExpiresAt, time.Minute)
+}
+
+// [GIVEN] 지원하지 않는 권한 범위
+// [WHEN] CreateAPIToken 핸들러를 호출
+// [THEN] 상태코드 400 반환 확인
+func TestCreateAPIToken_InvalidScope(t *testing.T) {
+	tokenRepo := repository.NewMemoryAPITokenRepository()
+	c, w := SetupTestContext("POST", "/admin/tokens", `{"name": "CI", "scopes": ["users:write"]}`)
+	c.Set("username", "editor1")
+
+	MockCreateAPIToken(tokenRepo)(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	tokens, err := tokenRepo.GetTokens(context.Background(), "editor1")
+	require.NoError(t, err)
+	assert.Empty(t, tokens)
+}
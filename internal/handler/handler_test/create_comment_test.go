@@ -2,16 +2,20 @@ package handler
 
 import (
 	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+	"bumsiku/internal/spam"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 )
 
 // 핸들러 모의 함수 - 로거를 사용하지 않도록 구현
-func MockCreateComment(commentRepo *CommentRepositoryMock, postRepo *mockPostRepository) gin.HandlerFunc {
+func MockCreateComment(commentRepo *CommentRepositoryMock, postRepo *mockPostRepository, moderate bool, spamFilter spam.Filter) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		postID := c.Param("postId")
 		if postID == "" {
@@ -50,8 +54,14 @@ func MockCreateComment(commentRepo *CommentRepositoryMock, postRepo *mockPostRep
 		}
 
 		// 요청 바인딩
-		var comment model.Comment
-		if err := c.ShouldBindJSON(&comment); err != nil {
+		var req struct {
+			Nickname        string `json:"nickname"`
+			Content         string `json:"content"`
+			ParentCommentID string `json:"parentCommentId"`
+			FormToken       string `json:"formToken"`
+			Website         string `json:"website"`
+		}
+		if err := c.ShouldBindJSON(&req); err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{
 				"success": false,
 				"error": map[string]string{
@@ -63,7 +73,7 @@ func MockCreateComment(commentRepo *CommentRepositoryMock, postRepo *mockPostRep
 		}
 
 		// 필수 필드 검증
-		if comment.Nickname == "" || comment.Content == "" {
+		if req.Nickname == "" || req.Content == "" {
 			c.JSON(http.StatusBadRequest, gin.H{
 				"success": false,
 				"error": map[string]string{
@@ -75,7 +85,81 @@ func MockCreateComment(commentRepo *CommentRepositoryMock, postRepo *mockPostRep
 		}
 
 		// 댓글에 게시글 ID 설정
-		comment.PostID = postID
+		comment := model.Comment{PostID: postID, Nickname: req.Nickname, Content: req.Content, Status: model.CommentStatusApproved}
+		if moderate {
+			comment.Status = model.CommentStatusPending
+		}
+
+		// 스팸 검사
+		if spamFilter != nil {
+			verdict, err := spamFilter.Check(c.Request.Context(), &spam.Submission{
+				PostID:      postID,
+				Nickname:    req.Nickname,
+				Content:     req.Content,
+				Honeypot:    req.Website,
+				FormToken:   req.FormToken,
+				SubmittedAt: time.Now(),
+			})
+			if err == nil && verdict != nil {
+				if verdict.Reject {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"success": false,
+						"error": map[string]string{
+							"code":    "BAD_REQUEST",
+							"message": "댓글을 등록할 수 없습니다",
+						},
+					})
+					return
+				}
+				comment.Status = model.CommentStatusSpam
+			}
+		}
+
+		// 답글이면 부모 댓글 확인 후 중첩 단계 결정
+		if req.ParentCommentID != "" {
+			comments, _ := commentRepo.GetComments(c.Request.Context(), &repository.GetCommentsInput{PostID: &postID})
+
+			var parent *model.Comment
+			for i := range comments {
+				if comments[i].CommentID == req.ParentCommentID {
+					parent = &comments[i]
+					break
+				}
+			}
+			if parent == nil || !parent.IsApproved() {
+				c.JSON(http.StatusNotFound, gin.H{
+					"success": false,
+					"error": map[string]string{
+						"code":    "NOT_FOUND",
+						"message": "답글을 달 댓글을 찾을 수 없습니다",
+					},
+				})
+				return
+			}
+			if parent.Deleted {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"success": false,
+					"error": map[string]string{
+						"code":    "BAD_REQUEST",
+						"message": "삭제된 댓글에는 답글을 달 수 없습니다",
+					},
+				})
+				return
+			}
+			if parent.Depth+1 >= model.MaxCommentDepth {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"success": false,
+					"error": map[string]string{
+						"code":    "BAD_REQUEST",
+						"message": fmt.Sprintf("답글은 %d단계까지만 달 수 있습니다", model.MaxCommentDepth-1),
+					},
+				})
+				return
+			}
+
+			comment.ParentCommentID = parent.CommentID
+			comment.Depth = parent.Depth + 1
+		}
 
 		// 댓글 저장
 		createdComment, err := commentRepo.CreateComment(c.Request.Context(), &comment)
@@ -113,7 +197,7 @@ func TestCreateComment_Success(t *testing.T) {
 	c, w := SetupTestContext("POST", "/comments/post1", requestBody)
 	c.Params = []gin.Param{{Key: "postId", Value: "post1"}}
 
-	MockCreateComment(mockCommentRepo, mockPostRepo)(c)
+	MockCreateComment(mockCommentRepo, mockPostRepo, false, nil)(c)
 
 	// Then
 	assert.Equal(t, http.StatusCreated, w.Code)
@@ -148,7 +232,7 @@ func TestCreateComment_MissingPostId(t *testing.T) {
 	c, w := SetupTestContext("POST", "/comments/", requestBody)
 	c.Params = []gin.Param{{Key: "postId", Value: ""}}
 
-	MockCreateComment(mockCommentRepo, mockPostRepo)(c)
+	MockCreateComment(mockCommentRepo, mockPostRepo, false, nil)(c)
 
 	// Then
 	assert.Equal(t, http.StatusBadRequest, w.Code)
@@ -181,7 +265,7 @@ func TestCreateComment_PostNotFound(t *testing.T) {
 	c, w := SetupTestContext("POST", "/comments/nonexistent", requestBody)
 	c.Params = []gin.Param{{Key: "postId", Value: "nonexistent"}}
 
-	MockCreateComment(mockCommentRepo, mockPostRepo)(c)
+	MockCreateComment(mockCommentRepo, mockPostRepo, false, nil)(c)
 
 	// Then
 	assert.Equal(t, http.StatusNotFound, w.Code)
@@ -214,7 +298,7 @@ func TestCreateComment_InvalidRequest(t *testing.T) {
 	c, w := SetupTestContext("POST", "/comments/post1", requestBody)
 	c.Params = []gin.Param{{Key: "postId", Value: "post1"}}
 
-	MockCreateComment(mockCommentRepo, mockPostRepo)(c)
+	MockCreateComment(mockCommentRepo, mockPostRepo, false, nil)(c)
 
 	// Then
 	assert.Equal(t, http.StatusBadRequest, w.Code)
@@ -247,7 +331,7 @@ func TestCreateComment_SaveError(t *testing.T) {
 	c, w := SetupTestContext("POST", "/comments/post1", requestBody)
 	c.Params = []gin.Param{{Key: "postId", Value: "post1"}}
 
-	MockCreateComment(mockCommentRepo, mockPostRepo)(c)
+	MockCreateComment(mockCommentRepo, mockPostRepo, false, nil)(c)
 
 	// Then
 	assert.Equal(t, http.StatusInternalServerError, w.Code)
@@ -263,3 +347,170 @@ func TestCreateComment_SaveError(t *testing.T) {
 	assert.Equal(t, "INTERNAL_SERVER_ERROR", errorData["code"])
 	assert.Contains(t, errorData["message"], "댓글 등록에 실패했습니다")
 }
+
+// createReplyTestComments는 post1에 depth 0~2 댓글과 삭제 표시, 검토 대기 댓글을 만듭니다.
+func createReplyTestComments() []model.Comment {
+	return []model.Comment{
+		{CommentID: "root", PostID: "post1", Nickname: "사용자1", Content: "원댓글"},
+		{CommentID: "reply", PostID: "post1", ParentCommentID: "root", Depth: 1, Nickname: "사용자2", Content: "답글"},
+		{CommentID: "nested", PostID: "post1", ParentCommentID: "reply", Depth: 2, Nickname: "사용자3", Content: "답글의 답글"},
+		{CommentID: "tombstone", PostID: "post1", Deleted: true},
+		{CommentID: "pending", PostID: "post1", Nickname: "사용자5", Content: "검토 대기", Status: model.CommentStatusPending},
+		{CommentID: "other", PostID: "post2", Nickname: "사용자4", Content: "다른 게시글 댓글"},
+	}
+}
+
+// [GIVEN] 부모 댓글 ID를 지정한 답글 요청
+// [WHEN] CreateComment 핸들러를 호출
+// [THEN] 상태코드 201과 부모 댓글 ID, 중첩 단계가 설정된 답글 확인
+func TestCreateComment_Reply(t *testing.T) {
+	// Given
+	mockCommentRepo := &CommentRepositoryMock{comments: createReplyTestComments()}
+	mockPostRepo := &mockPostRepository{posts: CreateTestPosts()}
+	requestBody := `{"nickname": "테스터", "content": "답글입니다.", "parentCommentId": "reply"}`
+
+	// When
+	c, w := SetupTestContext("POST", "/comments/post1", requestBody)
+	c.Params = []gin.Param{{Key: "postId", Value: "post1"}}
+
+	MockCreateComment(mockCommentRepo, mockPostRepo, false, nil)(c)
+
+	// Then
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Equal(t, "reply", mockCommentRepo.createdComment.ParentCommentID)
+	assert.Equal(t, 2, mockCommentRepo.createdComment.Depth)
+}
+
+// [GIVEN] 답글을 달 수 없는 부모 댓글
+// [WHEN] CreateComment 핸들러를 호출
+// [THEN] 부모 댓글 상태에 따른 오류 응답 확인
+func TestCreateComment_InvalidParent(t *testing.T) {
+	tests := []struct {
+		name           string
+		parentID       string
+		expectedStatus int
+		expectedCode   string
+	}{
+		{"없는 댓글", "unknown", http.StatusNotFound, "NOT_FOUND"},
+		{"다른 게시글의 댓글", "other", http.StatusNotFound, "NOT_FOUND"},
+		{"검토 대기 댓글", "pending", http.StatusNotFound, "NOT_FOUND"},
+		{"삭제 표시된 댓글", "tombstone", http.StatusBadRequest, "BAD_REQUEST"},
+		{"최대 중첩 단계", "nested", http.StatusBadRequest, "BAD_REQUEST"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			mockCommentRepo := &CommentRepositoryMock{comments: createReplyTestComments()}
+			mockPostRepo := &mockPostRepository{posts: CreateTestPosts()}
+			requestBody := fmt.Sprintf(`{"nickname": "테스터", "content": "답글입니다.", "parentCommentId": %q}`, tt.parentID)
+
+			// When
+			c, w := SetupTestContext("POST", "/comments/post1", requestBody)
+			c.Params = []gin.Param{{Key: "postId", Value: "post1"}}
+
+			MockCreateComment(mockCommentRepo, mockPostRepo, false, nil)(c)
+
+			// Then
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Nil(t, mockCommentRepo.createdComment)
+
+			var response map[string]interface{}
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			errorData := response["error"].(map[string]interface{})
+			assert.Equal(t, tt.expectedCode, errorData["code"])
+		})
+	}
+}
+
+// [GIVEN] 댓글 검토가 켜진 경우
+// [WHEN] CreateComment 핸들러를 호출
+// [THEN] 댓글이 검토 대기 상태로 등록됨 확인
+func TestCreateComment_Moderated(t *testing.T) {
+	// Given
+	mockCommentRepo := &CommentRepositoryMock{}
+	mockPostRepo := &mockPostRepository{posts: CreateTestPosts()}
+	requestBody := `{"nickname": "테스터", "content": "검토 받을 댓글"}`
+
+	// When
+	c, w := SetupTestContext("POST", "/comments/post1", requestBody)
+	c.Params = []gin.Param{{Key: "postId", Value: "post1"}}
+
+	MockCreateComment(mockCommentRepo, mockPostRepo, true, nil)(c)
+
+	// Then
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var response map[string]interface{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	comment := response["data"].(map[string]interface{})
+	assert.Equal(t, model.CommentStatusPending, comment["status"])
+}
+
+// [GIVEN] 숨김 필드를 채우거나 폼 토큰 없이 보낸 요청, 링크가 많은 댓글
+// [WHEN] 스팸 필터를 적용한 CreateComment 핸들러를 호출
+// [THEN] 봇 요청은 저장 없이 400, 링크가 많은 댓글은 spam 상태로 저장 확인
+func TestCreateComment_SpamFilter(t *testing.T) {
+	tokens := spam.NewFormTokens([]byte("secret"), time.Second, time.Hour)
+	validToken := tokens.Issue("post1", time.Now().Add(-time.Minute))
+	filter := spam.NewChain(spam.NewHoneypotFilter(), spam.NewTimingFilter(tokens), spam.NewLinkFilter(1))
+
+	tests := []struct {
+		name         string
+		body         string
+		expectedCode int
+		status       string
+	}{
+		{
+			name:         "정상 댓글",
+			body:         fmt.Sprintf(`{"nickname": "테스터", "content": "좋은 글입니다", "formToken": %q}`, validToken),
+			expectedCode: http.StatusCreated,
+			status:       model.CommentStatusApproved,
+		},
+		{
+			name:         "숨김 필드 입력",
+			body:         fmt.Sprintf(`{"nickname": "봇", "content": "좋은 글입니다", "formToken": %q, "website": "http://spam.example"}`, validToken),
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "폼 토큰 없음",
+			body:         `{"nickname": "봇", "content": "좋은 글입니다"}`,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "방금 발급한 토큰",
+			body:         fmt.Sprintf(`{"nickname": "봇", "content": "좋은 글입니다", "formToken": %q}`, tokens.Issue("post1", time.Now())),
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "링크가 많은 댓글",
+			body:         fmt.Sprintf(`{"nickname": "광고", "content": "https://a.example www.b.example", "formToken": %q}`, validToken),
+			expectedCode: http.StatusCreated,
+			status:       model.CommentStatusSpam,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			mockCommentRepo := &CommentRepositoryMock{}
+			mockPostRepo := &mockPostRepository{posts: CreateTestPosts()}
+
+			// When
+			c, w := SetupTestContext("POST", "/comments/post1", tt.body)
+			c.Params = []gin.Param{{Key: "postId", Value: "post1"}}
+
+			MockCreateComment(mockCommentRepo, mockPostRepo, false, filter)(c)
+
+			// Then
+			assert.Equal(t, tt.expectedCode, w.Code)
+			if tt.expectedCode != http.StatusCreated {
+				assert.Nil(t, mockCommentRepo.createdComment)
+				return
+			}
+			if assert.NotNil(t, mockCommentRepo.createdComment) {
+				assert.Equal(t, tt.status, mockCommentRepo.createdComment.Status)
+			}
+		})
+	}
+}
@@ -19,10 +19,11 @@ func MockCreatePost(repo *PostRepositoryForCreatePostMock) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// 요청 본문 파싱
 		var request struct {
-			Title    string `json:"title"`
-			Content  string `json:"content"`
-			Summary  string `json:"summary"`
-			Category string `json:"category"`
+			Title    string   `json:"title"`
+			Content  string   `json:"content"`
+			Summary  string   `json:"summary"`
+			Category string   `json:"category"`
+			Tags     []string `json:"tags"`
 		}
 
 		if err := c.ShouldBindJSON(&request); err != nil {
@@ -48,6 +49,19 @@ func MockCreatePost(repo *PostRepositoryForCreatePostMock) gin.HandlerFunc {
 			return
 		}
 
+		// 태그 정규화
+		tags, err := model.NormalizeTags(request.Tags)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "BAD_REQUEST",
+					"message": err.Error(),
+				},
+			})
+			return
+		}
+
 		// 게시글 생성
 		now := time.Now()
 		post := &model.Post{
@@ -56,11 +70,12 @@ func MockCreatePost(repo *PostRepositoryForCreatePostMock) gin.HandlerFunc {
 			Content:   request.Content,
 			Summary:   request.Summary,
 			Category:  request.Category,
+			Tags:      tags,
 			CreatedAt: now,
 			UpdatedAt: now,
 		}
 
-		err := repo.CreatePost(c.Request.Context(), post)
+		err = repo.CreatePost(c.Request.Context(), post)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{
 				"success": false,
@@ -194,3 +209,58 @@ func TestCreatePost_SaveError(t *testing.T) {
 	assert.Equal(t, "INTERNAL_SERVER_ERROR", errorData["code"])
 	assert.Equal(t, "게시글 등록에 실패했습니다", errorData["message"])
 }
+
+// [GIVEN] 대소문자, 공백, 중복이 섞인 태그로 게시글 생성 요청
+// [WHEN] CreatePost 핸들러를 호출
+// [THEN] 정규화되고 중복이 제거된 태그로 저장됨을 확인
+func TestCreatePost_NormalizesTags(t *testing.T) {
+	// Given
+	mockRepo := &PostRepositoryForCreatePostMock{}
+	requestBody := `{
+		"title": "테스트 게시글",
+		"content": "테스트 내용입니다.",
+		"summary": "테스트 요약입니다.",
+		"category": "tech",
+		"tags": ["Go", " go ", "Clean Code", "", "데이터베이스"]
+	}`
+
+	// When
+	c, w := SetupTestContextWithSession("POST", "/admin/posts", requestBody)
+
+	MockCreatePost(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Equal(t, []string{"go", "clean-code", "데이터베이스"}, mockRepo.createdPost.Tags)
+}
+
+// [GIVEN] 사용할 수 없는 문자가 포함된 태그로 게시글 생성 요청
+// [WHEN] CreatePost 핸들러를 호출
+// [THEN] 상태코드 400을 반환하고 게시글을 저장하지 않음을 확인
+func TestCreatePost_InvalidTag(t *testing.T) {
+	// Given
+	mockRepo := &PostRepositoryForCreatePostMock{}
+	requestBody := `{
+		"title": "테스트 게시글",
+		"content": "테스트 내용입니다.",
+		"summary": "테스트 요약입니다.",
+		"category": "tech",
+		"tags": ["go/lang"]
+	}`
+
+	// When
+	c, w := SetupTestContextWithSession("POST", "/admin/posts", requestBody)
+
+	MockCreatePost(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Nil(t, mockRepo.createdPost)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+
+	errorData := response["error"].(map[string]interface{})
+	assert.Contains(t, errorData["message"], "사용할 수 없는 문자")
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -22,9 +23,23 @@ func MockGetComments(repo *CommentRepositoryMock) gin.HandlerFunc {
 			postIDPtr = &postID
 		}
 
+		// 검토 상태 검증
+		status := c.Query("status")
+		if status != "" && !model.IsValidCommentStatus(status) {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "BAD_REQUEST",
+					"message": "유효하지 않은 댓글 상태입니다 (pending, approved, rejected, spam)",
+				},
+			})
+			return
+		}
+
 		// 댓글 조회
 		input := &repository.GetCommentsInput{
 			PostID: postIDPtr,
+			Status: status,
 		}
 
 		comments, err := repo.GetComments(c.Request.Context(), input)
@@ -83,9 +98,21 @@ func MockGetCommentsByPostID(repo *CommentRepositoryMock) gin.HandlerFunc {
 			return
 		}
 
+		// 승인되지 않은 댓글은 작성자와 내용을 지움
+		public := make([]model.Comment, 0, len(comments))
+		for _, comment := range comments {
+			if !comment.IsApproved() {
+				comment = comment.Tombstone()
+				comment.Status = ""
+			}
+			public = append(public, comment)
+		}
+
 		c.JSON(http.StatusOK, gin.H{
 			"success": true,
-			"data":    comments,
+			"data": gin.H{
+				"comments": model.BuildCommentThreads(public),
+			},
 		})
 	}
 }
@@ -197,8 +224,52 @@ func TestGetCommentsByPostID_Success(t *testing.T) {
 	assert.True(t, response["success"].(bool))
 	assert.NotNil(t, response["data"])
 
-	data := response["data"].([]interface{})
-	assert.Equal(t, 2, len(data)) // post1에는 댓글이 2개 있어야 함
+	data := response["data"].(map[string]interface{})
+	comments := data["comments"].([]interface{})
+	assert.Equal(t, 2, len(comments)) // post1에는 댓글이 2개 있어야 함
+}
+
+// [GIVEN] 답글과 답글이 남은 삭제 표시 댓글, 답글이 없는 삭제 표시 댓글이 있는 경우
+// [WHEN] GetCommentsByPostID 핸들러를 호출
+// [THEN] 답글 트리로 반환되고 답글이 없는 삭제 표시 댓글은 제외됨 확인
+func TestGetCommentsByPostID_Thread(t *testing.T) {
+	// Given
+	now := time.Now()
+	mockRepo := &CommentRepositoryMock{comments: []model.Comment{
+		{CommentID: "root", PostID: "post1", Nickname: "사용자1", Content: "원댓글", CreatedAt: now},
+		{CommentID: "deleted", PostID: "post1", Deleted: true, CreatedAt: now.Add(time.Minute)},
+		{CommentID: "reply1", PostID: "post1", ParentCommentID: "root", Depth: 1, Nickname: "사용자2", Content: "답글", CreatedAt: now.Add(2 * time.Minute)},
+		{CommentID: "nested", PostID: "post1", ParentCommentID: "reply1", Depth: 2, Nickname: "사용자3", Content: "답글의 답글", CreatedAt: now.Add(3 * time.Minute)},
+		{CommentID: "tombstone", PostID: "post1", Deleted: true, CreatedAt: now.Add(4 * time.Minute)},
+		{CommentID: "reply2", PostID: "post1", ParentCommentID: "tombstone", Depth: 1, Nickname: "사용자4", Content: "남은 답글", CreatedAt: now.Add(5 * time.Minute)},
+	}}
+
+	// When
+	c, w := SetupTestContext("GET", "/comments/post1", "")
+	c.Params = []gin.Param{{Key: "id", Value: "post1"}}
+
+	MockGetCommentsByPostID(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		Data struct {
+			Comments []model.CommentThread `json:"comments"`
+		} `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+
+	threads := response.Data.Comments
+	assert.Len(t, threads, 2)
+	assert.Equal(t, "root", threads[0].CommentID)
+	assert.Len(t, threads[0].Replies, 1)
+	assert.Equal(t, "reply1", threads[0].Replies[0].CommentID)
+	assert.Equal(t, "nested", threads[0].Replies[0].Replies[0].CommentID)
+	assert.Equal(t, "tombstone", threads[1].CommentID)
+	assert.True(t, threads[1].Deleted)
+	assert.Empty(t, threads[1].Content)
+	assert.Equal(t, "reply2", threads[1].Replies[0].CommentID)
 }
 
 // [GIVEN] 게시글 ID가 비어있는 경우
@@ -299,3 +370,73 @@ func TestGetComments_EmptyList(t *testing.T) {
 	comments := data["comments"].([]interface{})
 	assert.Equal(t, 0, len(comments))
 }
+
+// [GIVEN] 검토 상태가 다른 댓글이 있는 경우
+// [WHEN] GetComments 핸들러를 status 쿼리로 호출
+// [THEN] 해당 상태의 댓글만 반환, 잘못된 상태는 400 확인
+func TestGetComments_WithStatusFilter(t *testing.T) {
+	// Given
+	mockRepo := &CommentRepositoryMock{comments: []model.Comment{
+		{CommentID: "c1", PostID: "post1", Status: model.CommentStatusApproved},
+		{CommentID: "c2", PostID: "post1", Status: model.CommentStatusPending},
+		{CommentID: "c3", PostID: "post2", Status: model.CommentStatusPending},
+	}}
+
+	// When
+	c, w := SetupTestContext("GET", "/admin/comments?status=pending", "")
+	MockGetComments(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	data := response["data"].(map[string]interface{})
+	assert.Len(t, data["comments"].([]interface{}), 2)
+
+	// When
+	c, w = SetupTestContext("GET", "/admin/comments?status=hidden", "")
+	MockGetComments(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+// [GIVEN] 검토 대기 댓글과 승인된 답글이 달린 거절된 댓글이 있는 경우
+// [WHEN] GetCommentsByPostID 핸들러를 호출
+// [THEN] 검토 대기 댓글은 제외되고 거절된 댓글은 내용 없이 자리만 남음 확인
+func TestGetCommentsByPostID_OnlyApproved(t *testing.T) {
+	// Given
+	now := time.Now()
+	mockRepo := &CommentRepositoryMock{comments: []model.Comment{
+		{CommentID: "approved", PostID: "post1", Nickname: "사용자1", Content: "공개", Status: model.CommentStatusApproved, CreatedAt: now},
+		{CommentID: "pending", PostID: "post1", Nickname: "사용자2", Content: "대기", Status: model.CommentStatusPending, CreatedAt: now.Add(time.Minute)},
+		{CommentID: "rejected", PostID: "post1", Nickname: "사용자3", Content: "거절", Status: model.CommentStatusRejected, CreatedAt: now.Add(2 * time.Minute)},
+		{CommentID: "reply", PostID: "post1", ParentCommentID: "rejected", Depth: 1, Nickname: "사용자4", Content: "답글", Status: model.CommentStatusApproved, CreatedAt: now.Add(3 * time.Minute)},
+	}}
+
+	// When
+	c, w := SetupTestContext("GET", "/comments/post1", "")
+	c.Params = []gin.Param{{Key: "id", Value: "post1"}}
+
+	MockGetCommentsByPostID(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), "대기")
+	assert.NotContains(t, w.Body.String(), "거절")
+
+	var response struct {
+		Data struct {
+			Comments []model.CommentThread `json:"comments"`
+		} `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+
+	threads := response.Data.Comments
+	assert.Len(t, threads, 2)
+	assert.Equal(t, "approved", threads[0].CommentID)
+	assert.Equal(t, "rejected", threads[1].CommentID)
+	assert.True(t, threads[1].Deleted)
+	assert.Equal(t, "reply", threads[1].Replies[0].CommentID)
+}
@@ -0,0 +1,193 @@
+package handler
+
+import (
+	"bumsiku/internal/feed"
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 핸들러 모의 함수 - 로거를 사용하지 않도록 구현
+func MockGetRSSFeed(repo *mockPostRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		input := &repository.GetPostsInput{
+			Status:   model.PostStatusPublished,
+			Sort:     repository.SortNewest,
+			Page:     1,
+			PageSize: 20,
+		}
+		if category := c.Param("name"); category != "" {
+			input.Category = &category
+		}
+
+		output, err := repo.GetPosts(c.Request.Context(), input)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "INTERNAL_SERVER_ERROR",
+					"message": "피드 생성에 실패했습니다",
+				},
+			})
+			return
+		}
+
+		f := &feed.Feed{Title: "bumsiku.kr", SiteURL: "https://bumsiku.kr", FeedURL: "https://bumsiku.kr/feed.xml"}
+		for _, post := range output.Posts {
+			link := "https://bumsiku.kr/post/" + post.PostID
+			f.Items = append(f.Items, feed.Item{
+				ID:        link,
+				URL:       link,
+				Title:     post.Title,
+				Published: post.CreatedAt,
+				Updated:   post.UpdatedAt,
+			})
+		}
+
+		body, err := f.RSS()
+		if err != nil {
+			c.Status(http.StatusInternalServerError)
+			return
+		}
+
+		etag := feed.ETag(body)
+		lastModified := f.Updated()
+		c.Header("ETag", etag)
+		if !lastModified.IsZero() {
+			c.Header("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+		}
+
+		if feed.NotModified(c.GetHeader("If-None-Match"), c.GetHeader("If-Modified-Since"), etag, lastModified) {
+			c.Status(http.StatusNotModified)
+			return
+		}
+
+		c.Data(http.StatusOK, "application/rss+xml; charset=utf-8", body)
+	}
+}
+
+type rssDocument struct {
+	Channel struct {
+		Items []struct {
+			Title string `xml:"title"`
+		} `xml:"item"`
+	} `xml:"channel"`
+}
+
+// [GIVEN] 발행된 게시글이 있는 경우
+// [WHEN] RSS 피드 조회
+// [THEN] 상태코드 200과 최신순 항목, 검증자 헤더 확인
+func TestGetRSSFeed_Success(t *testing.T) {
+	// Given
+	mockRepo := &mockPostRepository{posts: CreateTestPosts()}
+
+	// When
+	c, w := SetupTestContext("GET", "/feed.xml", "")
+	MockGetRSSFeed(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/rss+xml; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.NotEmpty(t, w.Header().Get("ETag"))
+	assert.NotEmpty(t, w.Header().Get("Last-Modified"))
+
+	var doc rssDocument
+	require.NoError(t, xml.Unmarshal(w.Body.Bytes(), &doc))
+	require.Len(t, doc.Channel.Items, 2)
+	assert.Equal(t, "두 번째 게시글", doc.Channel.Items[0].Title)
+}
+
+// [GIVEN] 카테고리 경로
+// [WHEN] 카테고리별 RSS 피드 조회
+// [THEN] 해당 카테고리 게시글만 포함 확인
+func TestGetRSSFeed_Category(t *testing.T) {
+	// Given
+	mockRepo := &mockPostRepository{posts: CreateTestPosts()}
+
+	// When
+	c, w := SetupTestContext("GET", "/category/tech/feed.xml", "")
+	c.Params = gin.Params{{Key: "name", Value: "tech"}}
+	MockGetRSSFeed(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var doc rssDocument
+	require.NoError(t, xml.Unmarshal(w.Body.Bytes(), &doc))
+	require.Len(t, doc.Channel.Items, 1)
+	assert.Equal(t, "첫 번째 게시글", doc.Channel.Items[0].Title)
+}
+
+// [GIVEN] 이전 응답의 ETag와 Last-Modified
+// [WHEN] 조건부 GET 요청
+// [THEN] 상태코드 304와 빈 본문 확인
+func TestGetRSSFeed_NotModified(t *testing.T) {
+	// Given
+	mockRepo := &mockPostRepository{posts: CreateTestPosts()}
+	c, w := SetupTestContext("GET", "/feed.xml", "")
+	MockGetRSSFeed(mockRepo)(c)
+	etag := w.Header().Get("ETag")
+	lastModified := w.Header().Get("Last-Modified")
+
+	for name, header := range map[string][2]string{
+		"If-None-Match":     {"If-None-Match", etag},
+		"If-Modified-Since": {"If-Modified-Since", lastModified},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// When
+			c, w := SetupTestContext("GET", "/feed.xml", "")
+			c.Request.Header.Set(header[0], header[1])
+			MockGetRSSFeed(mockRepo)(c)
+			c.Writer.WriteHeaderNow()
+
+			// Then
+			assert.Equal(t, http.StatusNotModified, w.Code)
+			assert.Empty(t, w.Body.String())
+			assert.Equal(t, etag, w.Header().Get("ETag"))
+		})
+	}
+}
+
+// [GIVEN] 게시글이 변경되어 ETag가 달라진 경우
+// [WHEN] 이전 ETag로 조건부 GET 요청
+// [THEN] 상태코드 200과 새 피드 반환 확인
+func TestGetRSSFeed_Modified(t *testing.T) {
+	// Given
+	mockRepo := &mockPostRepository{posts: CreateTestPosts()}
+	c, w := SetupTestContext("GET", "/feed.xml", "")
+	MockGetRSSFeed(mockRepo)(c)
+	etag := w.Header().Get("ETag")
+	mockRepo.posts[0].Title = "수정된 제목"
+
+	// When
+	c, w = SetupTestContext("GET", "/feed.xml", "")
+	c.Request.Header.Set("If-None-Match", etag)
+	MockGetRSSFeed(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotEqual(t, etag, w.Header().Get("ETag"))
+	assert.Contains(t, w.Body.String(), "수정된 제목")
+}
+
+// [GIVEN] 저장소 오류가 발생하는 경우
+// [WHEN] RSS 피드 조회
+// [THEN] 상태코드 500 확인
+func TestGetRSSFeed_RepositoryError(t *testing.T) {
+	// Given
+	mockRepo := &mockPostRepository{err: errors.New("db error")}
+
+	// When
+	c, w := SetupTestContext("GET", "/feed.xml", "")
+	MockGetRSSFeed(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
@@ -1,9 +1,11 @@
 package handler
 
 import (
+	"bumsiku/internal/model"
 	"encoding/json"
 	"errors"
 	"net/http"
+	"strconv"
 	"testing"
 
 	"github.com/gin-gonic/gin"
@@ -37,7 +39,8 @@ func MockGetPostByID(repo *mockPostRepository) gin.HandlerFunc {
 			return
 		}
 
-		if post == nil {
+		// 발행되지 않은 게시글은 공개 조회에서 숨김
+		if post == nil || !post.IsPublished() {
 			c.JSON(http.StatusNotFound, gin.H{
 				"success": false,
 				"error": map[string]string{
@@ -48,6 +51,7 @@ func MockGetPostByID(repo *mockPostRepository) gin.HandlerFunc {
 			return
 		}
 
+		c.Header("ETag", `"`+strconv.FormatInt(post.Version, 10)+`"`)
 		c.JSON(http.StatusOK, gin.H{
 			"success": true,
 			"data":    post,
@@ -169,3 +173,42 @@ func TestGetPostById_Error(t *testing.T) {
 	assert.Equal(t, "INTERNAL_SERVER_ERROR", errorData["code"])
 	assert.Equal(t, "게시글 조회에 실패했습니다", errorData["message"])
 }
+
+// [GIVEN] 초안 상태의 게시글이 있는 경우
+// [WHEN] GetPostById 핸들러를 호출
+// [THEN] 상태코드 404 반환 확인
+func TestGetPostById_Draft(t *testing.T) {
+	// Given
+	mockPosts := CreateTestPosts()
+	mockPosts[0].Status = model.PostStatusDraft
+	mockRepo := &mockPostRepository{posts: mockPosts}
+
+	// When
+	c, w := SetupTestContext("GET", "/posts/post1", "")
+	c.Params = []gin.Param{{Key: "id", Value: "post1"}}
+
+	MockGetPostByID(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+// [GIVEN] 버전이 있는 게시글
+// [WHEN] GetPostById 핸들러를 호출
+// [THEN] 버전이 ETag 헤더로 반환되는지 확인
+func TestGetPostById_ETag(t *testing.T) {
+	// Given
+	mockPosts := CreateTestPosts()
+	mockPosts[0].Version = 5
+	mockRepo := &mockPostRepository{posts: mockPosts}
+
+	// When
+	c, w := SetupTestContext("GET", "/posts/post1", "")
+	c.Params = []gin.Param{{Key: "id", Value: "post1"}}
+
+	MockGetPostByID(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"5"`, w.Header().Get("ETag"))
+}
@@ -1,12 +1,18 @@
 package handler
 
 import (
+	"bumsiku/internal/handler"
+	"bumsiku/internal/model"
 	"bumsiku/internal/repository"
+	"bumsiku/internal/utils"
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -22,6 +28,34 @@ func MockGetPosts(repo *mockPostRepository) gin.HandlerFunc {
 			category = &categoryParam
 		}
 
+		// 태그 파라미터 (저장 형식으로 정규화)
+		tag := model.NormalizeTag(c.Query("tag"))
+		if tag != "" {
+			if err := model.ValidateTag(tag); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"success": false,
+					"error": gin.H{
+						"code":    "BAD_REQUEST",
+						"message": err.Error(),
+					},
+				})
+				return
+			}
+		}
+
+		// 정렬 파라미터
+		sortParam := c.Query("sort")
+		if !repository.IsValidSort(sortParam) {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "BAD_REQUEST",
+					"message": "지원하지 않는 정렬 방식입니다",
+				},
+			})
+			return
+		}
+
 		// 페이지네이션 파라미터
 		page := int32(1)
 		pageSize := int32(10)
@@ -40,15 +74,35 @@ func MockGetPosts(repo *mockPostRepository) gin.HandlerFunc {
 			}
 		}
 
+		var cursor *string
+		cursorParam := c.Query("cursor")
+		if cursorParam != "" {
+			cursor = &cursorParam
+		}
+
 		// 저장소에서 게시글 조회
 		input := &repository.GetPostsInput{
 			Category: category,
+			Status:   model.PostStatusPublished,
+			Tag:      tag,
+			Sort:     sortParam,
 			Page:     page,
 			PageSize: pageSize,
+			Cursor:   cursor,
 		}
 
 		output, err := repo.GetPosts(c.Request.Context(), input)
 		if err != nil {
+			if _, ok := err.(*repository.InvalidCursorError); ok {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"success": false,
+					"error": gin.H{
+						"code":    "BAD_REQUEST",
+						"message": "유효하지 않은 커서입니다",
+					},
+				})
+				return
+			}
 			c.JSON(http.StatusInternalServerError, gin.H{
 				"success": false,
 				"error": gin.H{
@@ -65,14 +119,23 @@ func MockGetPosts(repo *mockPostRepository) gin.HandlerFunc {
 			totalPages++
 		}
 
+		data := gin.H{
+			"posts":       output.Posts,
+			"totalCount":  output.TotalCount,
+			"currentPage": page,
+			"totalPages":  totalPages,
+		}
+		if cursor != nil {
+			data["currentPage"] = 0
+			data["totalPages"] = 0
+		}
+		if output.NextCursor != "" {
+			data["nextCursor"] = output.NextCursor
+		}
+
 		c.JSON(http.StatusOK, gin.H{
 			"success": true,
-			"data": gin.H{
-				"posts":       output.Posts,
-				"totalCount":  output.TotalCount,
-				"currentPage": page,
-				"totalPages":  totalPages,
-			},
+			"data":    data,
 		})
 	}
 }
@@ -196,3 +259,260 @@ func TestGetPosts_Error(t *testing.T) {
 	assert.Equal(t, "INTERNAL_SERVER_ERROR", errorData["code"])
 	assert.Equal(t, "게시글 목록 조회에 실패했습니다", errorData["message"])
 }
+
+// [GIVEN] 첫 페이지 응답의 nextCursor를 사용하는 경우
+// [WHEN] cursor 파라미터로 GetPosts 핸들러를 호출
+// [THEN] 다음 게시글이 반환되고 마지막 페이지에서는 nextCursor가 없음을 확인
+func TestGetPosts_WithCursor(t *testing.T) {
+	// Given
+	mockPosts := CreateTestPosts()
+	mockRepo := &mockPostRepository{posts: mockPosts}
+
+	c, w := SetupTestContext("GET", "/posts?pageSize=1", "")
+	MockGetPosts(mockRepo)(c)
+
+	var firstPage map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &firstPage)
+	assert.NoError(t, err)
+
+	firstData := firstPage["data"].(map[string]interface{})
+	nextCursor, ok := firstData["nextCursor"].(string)
+	assert.True(t, ok)
+	assert.NotEmpty(t, nextCursor)
+
+	// When
+	c, w = SetupTestContext("GET", "/posts?pageSize=1&cursor="+nextCursor, "")
+	MockGetPosts(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+
+	data := response["data"].(map[string]interface{})
+	posts := data["posts"].([]interface{})
+	assert.Equal(t, 1, len(posts))
+	assert.Equal(t, "post1", posts[0].(map[string]interface{})["postId"])
+	assert.Nil(t, data["nextCursor"])
+}
+
+// [GIVEN] 서명이 올바르지 않은 커서가 전달된 경우
+// [WHEN] GetPosts 핸들러를 호출
+// [THEN] 상태코드 400과 에러 메시지 반환 확인
+func TestGetPosts_InvalidCursor(t *testing.T) {
+	// Given
+	mockRepo := &mockPostRepository{posts: CreateTestPosts()}
+
+	// When
+	c, w := SetupTestContext("GET", "/posts?cursor=tampered.signature", "")
+
+	MockGetPosts(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+
+	errorData := response["error"].(map[string]interface{})
+	assert.Equal(t, "BAD_REQUEST", errorData["code"])
+	assert.Equal(t, "유효하지 않은 커서입니다", errorData["message"])
+}
+
+// [GIVEN] 다른 카테고리 조회에서 발급된 커서가 전달된 경우
+// [WHEN] GetPosts 핸들러를 호출
+// [THEN] 상태코드 400 반환 확인
+func TestGetPosts_CursorScopeMismatch(t *testing.T) {
+	// Given
+	mockRepo := &mockPostRepository{posts: CreateTestPosts()}
+	cursor, err := testCursors.Encode("life|newest|published", map[string]string{"offset": "1"})
+	assert.NoError(t, err)
+
+	// When
+	c, w := SetupTestContext("GET", "/posts?category=tech&cursor="+cursor, "")
+
+	MockGetPosts(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+// [GIVEN] 작성 시간이 다른 게시글 목록이 있는 경우
+// [WHEN] 정렬 파라미터 없이 GetPosts 핸들러를 호출
+// [THEN] 최신 게시글이 먼저 반환되는지 확인
+func TestGetPosts_DefaultNewestFirst(t *testing.T) {
+	// Given
+	mockRepo := &mockPostRepository{posts: CreateTestPosts()}
+
+	// When
+	c, w := SetupTestContext("GET", "/posts", "")
+
+	MockGetPosts(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+
+	posts := response["data"].(map[string]interface{})["posts"].([]interface{})
+	assert.Equal(t, "post2", posts[0].(map[string]interface{})["postId"])
+	assert.Equal(t, "post1", posts[1].(map[string]interface{})["postId"])
+}
+
+// [GIVEN] 작성 시간이 다른 게시글 목록이 있는 경우
+// [WHEN] sort=oldest로 GetPosts 핸들러를 호출
+// [THEN] 오래된 게시글이 먼저 반환되는지 확인
+func TestGetPosts_SortOldest(t *testing.T) {
+	// Given
+	mockRepo := &mockPostRepository{posts: CreateTestPosts()}
+
+	// When
+	c, w := SetupTestContext("GET", "/posts?sort=oldest", "")
+
+	MockGetPosts(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+
+	posts := response["data"].(map[string]interface{})["posts"].([]interface{})
+	assert.Equal(t, "post1", posts[0].(map[string]interface{})["postId"])
+	assert.Equal(t, "post2", posts[1].(map[string]interface{})["postId"])
+}
+
+// [GIVEN] 지원하지 않는 정렬 방식이 전달된 경우
+// [WHEN] GetPosts 핸들러를 호출
+// [THEN] 상태코드 400 반환 확인
+func TestGetPosts_InvalidSort(t *testing.T) {
+	// Given
+	mockRepo := &mockPostRepository{posts: CreateTestPosts()}
+
+	// When
+	c, w := SetupTestContext("GET", "/posts?sort=random", "")
+
+	MockGetPosts(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+// [GIVEN] 초안과 발행된 게시글이 섞여 있는 경우
+// [WHEN] GetPosts 핸들러를 호출
+// [THEN] 발행된 게시글만 반환되는지 확인
+func TestGetPosts_ExcludesUnpublished(t *testing.T) {
+	// Given
+	mockPosts := CreateTestPosts()
+	mockPosts[0].Status = model.PostStatusDraft
+	mockRepo := &mockPostRepository{posts: mockPosts}
+
+	// When
+	c, w := SetupTestContext("GET", "/posts", "")
+
+	MockGetPosts(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+
+	data := response["data"].(map[string]interface{})
+	posts := data["posts"].([]interface{})
+	assert.Len(t, posts, 1)
+	assert.Equal(t, "post2", posts[0].(map[string]interface{})["postId"])
+	assert.Equal(t, float64(1), data["totalCount"])
+}
+
+// [GIVEN] 태그가 달린 게시글이 있는 경우
+// [WHEN] 대소문자가 다른 태그 필터로 GetPosts 핸들러를 호출
+// [THEN] 해당 태그가 달린 게시글만 반환 확인
+func TestGetPosts_WithTag(t *testing.T) {
+	// Given
+	mockPosts := CreateTestPosts()
+	mockPosts[0].Tags = []string{"go", "db"}
+	mockPosts[1].Tags = []string{"db"}
+	mockRepo := &mockPostRepository{posts: mockPosts}
+
+	// When
+	c, w := SetupTestContext("GET", "/posts?tag=Go", "")
+
+	MockGetPosts(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+
+	data := response["data"].(map[string]interface{})
+	posts := data["posts"].([]interface{})
+	assert.Len(t, posts, 1)
+	assert.Equal(t, "post1", posts[0].(map[string]interface{})["postId"])
+	assert.Equal(t, float64(1), data["totalCount"])
+}
+
+// [GIVEN] 사용할 수 없는 문자가 포함된 태그 필터
+// [WHEN] GetPosts 핸들러를 호출
+// [THEN] 상태코드 400 반환 확인
+func TestGetPosts_InvalidTag(t *testing.T) {
+	// Given
+	mockRepo := &mockPostRepository{posts: CreateTestPosts()}
+
+	// When
+	c, w := SetupTestContext("GET", "/posts?tag=a%2Fb", "")
+
+	MockGetPosts(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+// [GIVEN] 메모리 저장소에 최대 페이지 크기보다 많은 발행된 게시글
+// [WHEN] 최댓값보다 큰 pageSize, 오프셋이 int32 범위를 넘는 page로 GetPosts 핸들러를 호출
+// [THEN] 페이지 크기는 최댓값으로 줄고, 큰 페이지는 패닉 없이 빈 목록 반환 확인
+func TestGetPosts_HugePage(t *testing.T) {
+	// Given
+	postRepo := repository.NewMemoryPostRepository(testCursors)
+	now := time.Now()
+	for i := 0; i < 101; i++ {
+		post := model.Post{PostID: fmt.Sprintf("post%03d", i), Title: "제목", Content: "내용", Summary: "요약", Category: "tech", Status: model.PostStatusPublished, CreatedAt: now, UpdatedAt: now}
+		assert.NoError(t, postRepo.CreatePost(context.Background(), &post))
+	}
+	logger := utils.NewLogger(utils.NewMemorySink())
+	defer logger.Close(context.Background())
+
+	// When
+	c, w := SetupTestContext("GET", "/posts?pageSize=1000", "")
+	handler.GetPosts(postRepo, logger)(c)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	data := response["data"].(map[string]interface{})
+	assert.Len(t, data["posts"].([]interface{}), 100)
+	assert.Equal(t, float64(2), data["totalPages"])
+
+	// When
+	c, w = SetupTestContext("GET", "/posts?page=65537&pageSize=32768", "")
+	handler.GetPosts(postRepo, logger)(c)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	data = response["data"].(map[string]interface{})
+	assert.Len(t, data["posts"].([]interface{}), 0)
+	assert.Equal(t, float64(101), data["totalCount"])
+}
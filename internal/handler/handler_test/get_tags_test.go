@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"bumsiku/internal/model"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// 핸들러 모의 함수 - 로거를 사용하지 않도록 구현
+func MockGetTags(repo *MockTagRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 태그 목록 조회
+		tags, err := repo.GetTags(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "INTERNAL_SERVER_ERROR",
+					"message": "태그 목록 조회에 실패했습니다",
+				},
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data": gin.H{
+				"tags": tags,
+			},
+		})
+	}
+}
+
+// MockTagRepository는 테스트에 사용되는 태그 색인 모의 객체입니다.
+type MockTagRepository struct {
+	tags    []model.Tag
+	postIDs map[string][]string // 태그 → 게시글 ID
+	err     error
+}
+
+func (m *MockTagRepository) GetTags(ctx context.Context) ([]model.Tag, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.tags, nil
+}
+
+func (m *MockTagRepository) GetPostIDsByTag(ctx context.Context, tag string) ([]string, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	postIDs := append([]string{}, m.postIDs[tag]...)
+	sort.Strings(postIDs)
+	return postIDs, nil
+}
+
+func (m *MockTagRepository) SetPostTags(ctx context.Context, postID string, tags []string, published bool) error {
+	return m.err
+}
+
+func (m *MockTagRepository) DeletePostTags(ctx context.Context, postID string) error {
+	return m.err
+}
+
+// [GIVEN] 태그 색인에 태그가 있는 경우
+// [WHEN] GetTags 핸들러를 호출
+// [THEN] 상태코드 200과 태그별 게시글 수 반환 확인
+func TestGetTags_Success(t *testing.T) {
+	// Given
+	mockRepo := &MockTagRepository{tags: []model.Tag{{Tag: "go", Count: 3}, {Tag: "db", Count: 1}}}
+
+	// When
+	c, w := SetupTestContext("GET", "/tags", "")
+
+	MockGetTags(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+
+	data := response["data"].(map[string]interface{})
+	tags := data["tags"].([]interface{})
+	assert.Len(t, tags, 2)
+
+	first := tags[0].(map[string]interface{})
+	assert.Equal(t, "go", first["tag"])
+	assert.Equal(t, float64(3), first["count"])
+}
+
+// [GIVEN] Repository에서 에러가 발생하는 경우
+// [WHEN] GetTags 핸들러를 호출
+// [THEN] 상태코드 500과 에러 메시지 반환 확인
+func TestGetTags_Error(t *testing.T) {
+	// Given
+	mockRepo := &MockTagRepository{err: errors.New("database error")}
+
+	// When
+	c, w := SetupTestContext("GET", "/tags", "")
+
+	MockGetTags(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+
+	errorData := response["error"].(map[string]interface{})
+	assert.Equal(t, "INTERNAL_SERVER_ERROR", errorData["code"])
+	assert.Equal(t, "태그 목록 조회에 실패했습니다", errorData["message"])
+}
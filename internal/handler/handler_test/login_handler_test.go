@@ -1,17 +1,23 @@
 package handler
 
 import (
+	"bumsiku/internal/auth"
+	"bumsiku/internal/repository"
+	"context"
 	"encoding/json"
+	"fmt"
+	"math"
 	"net/http"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 )
 
 // 핸들러 모의 함수 - 로거를 사용하지 않도록 구현
-func MockPostLogin() gin.HandlerFunc {
+func MockPostLogin(userRepo repository.UserRepositoryInterface, throttle *auth.LoginThrottle) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var loginRequest struct {
 			Username string `json:"username" binding:"required"`
@@ -29,12 +35,34 @@ func MockPostLogin() gin.HandlerFunc {
 			return
 		}
 
-		// 환경 변수에서 관리자 자격증명 가져오기
-		adminID := os.Getenv("ADMIN_ID")
-		adminPW := os.Getenv("ADMIN_PW")
+		// 연속 실패로 지연 또는 잠금 중이면 거부
+		keys := auth.LoginKeys(loginRequest.Username, c.ClientIP())
+		if wait, _ := throttle.Wait(keys, time.Now()); wait > 0 {
+			c.Header("Retry-After", fmt.Sprintf("%d", int(math.Ceil(wait.Seconds()))))
+			c.JSON(http.StatusTooManyRequests, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "TOO_MANY_REQUESTS",
+					"message": "로그인 시도가 너무 많습니다",
+				},
+			})
+			return
+		}
 
 		// 자격증명 확인
-		if loginRequest.Username != adminID || loginRequest.Password != adminPW {
+		user, err := auth.Authenticate(c.Request.Context(), userRepo, loginRequest.Username, loginRequest.Password)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "INTERNAL_SERVER_ERROR",
+					"message": "로그인 처리 중 오류가 발생했습니다",
+				},
+			})
+			return
+		}
+		if user == nil {
+			throttle.Failure(keys, time.Now())
 			c.JSON(http.StatusUnauthorized, gin.H{
 				"success": false,
 				"error": gin.H{
@@ -45,25 +73,50 @@ func MockPostLogin() gin.HandlerFunc {
 			return
 		}
 
+		// 2단계 인증 계정은 TOTP 확인 전까지 로그인 대기
+		if user.TOTPEnabled {
+			c.JSON(http.StatusOK, gin.H{
+				"success": true,
+				"data": gin.H{
+					"message":      "2단계 인증 코드를 입력해주세요",
+					"totpRequired": true,
+				},
+			})
+			return
+		}
+		throttle.Success(keys)
+
 		// 테스트 환경에서는 실제 세션 처리 없이 바로 응답 반환
 		c.JSON(http.StatusOK, gin.H{
 			"success": true,
 			"data": gin.H{
 				"message": "로그인에 성공했습니다",
+				"role":    user.Role,
 			},
 		})
 	}
 }
 
+// newTestUserRepository는 테스트 환경 변수의 관리자 계정을 owner로 등록한 계정 저장소를 생성합니다.
+func newTestUserRepository(t *testing.T) repository.UserRepositoryInterface {
+	SetTestEnvironment()
+	userRepo := repository.NewMemoryUserRepository()
+	hash, err := auth.HashPassword(os.Getenv("ADMIN_PW"))
+	assert.NoError(t, err)
+	_, err = auth.EnsureOwner(context.Background(), userRepo, os.Getenv("ADMIN_ID"), hash, "")
+	assert.NoError(t, err)
+	return userRepo
+}
+
 // [GIVEN] 올바른 자격증명을 포함한 JSON 페이로드를 준비
 // [WHEN] PostLogin 핸들러를 호출
 // [THEN] 상태코드 200과 "로그인에 성공했습니다" 메시지 반환 확인
 func TestPostLogin_Success(t *testing.T) {
-	SetTestEnvironment()
+	userRepo := newTestUserRepository(t)
 	body := `{"username": "admin", "password": "password"}`
 	c, w := SetupTestContextWithSession("POST", "/login", body)
 
-	MockPostLogin()(c)
+	MockPostLogin(userRepo, auth.NewLoginThrottle())(c)
 
 	assert.Equal(t, http.StatusOK, w.Code)
 
@@ -77,17 +130,18 @@ func TestPostLogin_Success(t *testing.T) {
 
 	data := response["data"].(map[string]interface{})
 	assert.Equal(t, "로그인에 성공했습니다", data["message"])
+	assert.Equal(t, "owner", data["role"])
 }
 
 // [GIVEN] 잘못된 자격증명을 포함한 JSON 페이로드를 준비
 // [WHEN] PostLogin 핸들러를 호출
 // [THEN] 상태코드 401과 "로그인에 실패했습니다" 에러 메시지 반환 확인
 func TestPostLogin_InvalidCredentials(t *testing.T) {
-	SetTestEnvironment()
+	userRepo := newTestUserRepository(t)
 	body := `{"username": "wrong", "password": "creds"}`
 	c, w := SetupTestContextWithSession("POST", "/login", body)
 
-	MockPostLogin()(c)
+	MockPostLogin(userRepo, auth.NewLoginThrottle())(c)
 
 	assert.Equal(t, http.StatusUnauthorized, w.Code)
 
@@ -107,11 +161,11 @@ func TestPostLogin_InvalidCredentials(t *testing.T) {
 // [WHEN] PostLogin 핸들러를 호출
 // [THEN] 상태코드 400과 "잘못된 요청 형식입니다" 에러 메시지 반환 확인
 func TestPostLogin_BadRequest(t *testing.T) {
-	SetTestEnvironment()
+	userRepo := newTestUserRepository(t)
 	body := `{"username": "admin"}`
 	c, w := SetupTestContextWithSession("POST", "/login", body)
 
-	MockPostLogin()(c)
+	MockPostLogin(userRepo, auth.NewLoginThrottle())(c)
 
 	assert.Equal(t, http.StatusBadRequest, w.Code)
 
@@ -126,3 +180,52 @@ func TestPostLogin_BadRequest(t *testing.T) {
 	assert.Equal(t, "BAD_REQUEST", errorData["code"])
 	assert.Equal(t, "잘못된 요청 형식입니다", errorData["message"])
 }
+
+// [GIVEN] 같은 사용자 이름으로 로그인에 연속 실패한 경우
+// [WHEN] 올바른 비밀번호로 다시 로그인
+// [THEN] 지연 시간 동안 상태코드 429와 Retry-After 헤더 반환 확인
+func TestPostLogin_ThrottledAfterFailures(t *testing.T) {
+	userRepo := newTestUserRepository(t)
+	throttle := auth.NewLoginThrottle()
+	handler := MockPostLogin(userRepo, throttle)
+
+	for i := 0; i < auth.DefaultFreeAttempts; i++ {
+		c, w := SetupTestContextWithSession("POST", "/login", `{"username": "admin", "password": "wrong"}`)
+		handler(c)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	}
+
+	c, w := SetupTestContextWithSession("POST", "/login", `{"username": "admin", "password": "password"}`)
+	handler(c)
+
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "1", w.Header().Get("Retry-After"))
+
+	var response map[string]interface{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	errorData := response["error"].(map[string]interface{})
+	assert.Equal(t, "TOO_MANY_REQUESTS", errorData["code"])
+}
+
+// [GIVEN] 2단계 인증을 사용하는 계정
+// [WHEN] 올바른 비밀번호로 PostLogin 핸들러를 호출
+// [THEN] 로그인을 완료하지 않고 totpRequired 응답 확인
+func TestPostLogin_TOTPRequired(t *testing.T) {
+	userRepo := newTestUserRepository(t)
+	user, err := userRepo.GetUser(context.Background(), "admin")
+	assert.NoError(t, err)
+	user.TOTPEnabled = true
+	user.TOTPSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	assert.NoError(t, userRepo.UpdateUser(context.Background(), user))
+
+	c, w := SetupTestContextWithSession("POST", "/login", `{"username": "admin", "password": "password"}`)
+	MockPostLogin(userRepo, auth.NewLoginThrottle())(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	data := response["data"].(map[string]interface{})
+	assert.Equal(t, true, data["totpRequired"])
+	assert.Nil(t, data["role"])
+}
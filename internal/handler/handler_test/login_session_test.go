@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"bumsiku/internal/auth"
+	"bumsiku/internal/handler"
+	"bumsiku/internal/repository"
+	loginsession "bumsiku/internal/session"
+	"bumsiku/internal/utils"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// [GIVEN] 로그인 전에 세션 쿠키를 받은 방문자와 2단계 인증을 사용하는 계정
+// [WHEN] 그 쿠키로 /login, 이어서 /login/totp 요청
+// [THEN] 단계마다 세션 쿠키가 새로 발급되고, 이전 쿠키로는 세션을 사용할 수 없음 확인
+func TestLogin_RegeneratesSessionToken(t *testing.T) {
+	userRepo := newTestUserRepository(t)
+	user, err := userRepo.GetUser(context.Background(), "admin")
+	require.NoError(t, err)
+	user.TOTPEnabled = true
+	user.TOTPSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	require.NoError(t, userRepo.UpdateUser(context.Background(), user))
+
+	sessionRepo := repository.NewMemorySessionRepository()
+	logger := utils.NewLogger(utils.NewMemorySink())
+	defer logger.Close(context.Background())
+
+	throttle := auth.NewLoginThrottle()
+	router := gin.New()
+	router.Use(sessions.Sessions("loginSession", loginsession.NewStore(sessionRepo, time.Hour, sessions.Options{Path: "/", MaxAge: 3600})))
+	router.GET("/visit", func(c *gin.Context) {
+		session := sessions.Default(c)
+		session.Set("visited", true)
+		require.NoError(t, session.Save())
+	})
+	router.POST("/login", handler.PostLogin(userRepo, throttle, logger))
+	router.POST("/login/totp", handler.PostLoginTOTP(userRepo, throttle, logger))
+
+	request := func(method, path, body string, cookie *http.Cookie) (*httptest.ResponseRecorder, *http.Cookie) {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if cookie != nil {
+			req.AddCookie(cookie)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		for _, c := range w.Result().Cookies() {
+			if c.Name == "loginSession" {
+				return w, c
+			}
+		}
+		return w, nil
+	}
+	sessionExists := func(cookie *http.Cookie) bool {
+		stored, err := sessionRepo.GetSession(context.Background(), loginsession.HashToken(cookie.Value))
+		return err == nil && stored != nil
+	}
+
+	_, visitCookie := request(http.MethodGet, "/visit", "", nil)
+	require.NotNil(t, visitCookie)
+
+	w, pendingCookie := request(http.MethodPost, "/login", `{"username": "admin", "password": "password"}`, visitCookie)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NotNil(t, pendingCookie)
+	assert.NotEqual(t, visitCookie.Value, pendingCookie.Value)
+	assert.False(t, sessionExists(visitCookie))
+
+	code, err := auth.TOTPCode(user.TOTPSecret, time.Now())
+	require.NoError(t, err)
+	w, loginCookie := request(http.MethodPost, "/login/totp", `{"code": "`+code+`"}`, pendingCookie)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.NotNil(t, loginCookie)
+	assert.NotEqual(t, pendingCookie.Value, loginCookie.Value)
+	assert.False(t, sessionExists(pendingCookie))
+	assert.True(t, sessionExists(loginCookie))
+}
@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"bumsiku/internal/model"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// 핸들러 모의 함수 - 로거를 사용하지 않도록 구현
+func MockModerateComments(repo *CommentRepositoryMock) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req struct {
+			CommentIDs []string `json:"commentIds" binding:"required,min=1"`
+			Action     string   `json:"action" binding:"required"`
+		}
+		if err := c.ShouldBindJSON(&req); err != nil {
+			sendMockError(c, http.StatusBadRequest, "BAD_REQUEST", "요청 형식이 올바르지 않습니다")
+			return
+		}
+
+		status, ok := map[string]string{
+			"approve": model.CommentStatusApproved,
+			"reject":  model.CommentStatusRejected,
+			"spam":    model.CommentStatusSpam,
+		}[req.Action]
+		if !ok {
+			sendMockError(c, http.StatusBadRequest, "BAD_REQUEST", "유효하지 않은 검토 동작입니다 (approve, reject, spam)")
+			return
+		}
+		if len(req.CommentIDs) > 100 {
+			sendMockError(c, http.StatusBadRequest, "BAD_REQUEST", "한 번에 최대 100개의 댓글을 검토할 수 있습니다")
+			return
+		}
+
+		updated, err := repo.SetCommentStatus(c.Request.Context(), req.CommentIDs, status)
+		if err != nil {
+			sendMockError(c, http.StatusInternalServerError, "INTERNAL_SERVER_ERROR", "댓글 검토에 실패했습니다")
+			return
+		}
+
+		changed := make(map[string]bool)
+		for _, commentID := range updated {
+			changed[commentID] = true
+		}
+		notFound := make([]string, 0)
+		for _, commentID := range req.CommentIDs {
+			if !changed[commentID] {
+				changed[commentID] = true
+				notFound = append(notFound, commentID)
+			}
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data": gin.H{
+				"status":   status,
+				"updated":  updated,
+				"notFound": notFound,
+			},
+		})
+	}
+}
+
+func createPendingComments() []model.Comment {
+	return []model.Comment{
+		{CommentID: "c1", PostID: "post1", Status: model.CommentStatusPending},
+		{CommentID: "c2", PostID: "post1", Status: model.CommentStatusPending},
+		{CommentID: "c3", PostID: "post2", Status: model.CommentStatusPending},
+	}
+}
+
+// [GIVEN] 검토 대기 댓글
+// [WHEN] 없는 ID를 포함해 일괄 승인
+// [THEN] 존재하는 댓글만 승인되고 없는 ID는 notFound로 반환 확인
+func TestModerateComments_Approve(t *testing.T) {
+	// Given
+	mockRepo := &CommentRepositoryMock{comments: createPendingComments()}
+	requestBody := `{"commentIds": ["c1", "c3", "unknown"], "action": "approve"}`
+
+	// When
+	c, w := SetupTestContext("POST", "/admin/comments/moderate", requestBody)
+	MockModerateComments(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		Data struct {
+			Status   string   `json:"status"`
+			Updated  []string `json:"updated"`
+			NotFound []string `json:"notFound"`
+		} `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, model.CommentStatusApproved, response.Data.Status)
+	assert.Equal(t, []string{"c1", "c3"}, response.Data.Updated)
+	assert.Equal(t, []string{"unknown"}, response.Data.NotFound)
+
+	assert.Equal(t, model.CommentStatusApproved, mockRepo.comments[0].Status)
+	assert.Equal(t, model.CommentStatusPending, mockRepo.comments[1].Status)
+	assert.Equal(t, model.CommentStatusApproved, mockRepo.comments[2].Status)
+}
+
+// [GIVEN] 검토 대기 댓글
+// [WHEN] 스팸 처리
+// [THEN] 댓글 상태가 spam으로 변경됨 확인
+func TestModerateComments_Spam(t *testing.T) {
+	// Given
+	mockRepo := &CommentRepositoryMock{comments: createPendingComments()}
+
+	// When
+	c, w := SetupTestContext("POST", "/admin/comments/moderate", `{"commentIds": ["c2"], "action": "spam"}`)
+	MockModerateComments(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, model.CommentStatusSpam, mockRepo.comments[1].Status)
+}
+
+// [GIVEN] 잘못된 검토 요청
+// [WHEN] ModerateComments 핸들러를 호출
+// [THEN] 상태코드 400과 댓글 상태 유지 확인
+func TestModerateComments_InvalidRequest(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"댓글 ID 없음", `{"commentIds": [], "action": "approve"}`},
+		{"동작 없음", `{"commentIds": ["c1"]}`},
+		{"지원하지 않는 동작", `{"commentIds": ["c1"], "action": "delete"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			mockRepo := &CommentRepositoryMock{comments: createPendingComments()}
+
+			// When
+			c, w := SetupTestContext("POST", "/admin/comments/moderate", tt.body)
+			MockModerateComments(mockRepo)(c)
+
+			// Then
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			assert.Equal(t, model.CommentStatusPending, mockRepo.comments[0].Status)
+		})
+	}
+}
+
+// [GIVEN] 저장소 오류가 발생하는 경우
+// [WHEN] ModerateComments 핸들러를 호출
+// [THEN] 상태코드 500 확인
+func TestModerateComments_RepositoryError(t *testing.T) {
+	// Given
+	mockRepo := &CommentRepositoryMock{err: errors.New("db error")}
+
+	// When
+	c, w := SetupTestContext("POST", "/admin/comments/moderate", `{"commentIds": ["c1"], "action": "reject"}`)
+	MockModerateComments(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,234 @@
+package handler
+
+import (
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+	"bumsiku/internal/utils"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// 핸들러 모의 함수 - 로거를 사용하지 않도록 구현
+func MockGetPostRevisionDiff(postRepo *mockPostRepository, revisionRepo *RevisionRepositoryMock) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		postID := c.Param("id")
+		rev, err := strconv.Atoi(c.Param("rev"))
+		if err != nil || rev < 1 {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"error": map[string]string{
+					"code":    "BAD_REQUEST",
+					"message": "리비전 번호가 올바르지 않습니다",
+				},
+			})
+			return
+		}
+
+		revision, _ := revisionRepo.GetRevision(c.Request.Context(), postID, rev)
+		post, _ := postRepo.GetPostByID(c.Request.Context(), postID)
+		if revision == nil || post == nil {
+			c.JSON(http.StatusNotFound, gin.H{
+				"success": false,
+				"error": map[string]string{
+					"code":    "NOT_FOUND",
+					"message": "리비전을 찾을 수 없습니다",
+				},
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data": gin.H{
+				"postId":   postID,
+				"revision": rev,
+				"diff":     utils.UnifiedDiff(fmt.Sprintf("revision %d", rev), "current", revision.Content, post.Content),
+			},
+		})
+	}
+}
+
+// 핸들러 모의 함수 - 로거를 사용하지 않도록 구현
+func MockRestorePostRevision(postRepo *mockPostRepository, revisionRepo *RevisionRepositoryMock) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		postID := c.Param("id")
+		rev, err := strconv.Atoi(c.Param("rev"))
+		if err != nil || rev < 1 {
+			c.JSON(http.StatusBadRequest, gin.H{"success": false})
+			return
+		}
+
+		revision, _ := revisionRepo.GetRevision(c.Request.Context(), postID, rev)
+		if revision == nil {
+			c.JSON(http.StatusNotFound, gin.H{"success": false})
+			return
+		}
+
+		now := time.Now()
+		err = postRepo.UpdatePost(c.Request.Context(), &model.Post{
+			PostID:    postID,
+			Title:     revision.Title,
+			Content:   revision.Content,
+			Summary:   revision.Summary,
+			Category:  revision.Category,
+			UpdatedAt: now,
+		}, nil)
+		if err != nil {
+			if _, ok := err.(*repository.PostNotFoundError); ok {
+				c.JSON(http.StatusNotFound, gin.H{"success": false})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"success": false})
+			return
+		}
+
+		restoredPost, _ := postRepo.GetPostByID(c.Request.Context(), postID)
+		_ = revisionRepo.CreateRevision(c.Request.Context(), &model.PostRevision{
+			PostID:       postID,
+			Title:        restoredPost.Title,
+			Content:      restoredPost.Content,
+			Summary:      restoredPost.Summary,
+			Category:     restoredPost.Category,
+			Editor:       "admin",
+			RestoredFrom: rev,
+			CreatedAt:    now,
+		})
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    restoredPost,
+		})
+	}
+}
+
+// createTestRevisions는 post1의 이전 본문을 리비전 1로 가진 모의 저장소를 생성합니다.
+func createTestRevisions() *RevisionRepositoryMock {
+	repo := &RevisionRepositoryMock{}
+	_ = repo.CreateRevision(context.Background(), &model.PostRevision{
+		PostID:    "post1",
+		Title:     "예전 제목",
+		Content:   "첫 줄\n예전 둘째 줄\n셋째 줄",
+		Summary:   "예전 요약",
+		Category:  "life",
+		Editor:    "admin",
+		CreatedAt: time.Now().Add(-time.Hour),
+	})
+	return repo
+}
+
+// [GIVEN] 본문이 변경된 게시글과 이전 리비전이 있는 경우
+// [WHEN] 리비전 비교 요청
+// [THEN] 변경 줄이 포함된 unified diff 반환 확인
+func TestGetPostRevisionDiff_Success(t *testing.T) {
+	// Given
+	mockPosts := CreateTestPosts()
+	mockPosts[0].Content = "첫 줄\n새 둘째 줄\n셋째 줄"
+	postRepo := &mockPostRepository{posts: mockPosts}
+	revisionRepo := createTestRevisions()
+
+	// When
+	c, w := SetupTestContext("GET", "/admin/posts/post1/revisions/1/diff", "")
+	c.Params = []gin.Param{{Key: "id", Value: "post1"}, {Key: "rev", Value: "1"}}
+
+	MockGetPostRevisionDiff(postRepo, revisionRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+
+	data := response["data"].(map[string]interface{})
+	expected := "--- revision 1\n+++ current\n@@ -1,3 +1,3 @@\n 첫 줄\n-예전 둘째 줄\n+새 둘째 줄\n 셋째 줄\n"
+	assert.Equal(t, expected, data["diff"])
+}
+
+// [GIVEN] 존재하지 않는 리비전 번호
+// [WHEN] 리비전 비교 요청
+// [THEN] 상태코드 404 반환 확인
+func TestGetPostRevisionDiff_NotFound(t *testing.T) {
+	// Given
+	postRepo := &mockPostRepository{posts: CreateTestPosts()}
+	revisionRepo := createTestRevisions()
+
+	// When
+	c, w := SetupTestContext("GET", "/admin/posts/post1/revisions/9/diff", "")
+	c.Params = []gin.Param{{Key: "id", Value: "post1"}, {Key: "rev", Value: "9"}}
+
+	MockGetPostRevisionDiff(postRepo, revisionRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+// [GIVEN] 숫자가 아닌 리비전 번호
+// [WHEN] 리비전 비교 요청
+// [THEN] 상태코드 400 반환 확인
+func TestGetPostRevisionDiff_InvalidRevision(t *testing.T) {
+	// Given
+	postRepo := &mockPostRepository{posts: CreateTestPosts()}
+	revisionRepo := createTestRevisions()
+
+	// When
+	c, w := SetupTestContext("GET", "/admin/posts/post1/revisions/latest/diff", "")
+	c.Params = []gin.Param{{Key: "id", Value: "post1"}, {Key: "rev", Value: "latest"}}
+
+	MockGetPostRevisionDiff(postRepo, revisionRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+// [GIVEN] 이전 리비전이 있는 게시글
+// [WHEN] 리비전 복원 요청
+// [THEN] 게시글이 리비전 내용으로 돌아가고 복원 리비전이 추가되는지 확인
+func TestRestorePostRevision_Success(t *testing.T) {
+	// Given
+	postRepo := &mockPostRepository{posts: CreateTestPosts()}
+	revisionRepo := createTestRevisions()
+
+	// When
+	c, w := SetupTestContext("POST", "/admin/posts/post1/revisions/1/restore", "")
+	c.Params = []gin.Param{{Key: "id", Value: "post1"}, {Key: "rev", Value: "1"}}
+
+	MockRestorePostRevision(postRepo, revisionRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "예전 제목", postRepo.posts[0].Title)
+	assert.Equal(t, "첫 줄\n예전 둘째 줄\n셋째 줄", postRepo.posts[0].Content)
+	assert.Equal(t, "life", postRepo.posts[0].Category)
+
+	revisions, _ := revisionRepo.GetRevisions(context.Background(), "post1")
+	assert.Len(t, revisions, 2)
+	assert.Equal(t, 2, revisions[0].Revision)
+	assert.Equal(t, 1, revisions[0].RestoredFrom)
+}
+
+// [GIVEN] 존재하지 않는 리비전 번호
+// [WHEN] 리비전 복원 요청
+// [THEN] 상태코드 404 반환 및 게시글 유지 확인
+func TestRestorePostRevision_NotFound(t *testing.T) {
+	// Given
+	postRepo := &mockPostRepository{posts: CreateTestPosts()}
+	revisionRepo := createTestRevisions()
+
+	// When
+	c, w := SetupTestContext("POST", "/admin/posts/post1/revisions/5/restore", "")
+	c.Params = []gin.Param{{Key: "id", Value: "post1"}, {Key: "rev", Value: "5"}}
+
+	MockRestorePostRevision(postRepo, revisionRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, "첫 번째 게시글", postRepo.posts[0].Title)
+}
@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"bumsiku/internal/handler"
+	"bumsiku/internal/model"
+	"bumsiku/internal/search"
+	"bumsiku/internal/utils"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// 핸들러 모의 함수 - 로거를 사용하지 않도록 구현
+func MockSearchPosts(index *search.Index) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		query := strings.TrimSpace(c.Query("q"))
+		if query == "" || utf8.RuneCountInString(query) > 100 {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "BAD_REQUEST",
+					"message": "검색어는 1자 이상 100자 이하로 입력해 주세요",
+				},
+			})
+			return
+		}
+
+		page := int32(1)
+		pageSize := int32(10)
+		if p, err := strconv.ParseInt(c.Query("page"), 10, 32); err == nil && p > 0 {
+			page = int32(p)
+		}
+		if size, err := strconv.ParseInt(c.Query("pageSize"), 10, 32); err == nil && size > 0 {
+			pageSize = int32(min(size, 100))
+		}
+
+		results, total := index.Search(query, int(int64(page-1)*int64(pageSize)), int(pageSize))
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data": gin.H{
+				"posts":       results,
+				"totalCount":  total,
+				"currentPage": page,
+				"totalPages":  (int32(total) + pageSize - 1) / pageSize,
+			},
+		})
+	}
+}
+
+func createSearchIndex() *search.Index {
+	index := search.NewIndex()
+	now := time.Now()
+	index.Add(model.Post{PostID: "post1", Title: "Go 동시성 패턴", Summary: "요약", Content: "채널과 고루틴을 다룹니다", Category: "tech", Status: model.PostStatusPublished, CreatedAt: now})
+	index.Add(model.Post{PostID: "post2", Title: "일상", Summary: "요약", Content: "동시성 공부를 시작했다", Category: "life", Status: model.PostStatusPublished, CreatedAt: now.Add(time.Hour)})
+	index.Add(model.Post{PostID: "draft", Title: "동시성 초안", Summary: "요약", Content: "작성 중", Category: "tech", Status: model.PostStatusDraft, CreatedAt: now})
+	return index
+}
+
+// [GIVEN] 검색어와 일치하는 발행된 게시글과 초안
+// [WHEN] SearchPosts 핸들러를 호출
+// [THEN] 상태코드 200과 발행된 게시글만 관련도순으로 반환되는지 확인
+func TestSearchPosts_Success(t *testing.T) {
+	// Given
+	index := createSearchIndex()
+
+	// When
+	c, w := SetupTestContext("GET", "/search?q=동시성을", "")
+	MockSearchPosts(index)(c)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+
+	data := response["data"].(map[string]interface{})
+	assert.Equal(t, float64(2), data["totalCount"])
+	assert.Equal(t, float64(1), data["totalPages"])
+
+	posts := data["posts"].([]interface{})
+	assert.Len(t, posts, 2)
+	first := posts[0].(map[string]interface{})
+	assert.Equal(t, "post1", first["postId"])
+	assert.NotNil(t, first["score"])
+
+	second := posts[1].(map[string]interface{})
+	assert.Contains(t, second["snippet"], "<mark>동시성</mark>")
+}
+
+// [GIVEN] 검색어가 없거나 너무 긴 요청
+// [WHEN] SearchPosts 핸들러를 호출
+// [THEN] 상태코드 400 반환 확인
+func TestSearchPosts_InvalidQuery(t *testing.T) {
+	for _, query := range []string{"", "%20%20", strings.Repeat("a", 101)} {
+		// Given
+		index := createSearchIndex()
+
+		// When
+		c, w := SetupTestContext("GET", "/search?q="+query, "")
+		MockSearchPosts(index)(c)
+
+		// Then
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.False(t, response["success"].(bool))
+	}
+}
+
+// [GIVEN] 일치하는 게시글이 없는 검색어
+// [WHEN] SearchPosts 핸들러를 호출
+// [THEN] 상태코드 200과 빈 목록 반환 확인
+func TestSearchPosts_NoMatch(t *testing.T) {
+	// Given
+	index := createSearchIndex()
+
+	// When
+	c, w := SetupTestContext("GET", "/search?q=rust", "")
+	MockSearchPosts(index)(c)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+
+	data := response["data"].(map[string]interface{})
+	assert.Equal(t, float64(0), data["totalCount"])
+	assert.Len(t, data["posts"].([]interface{}), 0)
+}
+
+// [GIVEN] 검색어와 일치하는 게시글
+// [WHEN] 오프셋이 int32 범위를 넘는 페이지 번호와 최댓값보다 큰 페이지 크기로 SearchPosts 핸들러를 호출
+// [THEN] 패닉 없이 상태코드 200과 빈 목록, 최댓값으로 줄인 페이지 크기 기준의 전체 페이지 수 반환 확인
+func TestSearchPosts_HugePage(t *testing.T) {
+	// Given
+	index := createSearchIndex()
+	logger := utils.NewLogger(utils.NewMemorySink())
+	defer logger.Close(context.Background())
+
+	// When
+	c, w := SetupTestContext("GET", "/search?q=golang&page=65537&pageSize=32768", "")
+	handler.SearchPosts(index, logger)(c)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+
+	data := response["data"].(map[string]interface{})
+	assert.Len(t, data["posts"].([]interface{}), 0)
+	assert.Equal(t, float64(65537), data["currentPage"])
+
+	// 일치하는 게시글이 있는 검색어도 마찬가지
+	c, w = SetupTestContext("GET", "/search?q=동시성&page=65537&pageSize=32768", "")
+	handler.SearchPosts(index, logger)(c)
+	assert.Equal(t, http.StatusOK, w.Code)
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	data = response["data"].(map[string]interface{})
+	assert.Len(t, data["posts"].([]interface{}), 0)
+	assert.Equal(t, float64(2), data["totalCount"])
+}
@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"bumsiku/internal/handler"
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 핸들러 모의 함수 - 로거를 사용하지 않도록 구현
+func MockDeleteSession(sessionRepo repository.SessionRepositoryInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		sessionID := c.Param("id")
+		session, err := sessionRepo.GetSession(c.Request.Context(), sessionID)
+		if err != nil {
+			sendUserError(c, http.StatusInternalServerError, "INTERNAL_SERVER_ERROR", "로그인 세션 폐기에 실패했습니다")
+			return
+		}
+		if session == nil || (session.Username != c.GetString("username") && c.GetString("role") != model.UserRoleOwner) {
+			sendUserError(c, http.StatusNotFound, "NOT_FOUND", "존재하지 않는 로그인 세션입니다")
+			return
+		}
+		if err := sessionRepo.DeleteSession(c.Request.Context(), sessionID); err != nil {
+			sendUserError(c, http.StatusInternalServerError, "INTERNAL_SERVER_ERROR", "로그인 세션 폐기에 실패했습니다")
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"success": true, "data": gin.H{"message": "로그인 세션이 폐기되었습니다"}})
+	}
+}
+
+func setupSessionRepository(t *testing.T) repository.SessionRepositoryInterface {
+	now := time.Now()
+	sessionRepo := repository.NewMemorySessionRepository()
+	for _, session := range []model.Session{
+		{SessionID: "session1", Username: "editor1", IP: "203.0.113.10", LoginTime: now.Add(-time.Hour), LastSeenAt: now.Add(-time.Minute), ExpiresAt: now.Add(time.Hour)},
+		{SessionID: "session2", Username: "editor1", IP: "203.0.113.20", LoginTime: now.Add(-time.Hour), LastSeenAt: now, ExpiresAt: now.Add(2 * time.Hour)},
+		{SessionID: "expired", Username: "editor1", LoginTime: now.Add(-3 * time.Hour), LastSeenAt: now.Add(-3 * time.Hour), ExpiresAt: now.Add(-time.Hour)},
+		{SessionID: "other", Username: "admin", LoginTime: now, LastSeenAt: now, ExpiresAt: now.Add(time.Hour)},
+	} {
+		require.NoError(t, sessionRepo.SaveSession(context.Background(), &session))
+	}
+	return sessionRepo
+}
+
+// [GIVEN] 여러 계정의 세션과 만료된 세션
+// [WHEN] GetSessions 핸들러를 호출
+// [THEN] 로그인한 계정의 유효한 세션만 최근 사용순으로 반환 확인
+func TestGetSessions_Success(t *testing.T) {
+	sessionRepo := setupSessionRepository(t)
+	c, w := SetupTestContextWithSession("GET", "/admin/sessions", "")
+	c.Set("username", "editor1")
+
+	handler.GetSessions(sessionRepo, nil)(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response struct {
+		Data handler.GetSessionsResponse `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.Len(t, response.Data.Sessions, 2)
+	assert.Equal(t, "session2", response.Data.Sessions[0].SessionID)
+	assert.Equal(t, "203.0.113.20", response.Data.Sessions[0].IP)
+	assert.Equal(t, "session1", response.Data.Sessions[1].SessionID)
+	assert.False(t, response.Data.Sessions[0].Current)
+}
+
+// [GIVEN] 다른 계정의 세션
+// [WHEN] owner가 아닌 계정과 owner가 DeleteSession 핸들러를 호출
+// [THEN] owner가 아니면 404를 반환하고, owner는 세션을 폐기할 수 있음 확인
+func TestDeleteSession_OtherUser(t *testing.T) {
+	sessionRepo := setupSessionRepository(t)
+
+	c, w := SetupTestContext("DELETE", "/admin/sessions/other", "")
+	c.Params = gin.Params{{Key: "id", Value: "other"}}
+	c.Set("username", "editor1")
+	c.Set("role", model.UserRoleEditor)
+	MockDeleteSession(sessionRepo)(c)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	c, w = SetupTestContext("DELETE", "/admin/sessions/other", "")
+	c.Params = gin.Params{{Key: "id", Value: "other"}}
+	c.Set("username", "owner1")
+	c.Set("role", model.UserRoleOwner)
+	MockDeleteSession(sessionRepo)(c)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	session, err := sessionRepo.GetSession(context.Background(), "other")
+	require.NoError(t, err)
+	assert.Nil(t, session)
+}
@@ -11,6 +11,8 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"sort"
+	"strconv"
 	"testing"
 	"time"
 
@@ -25,6 +27,9 @@ func init() {
 	gin.SetMode(gin.TestMode)
 }
 
+// testCursors는 모의 저장소가 페이지 커서를 서명하는 키입니다.
+var testCursors = repository.NewCursorSigner([]byte("test-cursor-secret"))
+
 // MockPostRepository는 테스트에 사용되는 저장소 모의 객체입니다.
 type mockPostRepository struct {
 	posts []model.Post
@@ -52,8 +57,75 @@ func (m *mockPostRepository) GetPosts(ctx context.Context, input *repository.Get
 		totalCount = int64(len(filteredPosts))
 	}
 
-	// 페이지네이션 적용
+	// 상태 필터링 (상태가 비어 있는 게시글은 published로 간주)
+	if input.Status != "" {
+		filtered := make([]model.Post, 0)
+		for _, post := range filteredPosts {
+			status := post.Status
+			if status == "" {
+				status = model.PostStatusPublished
+			}
+			if status == input.Status {
+				filtered = append(filtered, post)
+			}
+		}
+		filteredPosts = filtered
+		totalCount = int64(len(filteredPosts))
+	}
+
+	// 태그 필터링
+	if input.Tag != "" {
+		filtered := make([]model.Post, 0)
+		for _, post := range filteredPosts {
+			for _, tag := range post.Tags {
+				if tag == input.Tag {
+					filtered = append(filtered, post)
+					break
+				}
+			}
+		}
+		filteredPosts = filtered
+		totalCount = int64(len(filteredPosts))
+	}
+
+	// 정렬 적용 (원본 슬라이스는 변경하지 않음)
+	sortBy := input.Sort
+	if sortBy == "" {
+		sortBy = repository.SortNewest
+	}
+	filteredPosts = append([]model.Post(nil), filteredPosts...)
+	sort.SliceStable(filteredPosts, func(i, j int) bool {
+		switch sortBy {
+		case repository.SortOldest:
+			return filteredPosts[i].CreatedAt.Before(filteredPosts[j].CreatedAt)
+		case repository.SortUpdated:
+			return filteredPosts[i].UpdatedAt.After(filteredPosts[j].UpdatedAt)
+		default:
+			return filteredPosts[i].CreatedAt.After(filteredPosts[j].CreatedAt)
+		}
+	})
+
+	// 페이지네이션 적용 (커서가 있으면 커서에 담긴 오프셋부터 조회)
 	start := (input.Page - 1) * input.PageSize
+	scope := "|" + sortBy + "|" + input.Status
+	if input.Category != nil {
+		scope = *input.Category + scope
+	}
+	if input.Tag != "" {
+		scope += "|" + input.Tag
+	}
+	if input.Cursor != nil && *input.Cursor != "" {
+		key, err := testCursors.Decode(*input.Cursor, scope)
+		if err != nil {
+			return nil, err
+		}
+		offset, err := strconv.ParseInt(key["offset"], 10, 32)
+		if err != nil {
+			return nil, &repository.InvalidCursorError{Cursor: *input.Cursor}
+		}
+		start = int32(offset)
+		totalCount = 0
+	}
 	end := start + input.PageSize
 	if start >= int32(len(filteredPosts)) {
 		return &repository.GetPostsOutput{
@@ -65,9 +137,16 @@ func (m *mockPostRepository) GetPosts(ctx context.Context, input *repository.Get
 		end = int32(len(filteredPosts))
 	}
 
+	// 다음 페이지가 남아 있으면 커서 발급
+	nextCursor := ""
+	if end < int32(len(filteredPosts)) {
+		nextCursor, _ = testCursors.Encode(scope, map[string]string{"offset": strconv.Itoa(int(end))})
+	}
+
 	return &repository.GetPostsOutput{
 		Posts:      filteredPosts[start:end],
 		TotalCount: totalCount,
+		NextCursor: nextCursor,
 	}, nil
 }
 
@@ -92,7 +171,7 @@ func (m *mockPostRepository) CreatePost(ctx context.Context, post *model.Post) e
 	return nil
 }
 
-func (m *mockPostRepository) UpdatePost(ctx context.Context, post *model.Post) error {
+func (m *mockPostRepository) UpdatePost(ctx context.Context, post *model.Post, expectedVersion *int64) error {
 	if m.err != nil {
 		return m.err
 	}
@@ -102,12 +181,20 @@ func (m *mockPostRepository) UpdatePost(ctx context.Context, post *model.Post) e
 	for i, p := range m.posts {
 		if p.PostID == post.PostID {
 			found = true
+			if expectedVersion != nil && p.Version != *expectedVersion {
+				return &repository.PostVersionConflictError{PostID: post.PostID, ExpectedVersion: *expectedVersion, CurrentVersion: p.Version}
+			}
 			// 게시글 업데이트
 			m.posts[i].Title = post.Title
 			m.posts[i].Content = post.Content
 			m.posts[i].Summary = post.Summary
 			m.posts[i].Category = post.Category
+			if post.Tags != nil {
+				m.posts[i].Tags = post.Tags
+			}
 			m.posts[i].UpdatedAt = post.UpdatedAt
+			m.posts[i].Version++
+			post.Version = m.posts[i].Version
 			break
 		}
 	}
@@ -119,6 +206,22 @@ func (m *mockPostRepository) UpdatePost(ctx context.Context, post *model.Post) e
 	return nil
 }
 
+func (m *mockPostRepository) UpdatePostStatus(ctx context.Context, postID string, status string, publishAt *time.Time) error {
+	if m.err != nil {
+		return m.err
+	}
+
+	for i, p := range m.posts {
+		if p.PostID == postID {
+			m.posts[i].Status = status
+			m.posts[i].PublishAt = publishAt
+			return nil
+		}
+	}
+
+	return &repository.PostNotFoundError{PostID: postID}
+}
+
 func (m *mockPostRepository) DeletePost(ctx context.Context, postID string) error {
 	if m.err != nil {
 		return m.err
@@ -257,13 +360,17 @@ func (m *CommentRepositoryMock) GetComments(ctx context.Context, input *reposito
 		return nil, m.err
 	}
 
-	// postID로 필터링
-	if input != nil && input.PostID != nil {
+	// postID, 검토 상태로 필터링
+	if input != nil && (input.PostID != nil || input.Status != "") {
 		filteredComments := make([]model.Comment, 0)
 		for _, comment := range m.comments {
-			if comment.PostID == *input.PostID {
-				filteredComments = append(filteredComments, comment)
+			if input.PostID != nil && comment.PostID != *input.PostID {
+				continue
+			}
+			if input.Status != "" && comment.Status != input.Status {
+				continue
 			}
+			filteredComments = append(filteredComments, comment)
 		}
 		return filteredComments, nil
 	}
@@ -306,6 +413,85 @@ func (m *CommentRepositoryMock) DeleteComment(ctx context.Context, commentID str
 	return nil
 }
 
+func (m *CommentRepositoryMock) SetCommentStatus(ctx context.Context, commentIDs []string, status string) ([]string, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	// 존재하는 댓글만 한 번씩 변경
+	updated := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, commentID := range commentIDs {
+		for i := range m.comments {
+			if m.comments[i].CommentID == commentID && !seen[commentID] {
+				seen[commentID] = true
+				m.comments[i].Status = status
+				updated = append(updated, commentID)
+			}
+		}
+	}
+	return updated, nil
+}
+
+// RevisionRepositoryMock은 repository.RevisionRepositoryInterface를 구현하는 모의 객체입니다.
+type RevisionRepositoryMock struct {
+	revisions []model.PostRevision
+	err       error
+}
+
+func (m *RevisionRepositoryMock) CreateRevision(ctx context.Context, revision *model.PostRevision) error {
+	if m.err != nil {
+		return m.err
+	}
+
+	// 게시글별 다음 리비전 번호 할당
+	revision.Revision = 1
+	for _, rev := range m.revisions {
+		if rev.PostID == revision.PostID && rev.Revision >= revision.Revision {
+			revision.Revision = rev.Revision + 1
+		}
+	}
+	m.revisions = append(m.revisions, *revision)
+	return nil
+}
+
+func (m *RevisionRepositoryMock) GetRevisions(ctx context.Context, postID string) ([]model.PostRevision, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	// 최신순으로 반환
+	revisions := make([]model.PostRevision, 0)
+	for i := len(m.revisions) - 1; i >= 0; i-- {
+		if m.revisions[i].PostID == postID {
+			revisions = append(revisions, m.revisions[i])
+		}
+	}
+	return revisions, nil
+}
+
+func (m *RevisionRepositoryMock) GetRevision(ctx context.Context, postID string, revision int) (*model.PostRevision, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	for _, rev := range m.revisions {
+		if rev.PostID == postID && rev.Revision == revision {
+			return &rev, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *RevisionRepositoryMock) DeleteRevisionsByPostID(ctx context.Context, postID string) error {
+	if m.err != nil {
+		return m.err
+	}
+
+	// 실제 삭제 로직은 테스트에서 중요하지 않으므로 성공만 반환
+	return nil
+}
+
 // MockLogger는 로깅을 수행하지 않는 로거 모의 객체입니다.
 // 이 객체는 더 이상 사용되지 않으며, 대신 각 테스트 파일에서 필요한 핸들러 함수를 직접 구현합니다.
 // 핸들러 함수에 로거를 전달하지 않는 방식으로 테스트를 수행합니다.
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"bumsiku/internal/auth"
+	"bumsiku/internal/handler"
+	"bumsiku/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// [GIVEN] 2단계 인증을 사용하는 로그인 세션
+// [WHEN] 잘못된 코드로 해제를 잠금 횟수만큼 시도한 뒤 올바른 코드로 요청
+// [THEN] 429 응답과 Retry-After 헤더를 받고 2단계 인증이 해제되지 않음 확인
+func TestDisableTOTP_Throttled(t *testing.T) {
+	userRepo := newTestUserRepository(t)
+	user, err := userRepo.GetUser(context.Background(), "admin")
+	require.NoError(t, err)
+	user.TOTPEnabled = true
+	user.TOTPSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	require.NoError(t, userRepo.UpdateUser(context.Background(), user))
+
+	logger := utils.NewLogger(utils.NewMemorySink())
+	defer logger.Close(context.Background())
+
+	throttle := auth.NewLoginThrottle()
+	now := time.Now()
+	for i := 0; i < throttle.LockoutThreshold; i++ {
+		throttle.Failure(auth.LoginKeys("admin", "192.0.2.1"), now)
+	}
+
+	code, err := auth.TOTPCode(user.TOTPSecret, time.Now())
+	require.NoError(t, err)
+	c, w := SetupTestContextWithSession(http.MethodDelete, "/admin/me/totp", `{"code": "`+code+`"}`)
+	c.Request.RemoteAddr = "192.0.2.1:1234"
+	c.Set("username", "admin")
+
+	handler.DisableTOTP(userRepo, throttle, logger)(c)
+
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.NotEmpty(t, w.Header().Get("Retry-After"))
+	stored, err := userRepo.GetUser(context.Background(), "admin")
+	require.NoError(t, err)
+	assert.True(t, stored.TOTPEnabled)
+}
+
+// [GIVEN] 2단계 인증 등록을 시작한 로그인 세션
+// [WHEN] 잘못된 코드로 등록 완료를 반복 요청
+// [THEN] 처음에는 400, 허용 횟수를 넘으면 429 응답 확인
+func TestConfirmTOTP_Throttled(t *testing.T) {
+	userRepo := newTestUserRepository(t)
+	user, err := userRepo.GetUser(context.Background(), "admin")
+	require.NoError(t, err)
+	user.TOTPSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	require.NoError(t, userRepo.UpdateUser(context.Background(), user))
+
+	logger := utils.NewLogger(utils.NewMemorySink())
+	defer logger.Close(context.Background())
+
+	throttle := auth.NewLoginThrottle()
+	confirm := func() int {
+		c, w := SetupTestContextWithSession(http.MethodPost, "/admin/me/totp/confirm", `{"code": "wrong-code"}`)
+		c.Request.RemoteAddr = "192.0.2.1:1234"
+		c.Set("username", "admin")
+		handler.ConfirmTOTP(userRepo, throttle, logger)(c)
+		return w.Code
+	}
+
+	for i := 0; i < throttle.FreeAttempts; i++ {
+		assert.Equal(t, http.StatusBadRequest, confirm())
+	}
+	assert.Equal(t, http.StatusTooManyRequests, confirm())
+}
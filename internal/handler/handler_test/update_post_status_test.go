@@ -0,0 +1,263 @@
+package handler
+
+import (
+	"bumsiku/internal/handler"
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+	"bumsiku/internal/utils"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// 상태 전환 규칙 - 실제 핸들러와 동일하게 유지
+var testPostStatusTransitions = map[string][]string{
+	model.PostStatusDraft:     {model.PostStatusScheduled, model.PostStatusPublished, model.PostStatusArchived},
+	model.PostStatusScheduled: {model.PostStatusDraft, model.PostStatusPublished, model.PostStatusArchived},
+	model.PostStatusPublished: {model.PostStatusDraft, model.PostStatusArchived},
+	model.PostStatusArchived:  {model.PostStatusDraft, model.PostStatusPublished},
+}
+
+// 핸들러 모의 함수 - 로거를 사용하지 않도록 구현
+func MockUpdatePostStatus(repo *mockPostRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		badRequest := func(message string) {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"error": map[string]string{
+					"code":    "BAD_REQUEST",
+					"message": message,
+				},
+			})
+		}
+
+		var req struct {
+			Status    string     `json:"status" binding:"required"`
+			PublishAt *time.Time `json:"publishAt,omitempty"`
+		}
+		if err := c.ShouldBindJSON(&req); err != nil || !model.IsValidPostStatus(req.Status) {
+			badRequest("요청 형식이 올바르지 않습니다")
+			return
+		}
+
+		post, _ := repo.GetPostByID(c.Request.Context(), c.Param("id"))
+		if post == nil {
+			c.JSON(http.StatusNotFound, gin.H{
+				"success": false,
+				"error": map[string]string{
+					"code":    "NOT_FOUND",
+					"message": "게시글을 찾을 수 없습니다",
+				},
+			})
+			return
+		}
+
+		from := post.Status
+		if from == "" {
+			from = model.PostStatusPublished
+		}
+		allowed := false
+		for _, to := range testPostStatusTransitions[from] {
+			if to == req.Status {
+				allowed = true
+			}
+		}
+		if !allowed {
+			badRequest("허용되지 않는 상태 전환입니다")
+			return
+		}
+
+		now := time.Now()
+		publishAt := req.PublishAt
+		switch req.Status {
+		case model.PostStatusScheduled:
+			if publishAt == nil || !publishAt.After(now) {
+				badRequest("예약 발행은 현재 이후의 publishAt이 필요합니다")
+				return
+			}
+		case model.PostStatusPublished:
+			if publishAt == nil {
+				publishAt = &now
+			}
+		case model.PostStatusDraft:
+			publishAt = nil
+		}
+
+		if err := repo.UpdatePostStatus(c.Request.Context(), post.PostID, req.Status, publishAt); err != nil {
+			if _, ok := err.(*repository.PostNotFoundError); ok {
+				c.JSON(http.StatusNotFound, gin.H{"success": false})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"success": false})
+			return
+		}
+
+		updatedPost, _ := repo.GetPostByID(c.Request.Context(), post.PostID)
+		c.Header("ETag", strconv.Quote(strconv.FormatInt(updatedPost.Version, 10)))
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    updatedPost,
+		})
+	}
+}
+
+// [GIVEN] 초안 상태의 게시글이 있는 경우
+// [WHEN] published로 상태 변경 요청
+// [THEN] 상태코드 200과 발행 시간이 설정되는지 확인
+func TestUpdatePostStatus_DraftToPublished(t *testing.T) {
+	// Given
+	mockPosts := CreateTestPosts()
+	mockPosts[0].Status = model.PostStatusDraft
+	mockRepo := &mockPostRepository{posts: mockPosts}
+
+	// When
+	c, w := SetupTestContext("PUT", "/admin/posts/post1/status", `{"status":"published"}`)
+	c.Params = []gin.Param{{Key: "id", Value: "post1"}}
+
+	MockUpdatePostStatus(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, model.PostStatusPublished, mockRepo.posts[0].Status)
+	assert.NotNil(t, mockRepo.posts[0].PublishAt)
+}
+
+// [GIVEN] 초안 상태의 게시글이 있는 경우
+// [WHEN] 미래 시간과 함께 scheduled로 상태 변경 요청
+// [THEN] 상태코드 200과 예약 시간이 저장되는지 확인
+func TestUpdatePostStatus_Schedule(t *testing.T) {
+	// Given
+	mockPosts := CreateTestPosts()
+	mockPosts[0].Status = model.PostStatusDraft
+	mockRepo := &mockPostRepository{posts: mockPosts}
+	publishAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+
+	body, _ := json.Marshal(map[string]interface{}{"status": "scheduled", "publishAt": publishAt})
+
+	// When
+	c, w := SetupTestContext("PUT", "/admin/posts/post1/status", string(body))
+	c.Params = []gin.Param{{Key: "id", Value: "post1"}}
+
+	MockUpdatePostStatus(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, model.PostStatusScheduled, mockRepo.posts[0].Status)
+	assert.True(t, publishAt.Equal(*mockRepo.posts[0].PublishAt))
+}
+
+// [GIVEN] 초안 상태의 게시글이 있는 경우
+// [WHEN] publishAt 없이 scheduled로 상태 변경 요청
+// [THEN] 상태코드 400 반환 확인
+func TestUpdatePostStatus_ScheduleWithoutPublishAt(t *testing.T) {
+	// Given
+	mockPosts := CreateTestPosts()
+	mockPosts[0].Status = model.PostStatusDraft
+	mockRepo := &mockPostRepository{posts: mockPosts}
+
+	// When
+	c, w := SetupTestContext("PUT", "/admin/posts/post1/status", `{"status":"scheduled"}`)
+	c.Params = []gin.Param{{Key: "id", Value: "post1"}}
+
+	MockUpdatePostStatus(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, model.PostStatusDraft, mockRepo.posts[0].Status)
+}
+
+// [GIVEN] 발행된 게시글이 있는 경우
+// [WHEN] scheduled로 상태 변경 요청
+// [THEN] 허용되지 않는 전환으로 상태코드 400 반환 확인
+func TestUpdatePostStatus_InvalidTransition(t *testing.T) {
+	// Given
+	mockRepo := &mockPostRepository{posts: CreateTestPosts()}
+	publishAt := time.Now().Add(time.Hour)
+	body, _ := json.Marshal(map[string]interface{}{"status": "scheduled", "publishAt": publishAt})
+
+	// When
+	c, w := SetupTestContext("PUT", "/admin/posts/post1/status", string(body))
+	c.Params = []gin.Param{{Key: "id", Value: "post1"}}
+
+	MockUpdatePostStatus(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "허용되지 않는 상태 전환입니다", response["error"].(map[string]interface{})["message"])
+}
+
+// [GIVEN] 존재하지 않는 게시글 ID가 주어진 경우
+// [WHEN] 상태 변경 요청
+// [THEN] 상태코드 404 반환 확인
+func TestUpdatePostStatus_NotFound(t *testing.T) {
+	// Given
+	mockRepo := &mockPostRepository{posts: CreateTestPosts()}
+
+	// When
+	c, w := SetupTestContext("PUT", "/admin/posts/nope/status", `{"status":"archived"}`)
+	c.Params = []gin.Param{{Key: "id", Value: "nope"}}
+
+	MockUpdatePostStatus(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+// [GIVEN] 메모리 저장소에 저장된 초안 게시글
+// [WHEN] 상태를 published로 변경한 뒤, 응답의 ETag를 If-Match로 전달해 게시글 수정
+// [THEN] 상태 변경 응답에 올라간 버전과 ETag가 있고, 이어지는 수정이 412 없이 성공하는지 확인
+func TestUpdatePostStatus_ReturnsNewVersion(t *testing.T) {
+	// Given
+	postRepo := repository.NewMemoryPostRepository(testCursors)
+	now := time.Now()
+	post := model.Post{PostID: "post1", Title: "제목", Content: "내용", Summary: "요약", Category: "tech", Status: model.PostStatusDraft, CreatedAt: now, UpdatedAt: now}
+	assert.NoError(t, postRepo.CreatePost(context.Background(), &post))
+	stored, err := postRepo.GetPostByID(context.Background(), "post1")
+	assert.NoError(t, err)
+
+	logger := utils.NewLogger(utils.NewMemorySink())
+	defer logger.Close(context.Background())
+
+	router := gin.New()
+	router.PUT("/admin/posts/:id/status", handler.UpdatePostStatus(postRepo, logger))
+	router.PUT("/admin/posts/:id", handler.UpdatePost(postRepo, repository.NewMemoryRevisionRepository(), logger))
+
+	// When
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPut, "/admin/posts/post1/status", strings.NewReader(`{"status": "published"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response struct {
+		Data model.Post `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, model.PostStatusPublished, response.Data.Status)
+	assert.Greater(t, response.Data.Version, stored.Version)
+	etag := w.Header().Get("ETag")
+	assert.Equal(t, strconv.Quote(strconv.FormatInt(response.Data.Version, 10)), etag)
+
+	// When
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodPut, "/admin/posts/post1", strings.NewReader(`{"title": "수정", "content": "내용", "summary": "요약", "category": "tech"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("If-Match", etag)
+	router.ServeHTTP(w, req)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+}
@@ -9,6 +9,8 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -80,6 +82,23 @@ func MockUpdatePost(repo *PostRepositoryForUpdatePostMock) gin.HandlerFunc {
 			return
 		}
 
+		// If-Match 헤더에서 기대 버전 추출
+		var expectedVersion *int64
+		if ifMatch := c.GetHeader("If-Match"); ifMatch != "" && ifMatch != "*" {
+			version, err := strconv.ParseInt(strings.Trim(ifMatch, `"`), 10, 64)
+			if err != nil || !strings.HasPrefix(ifMatch, `"`) || !strings.HasSuffix(ifMatch, `"`) {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"success": false,
+					"error": gin.H{
+						"code":    "BAD_REQUEST",
+						"message": "If-Match 헤더 형식이 올바르지 않습니다",
+					},
+				})
+				return
+			}
+			expectedVersion = &version
+		}
+
 		// 게시글이 존재하는지 확인
 		existingPost, err := repo.GetPostByID(c.Request.Context(), postID)
 		if err != nil {
@@ -115,7 +134,7 @@ func MockUpdatePost(repo *PostRepositoryForUpdatePostMock) gin.HandlerFunc {
 			UpdatedAt: time.Now(),
 		}
 
-		err = repo.UpdatePost(c.Request.Context(), post)
+		err = repo.UpdatePost(c.Request.Context(), post, expectedVersion)
 		if err != nil {
 			var notFoundErr *repository.PostNotFoundError
 			if errors.As(err, &notFoundErr) {
@@ -129,6 +148,18 @@ func MockUpdatePost(repo *PostRepositoryForUpdatePostMock) gin.HandlerFunc {
 				return
 			}
 
+			var conflictErr *repository.PostVersionConflictError
+			if errors.As(err, &conflictErr) {
+				c.JSON(http.StatusPreconditionFailed, gin.H{
+					"success": false,
+					"error": gin.H{
+						"code":    "PRECONDITION_FAILED",
+						"message": "다른 곳에서 먼저 수정된 게시글입니다. 최신 내용을 다시 불러와 주세요",
+					},
+				})
+				return
+			}
+
 			c.JSON(http.StatusInternalServerError, gin.H{
 				"success": false,
 				"error": gin.H{
@@ -142,6 +173,7 @@ func MockUpdatePost(repo *PostRepositoryForUpdatePostMock) gin.HandlerFunc {
 		// 업데이트된 게시글 조회
 		updatedPost, _ := repo.GetPostByID(c.Request.Context(), postID)
 
+		c.Header("ETag", `"`+strconv.FormatInt(post.Version, 10)+`"`)
 		c.JSON(http.StatusOK, gin.H{
 			"success": true,
 			"data":    updatedPost,
@@ -202,28 +234,41 @@ func (m *PostRepositoryForUpdatePostMock) CreatePost(ctx context.Context, post *
 	return nil
 }
 
-func (m *PostRepositoryForUpdatePostMock) UpdatePost(ctx context.Context, post *model.Post) error {
+func (m *PostRepositoryForUpdatePostMock) UpdatePost(ctx context.Context, post *model.Post, expectedVersion *int64) error {
 	if m.err != nil {
 		return m.err
 	}
 
-	// 게시글 존재 여부 확인
-	found := false
-	for _, p := range m.posts {
-		if p.PostID == post.PostID {
-			found = true
+	// 게시글 존재 여부 및 버전 확인
+	var existing *model.Post
+	for i := range m.posts {
+		if m.posts[i].PostID == post.PostID {
+			existing = &m.posts[i]
 			break
 		}
 	}
 
-	if !found {
+	if existing == nil {
 		return &repository.PostNotFoundError{PostID: post.PostID}
 	}
+	if expectedVersion != nil && existing.Version != *expectedVersion {
+		return &repository.PostVersionConflictError{PostID: post.PostID, ExpectedVersion: *expectedVersion, CurrentVersion: existing.Version}
+	}
+
+	existing.Version++
+	post.Version = existing.Version
 
 	m.updatedPost = post
 	return nil
 }
 
+func (m *PostRepositoryForUpdatePostMock) UpdatePostStatus(ctx context.Context, postID string, status string, publishAt *time.Time) error {
+	if m.err != nil {
+		return m.err
+	}
+	return nil
+}
+
 func (m *PostRepositoryForUpdatePostMock) DeletePost(ctx context.Context, postID string) error {
 	if m.err != nil {
 		return m.err
@@ -436,3 +481,72 @@ func TestUpdatePost_UpdateError(t *testing.T) {
 	errorData := response["error"].(map[string]interface{})
 	assert.Equal(t, "INTERNAL_SERVER_ERROR", errorData["code"])
 }
+
+// [GIVEN] 다른 곳에서 먼저 수정되어 버전이 올라간 게시글
+// [WHEN] 이전 ETag를 If-Match로 전달하여 UpdatePost 핸들러를 호출
+// [THEN] 상태코드 412와 PRECONDITION_FAILED 반환 및 게시글 유지 확인
+func TestUpdatePost_VersionConflict(t *testing.T) {
+	// Given
+	posts := CreateTestPosts()
+	posts[0].Version = 3
+	mockRepo := &PostRepositoryForUpdatePostMock{posts: posts}
+
+	requestBody := `{"title": "늦은 수정", "content": "내용", "summary": "요약", "category": "tech"}`
+
+	// When
+	c, w := setupTestContext("PUT", "/admin/posts/post1", requestBody)
+	c.Request.Header.Set("If-Match", `"2"`)
+	c.AddParam("id", "post1")
+	MockUpdatePost(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "PRECONDITION_FAILED", response["error"].(map[string]interface{})["code"])
+	assert.Nil(t, mockRepo.updatedPost)
+	assert.Equal(t, int64(3), mockRepo.posts[0].Version)
+}
+
+// [GIVEN] 현재 버전과 일치하는 ETag
+// [WHEN] If-Match로 전달하여 UpdatePost 핸들러를 호출
+// [THEN] 상태코드 200과 증가한 버전의 ETag 반환 확인
+func TestUpdatePost_IfMatch(t *testing.T) {
+	// Given
+	posts := CreateTestPosts()
+	posts[0].Version = 3
+	mockRepo := &PostRepositoryForUpdatePostMock{posts: posts}
+
+	requestBody := `{"title": "수정", "content": "내용", "summary": "요약", "category": "tech"}`
+
+	// When
+	c, w := setupTestContext("PUT", "/admin/posts/post1", requestBody)
+	c.Request.Header.Set("If-Match", `"3"`)
+	c.AddParam("id", "post1")
+	MockUpdatePost(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"4"`, w.Header().Get("ETag"))
+}
+
+// [GIVEN] 형식이 잘못된 If-Match 헤더
+// [WHEN] UpdatePost 핸들러를 호출
+// [THEN] 상태코드 400 반환 확인
+func TestUpdatePost_InvalidIfMatch(t *testing.T) {
+	// Given
+	mockRepo := &PostRepositoryForUpdatePostMock{posts: CreateTestPosts()}
+
+	requestBody := `{"title": "수정", "content": "내용", "summary": "요약", "category": "tech"}`
+
+	// When
+	c, w := setupTestContext("PUT", "/admin/posts/post1", requestBody)
+	c.Request.Header.Set("If-Match", "v3")
+	c.AddParam("id", "post1")
+	MockUpdatePost(mockRepo)(c)
+
+	// Then
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
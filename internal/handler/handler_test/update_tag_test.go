@@ -0,0 +1,225 @@
+package handler
+
+import (
+	"bumsiku/internal/model"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// 핸들러 모의 함수 - 로거를 사용하지 않도록 구현
+// merge가 false이면 이름 변경(PUT /admin/tags/:tag), true이면 병합(POST /admin/tags/:tag/merge)으로 동작합니다.
+func MockUpdateTag(postRepo *mockPostRepository, tagRepo *MockTagRepository, merge bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var request struct {
+			Name string `json:"name"`
+			Into string `json:"into"`
+		}
+		if err := c.ShouldBindJSON(&request); err != nil {
+			sendMockError(c, http.StatusBadRequest, "BAD_REQUEST", "요청 형식이 올바르지 않습니다")
+			return
+		}
+
+		target := request.Name
+		if merge {
+			target = request.Into
+		}
+		from := model.NormalizeTag(c.Param("tag"))
+		to := model.NormalizeTag(target)
+
+		if err := model.ValidateTag(to); err != nil {
+			sendMockError(c, http.StatusBadRequest, "BAD_REQUEST", err.Error())
+			return
+		}
+		if from == to {
+			sendMockError(c, http.StatusBadRequest, "BAD_REQUEST", "기존 태그와 같은 태그입니다")
+			return
+		}
+
+		postIDs, _ := tagRepo.GetPostIDsByTag(c.Request.Context(), from)
+		if len(postIDs) == 0 {
+			sendMockError(c, http.StatusNotFound, "NOT_FOUND", "태그를 찾을 수 없습니다")
+			return
+		}
+		if !merge {
+			if existing, _ := tagRepo.GetPostIDsByTag(c.Request.Context(), to); len(existing) > 0 {
+				sendMockError(c, http.StatusConflict, "CONFLICT", "이미 사용 중인 태그입니다. 병합을 사용해 주세요")
+				return
+			}
+		}
+
+		updated := 0
+		for _, postID := range postIDs {
+			post, _ := postRepo.GetPostByID(c.Request.Context(), postID)
+			if post == nil {
+				continue
+			}
+
+			// 태그 교체 및 중복 제거
+			seen := make(map[string]bool)
+			tags := make([]string, 0, len(post.Tags))
+			for _, tag := range post.Tags {
+				if tag == from {
+					tag = to
+				}
+				if !seen[tag] {
+					seen[tag] = true
+					tags = append(tags, tag)
+				}
+			}
+			post.Tags = tags
+
+			expectedVersion := post.Version
+			if err := postRepo.UpdatePost(c.Request.Context(), post, &expectedVersion); err != nil {
+				sendMockError(c, http.StatusInternalServerError, "INTERNAL_SERVER_ERROR", "게시글 태그 변경에 실패했습니다")
+				return
+			}
+			updated++
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data": gin.H{
+				"from":         from,
+				"to":           to,
+				"updatedPosts": updated,
+			},
+		})
+	}
+}
+
+func sendMockError(c *gin.Context, status int, code, message string) {
+	c.JSON(status, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
+
+// createTaggedPosts는 post1에 go, db 태그를, post2에 golang 태그를 단 게시글과 태그 색인을 생성합니다.
+func createTaggedPosts() (*mockPostRepository, *MockTagRepository) {
+	posts := CreateTestPosts()
+	posts[0].Tags = []string{"go", "db"}
+	posts[1].Tags = []string{"golang"}
+
+	tagRepo := &MockTagRepository{postIDs: map[string][]string{
+		"go":     {"post1"},
+		"db":     {"post1"},
+		"golang": {"post2"},
+	}}
+	return &mockPostRepository{posts: posts}, tagRepo
+}
+
+// [GIVEN] 사용 중이지 않은 새 태그 이름
+// [WHEN] 태그 이름 변경
+// [THEN] 상태코드 200과 함께 게시글의 태그가 변경됨을 확인
+func TestRenameTag_Success(t *testing.T) {
+	// Given
+	postRepo, tagRepo := createTaggedPosts()
+
+	// When
+	c, w := SetupTestContextWithSession("PUT", "/admin/tags/golang", `{"name": "Go Lang"}`)
+	c.Params = gin.Params{{Key: "tag", Value: "golang"}}
+
+	MockUpdateTag(postRepo, tagRepo, false)(c)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+
+	data := response["data"].(map[string]interface{})
+	assert.Equal(t, "golang", data["from"])
+	assert.Equal(t, "go-lang", data["to"])
+	assert.Equal(t, float64(1), data["updatedPosts"])
+	assert.Equal(t, []string{"go-lang"}, postRepo.posts[1].Tags)
+}
+
+// [GIVEN] 이미 다른 게시글에서 사용 중인 태그 이름
+// [WHEN] 태그 이름 변경
+// [THEN] 상태코드 409를 반환하고 게시글은 변경되지 않음을 확인
+func TestRenameTag_TargetExists(t *testing.T) {
+	// Given
+	postRepo, tagRepo := createTaggedPosts()
+
+	// When
+	c, w := SetupTestContextWithSession("PUT", "/admin/tags/golang", `{"name": "go"}`)
+	c.Params = gin.Params{{Key: "tag", Value: "golang"}}
+
+	MockUpdateTag(postRepo, tagRepo, false)(c)
+
+	// Then
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Equal(t, []string{"golang"}, postRepo.posts[1].Tags)
+}
+
+// [GIVEN] 게시글이 없는 태그
+// [WHEN] 태그 이름 변경
+// [THEN] 상태코드 404 반환 확인
+func TestRenameTag_NotFound(t *testing.T) {
+	// Given
+	postRepo, tagRepo := createTaggedPosts()
+
+	// When
+	c, w := SetupTestContextWithSession("PUT", "/admin/tags/rust", `{"name": "rustlang"}`)
+	c.Params = gin.Params{{Key: "tag", Value: "rust"}}
+
+	MockUpdateTag(postRepo, tagRepo, false)(c)
+
+	// Then
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+// [GIVEN] 새 이름이 기존 이름과 같거나 형식이 잘못된 경우
+// [WHEN] 태그 이름 변경
+// [THEN] 상태코드 400 반환 확인
+func TestRenameTag_InvalidName(t *testing.T) {
+	for _, body := range []string{`{"name": "GoLang"}`, `{"name": "go/lang"}`, `{}`} {
+		// Given
+		postRepo, tagRepo := createTaggedPosts()
+
+		// When
+		c, w := SetupTestContextWithSession("PUT", "/admin/tags/golang", body)
+		c.Params = gin.Params{{Key: "tag", Value: "golang"}}
+
+		MockUpdateTag(postRepo, tagRepo, false)(c)
+
+		// Then
+		assert.Equal(t, http.StatusBadRequest, w.Code, body)
+	}
+}
+
+// [GIVEN] 두 태그가 모두 달린 게시글과 한 태그만 달린 게시글
+// [WHEN] 한 태그를 다른 태그로 병합
+// [THEN] 모든 게시글에 대상 태그만 남고 중복 없이 정리됨을 확인
+func TestMergeTag_Success(t *testing.T) {
+	// Given
+	postRepo, tagRepo := createTaggedPosts()
+	postRepo.posts[1].Tags = []string{"golang", "go"}
+	tagRepo.postIDs["go"] = []string{"post1", "post2"}
+
+	// When
+	c, w := SetupTestContextWithSession("POST", "/admin/tags/go/merge", `{"into": "golang"}`)
+	c.Params = gin.Params{{Key: "tag", Value: "go"}}
+
+	MockUpdateTag(postRepo, tagRepo, true)(c)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+
+	data := response["data"].(map[string]interface{})
+	assert.Equal(t, float64(2), data["updatedPosts"])
+	assert.Equal(t, []string{"golang", "db"}, postRepo.posts[0].Tags)
+	assert.Equal(t, []string{"golang"}, postRepo.posts[1].Tags)
+}
@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sendUserError(c *gin.Context, status int, code, message string) {
+	c.JSON(status, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
+
+// 핸들러 모의 함수 - 로거를 사용하지 않도록 구현
+func MockCreateUser(userRepo repository.UserRepositoryInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req struct {
+			Username string `json:"username" binding:"required"`
+			Password string `json:"password" binding:"required"`
+			Role     string `json:"role" binding:"required"`
+		}
+		if err := c.ShouldBindJSON(&req); err != nil {
+			sendUserError(c, http.StatusBadRequest, "BAD_REQUEST", "요청 형식이 올바르지 않습니다")
+			return
+		}
+		if !model.IsValidUsername(req.Username) || !model.IsValidUserRole(req.Role) || len(req.Password) < 8 {
+			sendUserError(c, http.StatusBadRequest, "BAD_REQUEST", "잘못된 계정 정보입니다")
+			return
+		}
+
+		user := &model.User{Username: req.Username, PasswordHash: "hash", Role: req.Role}
+		err := userRepo.CreateUser(c.Request.Context(), user)
+		if _, ok := err.(*repository.UserExistsError); ok {
+			sendUserError(c, http.StatusConflict, "CONFLICT", "이미 존재하는 사용자 이름입니다")
+			return
+		}
+		if err != nil {
+			sendUserError(c, http.StatusInternalServerError, "INTERNAL_SERVER_ERROR", "계정 생성에 실패했습니다")
+			return
+		}
+
+		c.JSON(http.StatusCreated, gin.H{"success": true, "data": user})
+	}
+}
+
+// 핸들러 모의 함수 - 로거를 사용하지 않도록 구현
+func MockDeleteUser(userRepo repository.UserRepositoryInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		username := c.Param("username")
+		if username == c.GetString("username") {
+			sendUserError(c, http.StatusBadRequest, "BAD_REQUEST", "자기 자신의 계정은 삭제할 수 없습니다")
+			return
+		}
+
+		users, err := userRepo.GetUsers(c.Request.Context())
+		if err != nil {
+			sendUserError(c, http.StatusInternalServerError, "INTERNAL_SERVER_ERROR", "계정 삭제에 실패했습니다")
+			return
+		}
+		owners := 0
+		var target *model.User
+		for i := range users {
+			if users[i].Role == model.UserRoleOwner {
+				owners++
+			}
+			if users[i].Username == username {
+				target = &users[i]
+			}
+		}
+		if target == nil {
+			sendUserError(c, http.StatusNotFound, "NOT_FOUND", "존재하지 않는 계정입니다")
+			return
+		}
+		if target.Role == model.UserRoleOwner && owners == 1 {
+			sendUserError(c, http.StatusBadRequest, "BAD_REQUEST", "마지막 owner 계정은 삭제할 수 없습니다")
+			return
+		}
+
+		if err := userRepo.DeleteUser(c.Request.Context(), username); err != nil {
+			sendUserError(c, http.StatusInternalServerError, "INTERNAL_SERVER_ERROR", "계정 삭제에 실패했습니다")
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data": gin.H{
+				"message": "계정이 삭제되었습니다",
+			},
+		})
+	}
+}
+
+func setupUserRepository(t *testing.T, users ...model.User) repository.UserRepositoryInterface {
+	userRepo := repository.NewMemoryUserRepository()
+	for i := range users {
+		require.NoError(t, userRepo.CreateUser(context.Background(), &users[i]))
+	}
+	return userRepo
+}
+
+// [GIVEN] 올바른 계정 정보
+// [WHEN] CreateUser 핸들러를 호출
+// [THEN] 상태코드 201과 비밀번호 해시가 없는 계정 정보 반환 확인
+func TestCreateUser_Success(t *testing.T) {
+	userRepo := setupUserRepository(t)
+	body := `{"username": "editor1", "password": "password1", "role": "editor"}`
+	c, w := SetupTestContext("POST", "/admin/users", body)
+
+	MockCreateUser(userRepo)(c)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.NotContains(t, w.Body.String(), "hash")
+
+	var response map[string]interface{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	data := response["data"].(map[string]interface{})
+	assert.Equal(t, "editor1", data["username"])
+	assert.Equal(t, "editor", data["role"])
+}
+
+// [GIVEN] 잘못된 역할 또는 이미 존재하는 사용자 이름
+// [WHEN] CreateUser 핸들러를 호출
+// [THEN] 각각 상태코드 400과 409 반환 확인
+func TestCreateUser_InvalidAndDuplicate(t *testing.T) {
+	userRepo := setupUserRepository(t, model.User{Username: "editor1", Role: model.UserRoleEditor})
+
+	c, w := SetupTestContext("POST", "/admin/users", `{"username": "writer", "password": "password1", "role": "writer"}`)
+	MockCreateUser(userRepo)(c)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	c, w = SetupTestContext("POST", "/admin/users", `{"username": "editor1", "password": "password1", "role": "editor"}`)
+	MockCreateUser(userRepo)(c)
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+// [GIVEN] owner 두 명과 editor 한 명
+// [WHEN] 자기 자신, 다른 owner, 마지막 owner 순서로 삭제
+// [THEN] 자기 자신과 마지막 owner는 400, 다른 owner는 삭제 확인
+func TestDeleteUser_ProtectsSelfAndLastOwner(t *testing.T) {
+	userRepo := setupUserRepository(t,
+		model.User{Username: "admin", Role: model.UserRoleOwner},
+		model.User{Username: "owner2", Role: model.UserRoleOwner},
+		model.User{Username: "editor1", Role: model.UserRoleEditor},
+	)
+
+	deleteUser := func(current, username string) int {
+		c, w := SetupTestContext("DELETE", "/admin/users/"+username, "")
+		c.Params = gin.Params{{Key: "username", Value: username}}
+		c.Set("username", current)
+		MockDeleteUser(userRepo)(c)
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusBadRequest, deleteUser("admin", "admin"))
+	assert.Equal(t, http.StatusNotFound, deleteUser("admin", "missing"))
+	assert.Equal(t, http.StatusOK, deleteUser("admin", "owner2"))
+	// editor1이 owner 권한 없이 요청할 수는 없지만, 마지막 owner 보호는 요청자와 무관
+	assert.Equal(t, http.StatusBadRequest, deleteUser("editor1", "admin"))
+
+	users, err := userRepo.GetUsers(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, users, 2)
+}
@@ -1,79 +1,197 @@
 package handler
 
 import (
+	"bumsiku/internal/auth"
 	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+	loginsession "bumsiku/internal/session"
 	"bumsiku/internal/utils"
+	"fmt"
+	"math"
 	"net/http"
-	"os"
 	"time"
 
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
 )
 
+// LoginResponse 로그인 응답 구조체
+type LoginResponse struct {
+	Message      string `json:"message" example:"로그인에 성공했습니다"`                                                 // 결과 메시지
+	Role         string `json:"role,omitempty" example:"editor"`                                               // 로그인한 계정의 역할 (로그인 완료 시)
+	TOTPRequired bool   `json:"totpRequired,omitempty" example:"false"`                                        // 2단계 인증 코드 확인 필요 여부
+	CSRFToken    string `json:"csrfToken,omitempty" example:"Q2hhbmdlTWUhQ2hhbmdlTWUhQ2hhbmdlTWUhQ2hhbmdlTWU"` // 변경 요청의 X-CSRF-Token 헤더에 보낼 토큰 (로그인 완료 시)
+}
+
 // @Summary     관리자 로그인
 // @Description 블로그 관리자 로그인 API
+// @Description 2단계 인증을 사용하는 계정은 totpRequired가 true로 반환되며, /login/totp로 인증 코드를 확인해야 로그인이 완료됩니다
+// @Description 로그인이 완료되면 csrfToken을 반환하며, 이후 /admin 변경 요청(POST, PUT, DELETE)의 X-CSRF-Token 헤더에 담아야 합니다
+// @Description 로그인에 연속으로 실패하면 다음 시도까지 점점 긴 지연이 생기고, 실패가 계속되면 일정 시간 잠깁니다 (사용자 이름, IP별)
 // @Tags        인증
 // @Accept      json
 // @Produce     json
 // @Param       request body model.LoginRequest true "로그인 정보"
-// @Success     200 {object} map[string]string "로그인 성공"
+// @Success     200 {object} LoginResponse "로그인 성공 또는 2단계 인증 필요 (totpRequired)"
 // @Failure     400 {object} ErrorResponse "잘못된 요청"
 // @Failure     401 {object} ErrorResponse "로그인 실패"
+// @Failure     429 {object} ErrorResponse "요청 수 제한 초과 또는 로그인 잠금 (Retry-After 헤더 참고)"
 // @Failure     500 {object} ErrorResponse "서버 오류"
 // @Router      /login [post]
-func PostLogin(c *gin.Context, logger *utils.Logger) {
-	var loginVals model.LoginRequest
+// PostLogin은 관리자 로그인 핸들러입니다. 비밀번호는 계정의 해시와 상수 시간으로 비교합니다.
+func PostLogin(userRepo repository.UserRepositoryInterface, throttle *auth.LoginThrottle, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var loginVals model.LoginRequest
 
-	if err := c.ShouldBindJSON(&loginVals); err != nil {
+		if err := c.ShouldBindJSON(&loginVals); err != nil {
+			contextInfo := map[string]string{
+				"handler": "PostLogin",
+				"step":    "요청 검증",
+				"ip":      c.ClientIP(),
+			}
+			SendBadRequestErrorWithLogging(c, logger, "잘못된 요청 형식입니다", err, contextInfo)
+			return
+		}
+
+		// 사용자 이름은 로깅하지만 암호는 로깅하지 않음
 		contextInfo := map[string]string{
-			"handler": "PostLogin",
-			"step":    "요청 검증",
-			"ip":      c.ClientIP(),
+			"handler":  "PostLogin",
+			"username": loginVals.Username,
+			"ip":       c.ClientIP(),
 		}
-		SendBadRequestErrorWithLogging(c, logger, "잘못된 요청 형식입니다", err, contextInfo)
-		return
-	}
 
-	// 사용자 이름은 로깅하지만 암호는 로깅하지 않음
-	contextInfo := map[string]string{
-		"handler":  "PostLogin",
-		"username": loginVals.Username,
-		"ip":       c.ClientIP(),
-	}
+		// 연속 실패로 지연 또는 잠금 중이면 자격 증명을 확인하지 않고 거부
+		keys := auth.LoginKeys(loginVals.Username, c.ClientIP())
+		if wait, locked := throttle.Wait(keys, time.Now()); wait > 0 {
+			sendLoginThrottled(c, logger, wait, locked, contextInfo)
+			return
+		}
 
-	if isValidLogin(loginVals) {
-		// 로그인 실패 로깅
-		logger.Warn(c.Request.Context(), "로그인 실패: 잘못된 자격 증명", contextInfo)
-		SendUnauthorizedErrorWithLogging(c, logger, "로그인에 실패했습니다", nil, contextInfo)
-		return
-	}
+		user, err := auth.Authenticate(c.Request.Context(), userRepo, loginVals.Username, loginVals.Password)
+		if err != nil {
+			contextInfo["step"] = "자격 증명 확인"
+			SendInternalServerErrorWithLogging(c, logger, "로그인 처리 중 오류가 발생했습니다", err, contextInfo)
+			return
+		}
+		if user == nil {
+			throttle.Failure(keys, time.Now())
 
-	if err := activateSession(c, loginVals.Username); err != nil {
-		contextInfo["step"] = "세션 활성화"
-		SendInternalServerErrorWithLogging(c, logger, "세션 저장에 실패했습니다", err, contextInfo)
-		return
-	}
+			// 로그인 실패 로깅
+			logger.Warn(c.Request.Context(), "로그인 실패: 잘못된 자격 증명", contextInfo)
+			SendUnauthorizedErrorWithLogging(c, logger, "로그인에 실패했습니다", nil, contextInfo)
+			return
+		}
+
+		// 2단계 인증을 사용하는 계정은 TOTP 확인 전까지 로그인 대기 상태로 둠
+		// 비밀번호만으로 실패 횟수가 초기화되지 않도록 실패 기록은 2단계 인증을 마친 뒤 초기화
+		if user.TOTPEnabled {
+			if err := startTOTPLogin(c, user.Username); err != nil {
+				contextInfo["step"] = "세션 활성화"
+				SendInternalServerErrorWithLogging(c, logger, "세션 저장에 실패했습니다", err, contextInfo)
+				return
+			}
+
+			logger.Info(c.Request.Context(), "관리자 로그인 2단계 인증 대기", contextInfo)
+			SendSuccess(c, http.StatusOK, LoginResponse{
+				Message:      "2단계 인증 코드를 입력해주세요",
+				TOTPRequired: true,
+			})
+			return
+		}
+		throttle.Success(keys)
+
+		csrfToken, err := activateSession(c, user)
+		if err != nil {
+			contextInfo["step"] = "세션 활성화"
+			SendInternalServerErrorWithLogging(c, logger, "세션 저장에 실패했습니다", err, contextInfo)
+			return
+		}
 
-	// 로그인 성공 로깅
-	logger.Info(c.Request.Context(), "관리자 로그인 성공", contextInfo)
+		// 로그인 성공 로깅
+		contextInfo["role"] = user.Role
+		logger.Info(c.Request.Context(), "관리자 로그인 성공", contextInfo)
 
-	SendSuccess(c, http.StatusOK, map[string]string{
-		"message": "로그인에 성공했습니다",
-	})
+		SendSuccess(c, http.StatusOK, LoginResponse{
+			Message:   "로그인에 성공했습니다",
+			Role:      user.Role,
+			CSRFToken: csrfToken,
+		})
+	}
 }
 
-func isValidLogin(value model.LoginRequest) bool {
-	return !(value.Username == os.Getenv("ADMIN_ID") && value.Password == os.Getenv("ADMIN_PW"))
+// sendLoginThrottled는 로그인 실패 제한으로 거부한 요청에 Retry-After 헤더와 429 응답을 보냅니다.
+func sendLoginThrottled(c *gin.Context, logger *utils.Logger, wait time.Duration, locked bool, contextInfo map[string]string) {
+	retryAfter := int(math.Ceil(wait.Seconds()))
+	contextInfo["step"] = "로그인 시도 제한"
+	contextInfo["retryAfter"] = fmt.Sprintf("%d", retryAfter)
+
+	message := fmt.Sprintf("로그인 시도가 너무 많습니다. %d초 후 다시 시도해주세요", retryAfter)
+	if locked {
+		message = fmt.Sprintf("로그인 실패가 반복되어 잠겼습니다. %d분 후 다시 시도해주세요", int(math.Ceil(wait.Minutes())))
+	}
+
+	c.Header("Retry-After", fmt.Sprintf("%d", retryAfter))
+	SendErrorWithLogging(c, logger, http.StatusTooManyRequests, "TOO_MANY_REQUESTS", message, nil, contextInfo)
 }
 
-func activateSession(c *gin.Context, username string) error {
+// activateSession은 로그인을 마친 계정으로 세션을 활성화하고 새로 발급한 CSRF 토큰을 반환합니다.
+// 2단계 인증을 사용하는 계정은 TOTP 확인을 마친 경우에만 호출합니다.
+// IP와 브라우저는 로그인 세션 목록에 표시하기 위해 저장합니다.
+// 세션 고정을 막기 위해 세션 토큰은 항상 새로 발급합니다.
+func activateSession(c *gin.Context, user *model.User) (string, error) {
 	session := sessions.Default(c)
-	session.Set("admin", true)
-	session.Set("username", username)
+	// 권한이 바뀌므로 로그인 전(또는 2단계 인증 대기 중) 세션 토큰을 폐기하고 새로 발급
+	loginsession.Regenerate(session)
+	csrfToken, err := issueCSRFToken(session)
+	if err != nil {
+		return "", err
+	}
+	session.Delete(sessionKeyTOTPPending)
+	session.Delete(sessionKeyTOTPPendingAt)
+	session.Set("username", user.Username)
+	session.Set(SessionKeyTOTPVerified, user.TOTPEnabled)
 	session.Set("loginTime", time.Now())
+	session.Set("ip", c.ClientIP())
+	session.Set("userAgent", c.Request.UserAgent())
 	if err := session.Save(); err != nil {
-		return err
+		return "", err
+	}
+	return csrfToken, nil
+}
+
+// @Summary     로그아웃
+// @Description 현재 로그인 세션을 서버에서 삭제하고 세션 쿠키를 지웁니다
+// @Description 로그인하지 않은 상태에서 요청해도 성공으로 응답합니다
+// @Tags        인증
+// @Produce     json
+// @Success     200 {object} map[string]string "로그아웃 성공"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /logout [post]
+// PostLogout은 현재 로그인 세션을 폐기하는 핸들러입니다.
+func PostLogout(logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		session := sessions.Default(c)
+		username, _ := session.Get("username").(string)
+		contextInfo := map[string]string{
+			"handler":  "PostLogout",
+			"username": username,
+			"ip":       c.ClientIP(),
+		}
+
+		// MaxAge가 음수이면 세션 저장소가 세션을 삭제하고 쿠키를 지움
+		session.Clear()
+		session.Options(sessions.Options{Path: "/", MaxAge: -1})
+		if err := session.Save(); err != nil {
+			contextInfo["step"] = "세션 삭제"
+			SendInternalServerErrorWithLogging(c, logger, "로그아웃에 실패했습니다", err, contextInfo)
+			return
+		}
+
+		if username != "" {
+			logger.Info(c.Request.Context(), "관리자 로그아웃", contextInfo)
+		}
+		SendSuccess(c, http.StatusOK, map[string]string{
+			"message": "로그아웃되었습니다",
+		})
 	}
-	return nil
 }
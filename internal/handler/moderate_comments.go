@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+	"bumsiku/internal/utils"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxModerateComments는 한 번에 검토할 수 있는 최대 댓글 수입니다.
+const maxModerateComments = 100
+
+// moderateActions는 검토 동작별 변경할 댓글 상태입니다.
+var moderateActions = map[string]string{
+	"approve": model.CommentStatusApproved,
+	"reject":  model.CommentStatusRejected,
+	"spam":    model.CommentStatusSpam,
+}
+
+// ModerateCommentsRequest 댓글 일괄 검토 요청 구조체
+type ModerateCommentsRequest struct {
+	CommentIDs []string `json:"commentIds" binding:"required,min=1" example:"comment-123,comment-456"` // 검토할 댓글 ID 목록 (최대 100개)
+	Action     string   `json:"action" binding:"required" example:"approve"`                           // 검토 동작 (approve, reject, spam)
+}
+
+// ModerateCommentsResponse 댓글 일괄 검토 응답 구조체
+type ModerateCommentsResponse struct {
+	Status   string   `json:"status" example:"approved"`      // 변경된 댓글 상태
+	Updated  []string `json:"updated" example:"comment-123"`  // 상태를 변경한 댓글 ID
+	NotFound []string `json:"notFound" example:"comment-456"` // 존재하지 않는 댓글 ID
+}
+
+// @Summary     댓글 일괄 검토
+// @Description 댓글들을 승인(approve), 거절(reject), 스팸 처리(spam)합니다 (관리자 전용)
+// @Description 승인된 댓글만 공개되며, 존재하지 않는 댓글 ID는 notFound로 반환합니다
+// @Tags        댓글
+// @Accept      json
+// @Produce     json
+// @Security    AdminAuth
+// @Security    BearerAuth
+// @Param       request body ModerateCommentsRequest true "검토할 댓글과 동작"
+// @Success     200 {object} ModerateCommentsResponse
+// @Failure     400 {object} ErrorResponse "잘못된 요청"
+// @Failure     401 {object} ErrorResponse "인증 실패"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /admin/comments/moderate [post]
+// ModerateComments는 관리자 전용 댓글 일괄 검토 핸들러입니다.
+func ModerateComments(commentRepo repository.CommentRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		contextInfo := map[string]string{
+			"handler":  "ModerateComments",
+			"clientIP": c.ClientIP(),
+		}
+
+		// 1. 요청 검증
+		var req ModerateCommentsRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			contextInfo["step"] = "요청 검증"
+			SendBadRequestErrorWithLogging(c, logger, "요청 형식이 올바르지 않습니다", err, contextInfo)
+			return
+		}
+		contextInfo["action"] = req.Action
+
+		status, ok := moderateActions[req.Action]
+		if !ok {
+			contextInfo["step"] = "동작 검증"
+			SendBadRequestErrorWithLogging(c, logger, "유효하지 않은 검토 동작입니다 (approve, reject, spam)", nil, contextInfo)
+			return
+		}
+		if len(req.CommentIDs) > maxModerateComments {
+			contextInfo["step"] = "요청 검증"
+			SendBadRequestErrorWithLogging(c, logger, fmt.Sprintf("한 번에 최대 %d개의 댓글을 검토할 수 있습니다", maxModerateComments), nil, contextInfo)
+			return
+		}
+
+		// 2. 상태 변경
+		updated, err := commentRepo.SetCommentStatus(c.Request.Context(), req.CommentIDs, status)
+		if err != nil {
+			contextInfo["step"] = "상태 변경"
+			contextInfo["updatedCount"] = fmt.Sprintf("%d", len(updated))
+			SendInternalServerErrorWithLogging(c, logger, "댓글 검토에 실패했습니다", err, contextInfo)
+			return
+		}
+
+		// 3. 변경되지 않은 댓글은 존재하지 않는 댓글
+		changed := make(map[string]bool, len(updated))
+		for _, commentID := range updated {
+			changed[commentID] = true
+		}
+		notFound := make([]string, 0)
+		for _, commentID := range req.CommentIDs {
+			if !changed[commentID] {
+				changed[commentID] = true // 중복 ID는 한 번만 포함
+				notFound = append(notFound, commentID)
+			}
+		}
+
+		// 성공 로깅
+		contextInfo["status"] = status
+		contextInfo["updated"] = strings.Join(updated, ",")
+		contextInfo["notFoundCount"] = fmt.Sprintf("%d", len(notFound))
+		contextInfo["moderatedBy"] = c.GetString("username")
+		logger.Info(c.Request.Context(), "댓글 검토 성공", contextInfo)
+
+		SendSuccess(c, http.StatusOK, ModerateCommentsResponse{
+			Status:   status,
+			Updated:  updated,
+			NotFound: notFound,
+		})
+	}
+}
@@ -0,0 +1,301 @@
+package handler
+
+import (
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+	"bumsiku/internal/utils"
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PostRevisionDiffResponse는 리비전과 현재 게시글 내용의 비교 결과입니다.
+type PostRevisionDiffResponse struct {
+	PostID   string `json:"postId" example:"post-123"`                                                     // 게시물 ID
+	Revision int    `json:"revision" example:"3"`                                                          // 비교한 리비전 번호
+	Diff     string `json:"diff" example:"--- revision 3\n+++ current\n@@ -1,1 +1,1 @@\n-이전 내용\n+현재 내용\n"` // 리비전 → 현재 내용 unified diff (같으면 빈 문자열)
+}
+
+// sessionUsername은 인증 미들웨어가 컨텍스트에 저장한 관리자 ID를 반환합니다.
+func sessionUsername(c *gin.Context) string {
+	return c.GetString("username")
+}
+
+// newPostRevision은 게시글의 현재 내용으로 리비전을 생성합니다.
+func newPostRevision(post *model.Post, editor string, createdAt time.Time) *model.PostRevision {
+	return &model.PostRevision{
+		PostID:    post.PostID,
+		Title:     post.Title,
+		Content:   post.Content,
+		Summary:   post.Summary,
+		Category:  post.Category,
+		Editor:    editor,
+		CreatedAt: createdAt,
+	}
+}
+
+// preserveOriginalRevision은 리비전 기록 도입 이전에 작성된 게시글이면
+// 수정 전 내용을 첫 리비전으로 보존합니다. 게시글이 없으면 아무것도 하지 않습니다.
+func preserveOriginalRevision(ctx context.Context, postRepo repository.PostRepositoryInterface, revisionRepo repository.RevisionRepositoryInterface, postID string) error {
+	revisions, err := revisionRepo.GetRevisions(ctx, postID)
+	if err != nil || len(revisions) > 0 {
+		return err
+	}
+
+	post, err := postRepo.GetPostByID(ctx, postID)
+	if err != nil || post == nil {
+		return err
+	}
+
+	return revisionRepo.CreateRevision(ctx, newPostRevision(post, "", post.UpdatedAt))
+}
+
+// parseRevisionParam은 경로의 리비전 번호를 검증합니다. 실패하면 400 응답 후 false를 반환합니다.
+func parseRevisionParam(c *gin.Context, logger *utils.Logger, handlerName, postID string) (int, bool) {
+	revParam := c.Param("rev")
+	rev, err := strconv.Atoi(revParam)
+	if err != nil || rev < 1 {
+		contextInfo := map[string]string{
+			"handler":  handlerName,
+			"step":     "경로 파라미터 확인",
+			"postID":   postID,
+			"revision": revParam,
+		}
+		SendBadRequestErrorWithLogging(c, logger, "리비전 번호가 올바르지 않습니다", nil, contextInfo)
+		return 0, false
+	}
+	return rev, true
+}
+
+// @Summary     게시물 리비전 목록 조회
+// @Description 게시물의 저장 이력을 최신순으로 조회합니다. 본문은 포함하지 않습니다 (관리자 전용)
+// @Tags        게시물
+// @Accept      json
+// @Produce     json
+// @Security    AdminAuth
+// @Security    BearerAuth
+// @Param       id path string true "게시물 ID"
+// @Success     200 {object} map[string]interface{} "리비전 목록"
+// @Failure     401 {object} ErrorResponse "인증 실패"
+// @Failure     404 {object} ErrorResponse "게시물을 찾을 수 없음"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /admin/posts/{id}/revisions [get]
+// GetPostRevisions는 관리자 전용 게시글 리비전 목록 조회 핸들러입니다.
+func GetPostRevisions(postRepo repository.PostRepositoryInterface, revisionRepo repository.RevisionRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		postID := c.Param("id")
+
+		// 1. 게시글 존재 확인
+		post, err := postRepo.GetPostByID(c.Request.Context(), postID)
+		if err != nil {
+			contextInfo := map[string]string{
+				"handler": "GetPostRevisions",
+				"step":    "게시글 조회",
+				"postID":  postID,
+			}
+			SendInternalServerErrorWithLogging(c, logger, "게시글 조회에 실패했습니다", err, contextInfo)
+			return
+		}
+		if post == nil {
+			contextInfo := map[string]string{
+				"handler": "GetPostRevisions",
+				"step":    "게시글 조회",
+				"postID":  postID,
+			}
+			SendNotFoundErrorWithLogging(c, logger, "게시글을 찾을 수 없습니다", nil, contextInfo)
+			return
+		}
+
+		// 2. 리비전 목록 조회
+		revisions, err := revisionRepo.GetRevisions(c.Request.Context(), postID)
+		if err != nil {
+			contextInfo := map[string]string{
+				"handler": "GetPostRevisions",
+				"step":    "리비전 목록 조회",
+				"postID":  postID,
+			}
+			SendInternalServerErrorWithLogging(c, logger, "리비전 목록 조회에 실패했습니다", err, contextInfo)
+			return
+		}
+
+		logger.Info(c.Request.Context(), "리비전 목록 조회 성공", map[string]string{
+			"handler":       "GetPostRevisions",
+			"postID":        postID,
+			"revisionCount": fmt.Sprintf("%d", len(revisions)),
+		})
+
+		SendSuccess(c, http.StatusOK, map[string]interface{}{
+			"revisions": revisions,
+		})
+	}
+}
+
+// @Summary     게시물 리비전 비교
+// @Description 지정한 리비전과 현재 게시물 본문의 줄 단위 unified diff를 반환합니다 (관리자 전용)
+// @Tags        게시물
+// @Accept      json
+// @Produce     json
+// @Security    AdminAuth
+// @Security    BearerAuth
+// @Param       id path string true "게시물 ID"
+// @Param       rev path int true "리비전 번호"
+// @Success     200 {object} PostRevisionDiffResponse
+// @Failure     400 {object} ErrorResponse "잘못된 리비전 번호"
+// @Failure     401 {object} ErrorResponse "인증 실패"
+// @Failure     404 {object} ErrorResponse "게시물 또는 리비전을 찾을 수 없음"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /admin/posts/{id}/revisions/{rev}/diff [get]
+// GetPostRevisionDiff는 관리자 전용 리비전 비교 핸들러입니다.
+func GetPostRevisionDiff(postRepo repository.PostRepositoryInterface, revisionRepo repository.RevisionRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		postID := c.Param("id")
+
+		// 1. 경로 파라미터 확인
+		rev, ok := parseRevisionParam(c, logger, "GetPostRevisionDiff", postID)
+		if !ok {
+			return
+		}
+
+		contextInfo := map[string]string{
+			"handler":  "GetPostRevisionDiff",
+			"postID":   postID,
+			"revision": strconv.Itoa(rev),
+		}
+
+		// 2. 리비전과 현재 게시글 조회
+		revision, err := revisionRepo.GetRevision(c.Request.Context(), postID, rev)
+		if err != nil {
+			contextInfo["step"] = "리비전 조회"
+			SendInternalServerErrorWithLogging(c, logger, "리비전 조회에 실패했습니다", err, contextInfo)
+			return
+		}
+		if revision == nil {
+			contextInfo["step"] = "리비전 조회"
+			SendNotFoundErrorWithLogging(c, logger, "리비전을 찾을 수 없습니다", nil, contextInfo)
+			return
+		}
+
+		post, err := postRepo.GetPostByID(c.Request.Context(), postID)
+		if err != nil {
+			contextInfo["step"] = "게시글 조회"
+			SendInternalServerErrorWithLogging(c, logger, "게시글 조회에 실패했습니다", err, contextInfo)
+			return
+		}
+		if post == nil {
+			contextInfo["step"] = "게시글 조회"
+			SendNotFoundErrorWithLogging(c, logger, "게시글을 찾을 수 없습니다", nil, contextInfo)
+			return
+		}
+
+		// 3. 리비전 → 현재 내용 비교
+		diff := utils.UnifiedDiff(fmt.Sprintf("revision %d", rev), "current", revision.Content, post.Content)
+
+		logger.Info(c.Request.Context(), "리비전 비교 성공", contextInfo)
+
+		SendSuccess(c, http.StatusOK, PostRevisionDiffResponse{
+			PostID:   postID,
+			Revision: rev,
+			Diff:     diff,
+		})
+	}
+}
+
+// @Summary     게시물 리비전 복원
+// @Description 게시물의 제목, 본문, 요약, 카테고리를 지정한 리비전으로 되돌립니다.
+// @Description 복원 결과는 새 리비전으로 기록됩니다 (관리자 전용)
+// @Tags        게시물
+// @Accept      json
+// @Produce     json
+// @Security    AdminAuth
+// @Security    BearerAuth
+// @Param       id path string true "게시물 ID"
+// @Param       rev path int true "복원할 리비전 번호"
+// @Success     200 {object} model.Post
+// @Failure     400 {object} ErrorResponse "잘못된 리비전 번호"
+// @Failure     401 {object} ErrorResponse "인증 실패"
+// @Failure     404 {object} ErrorResponse "게시물 또는 리비전을 찾을 수 없음"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /admin/posts/{id}/revisions/{rev}/restore [post]
+// RestorePostRevision은 관리자 전용 리비전 복원 핸들러입니다.
+func RestorePostRevision(postRepo repository.PostRepositoryInterface, revisionRepo repository.RevisionRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		postID := c.Param("id")
+
+		// 1. 경로 파라미터 확인
+		rev, ok := parseRevisionParam(c, logger, "RestorePostRevision", postID)
+		if !ok {
+			return
+		}
+
+		contextInfo := map[string]string{
+			"handler":  "RestorePostRevision",
+			"postID":   postID,
+			"revision": strconv.Itoa(rev),
+		}
+
+		// 2. 복원할 리비전 조회
+		revision, err := revisionRepo.GetRevision(c.Request.Context(), postID, rev)
+		if err != nil {
+			contextInfo["step"] = "리비전 조회"
+			SendInternalServerErrorWithLogging(c, logger, "리비전 조회에 실패했습니다", err, contextInfo)
+			return
+		}
+		if revision == nil {
+			contextInfo["step"] = "리비전 조회"
+			SendNotFoundErrorWithLogging(c, logger, "리비전을 찾을 수 없습니다", nil, contextInfo)
+			return
+		}
+
+		// 3. 게시글을 리비전 내용으로 수정
+		now := time.Now()
+		err = postRepo.UpdatePost(c.Request.Context(), &model.Post{
+			PostID:    postID,
+			Title:     revision.Title,
+			Content:   revision.Content,
+			Summary:   revision.Summary,
+			Category:  revision.Category,
+			UpdatedAt: now,
+		}, nil)
+		if err != nil {
+			contextInfo["step"] = "게시글 업데이트"
+
+			// PostNotFoundError 확인
+			if _, ok := err.(*repository.PostNotFoundError); ok {
+				SendNotFoundErrorWithLogging(c, logger, "게시글을 찾을 수 없습니다", err, contextInfo)
+				return
+			}
+
+			SendInternalServerErrorWithLogging(c, logger, "게시글 복원에 실패했습니다", err, contextInfo)
+			return
+		}
+
+		// 4. 복원된 게시글 조회
+		restoredPost, err := postRepo.GetPostByID(c.Request.Context(), postID)
+		if err != nil || restoredPost == nil {
+			contextInfo["step"] = "복원된 게시글 조회"
+			SendInternalServerErrorWithLogging(c, logger, "복원된 게시글 조회에 실패했습니다", err, contextInfo)
+			return
+		}
+
+		// 5. 복원 결과를 새 리비전으로 기록
+		restored := newPostRevision(restoredPost, sessionUsername(c), now)
+		restored.RestoredFrom = rev
+		if err := revisionRepo.CreateRevision(c.Request.Context(), restored); err != nil {
+			// 리비전 기록 실패 로그를 남기지만, 사용자에게는 복원 성공으로 응답
+			logger.Warn(c.Request.Context(), "게시글 복원 성공 후 리비전 기록 실패", map[string]string{
+				"handler": "RestorePostRevision",
+				"step":    "리비전 기록",
+				"postID":  postID,
+				"error":   err.Error(),
+			})
+		}
+
+		logger.Info(c.Request.Context(), "게시글이 리비전으로 복원되었습니다", contextInfo)
+
+		SendSuccess(c, http.StatusOK, restoredPost)
+	}
+}
@@ -2,7 +2,6 @@ package handler
 
 import (
 	"bumsiku/internal/utils"
-	"context"
 	"fmt"
 	"net/http"
 
@@ -49,13 +48,6 @@ func SendError(c *gin.Context, statusCode int, errorCode string, message string)
 
 // SendErrorWithLogging은 오류를 로깅하고 응답을 반환하는 헬퍼 함수입니다.
 func SendErrorWithLogging(c *gin.Context, logger *utils.Logger, statusCode int, errorCode string, message string, err error, contextInfo map[string]string) {
-	// 요청 ID 가져오기
-	requestID, exists := c.Get("requestID")
-	requestIDStr := ""
-	if exists {
-		requestIDStr = fmt.Sprintf("%v", requestID)
-	}
-
 	// 기본 로그 필드 설정
 	fields := map[string]string{
 		"method":     c.Request.Method,
@@ -69,11 +61,6 @@ func SendErrorWithLogging(c *gin.Context, logger *utils.Logger, statusCode int,
 		fields[k] = v
 	}
 
-	// 요청 ID 추가
-	if requestIDStr != "" {
-		fields["requestID"] = requestIDStr
-	}
-
 	// 원본 오류가 있으면 추가
 	errorDetail := message
 	if err != nil {
@@ -81,8 +68,8 @@ func SendErrorWithLogging(c *gin.Context, logger *utils.Logger, statusCode int,
 		fields["errorDetail"] = err.Error()
 	}
 
-	// 오류 로깅
-	logger.Error(context.Background(), errorDetail, fields)
+	// 오류 로깅 (요청 ID와 추적 정보는 요청 컨텍스트에서 붙음)
+	logger.Error(c.Request.Context(), errorDetail, fields)
 
 	// 클라이언트에 응답
 	c.JSON(statusCode, APIResponse{
@@ -143,3 +130,23 @@ func SendForbiddenError(c *gin.Context, message string) {
 func SendForbiddenErrorWithLogging(c *gin.Context, logger *utils.Logger, message string, err error, contextInfo map[string]string) {
 	SendErrorWithLogging(c, logger, http.StatusForbidden, "FORBIDDEN", message, err, contextInfo)
 }
+
+// SendPreconditionFailedError는 사전 조건 실패(412) 오류를 반환하는 헬퍼 함수입니다.
+func SendPreconditionFailedError(c *gin.Context, message string) {
+	SendError(c, http.StatusPreconditionFailed, "PRECONDITION_FAILED", message)
+}
+
+// SendPreconditionFailedErrorWithLogging는 사전 조건 실패 오류를 로깅하고 반환하는 헬퍼 함수입니다.
+func SendPreconditionFailedErrorWithLogging(c *gin.Context, logger *utils.Logger, message string, err error, contextInfo map[string]string) {
+	SendErrorWithLogging(c, logger, http.StatusPreconditionFailed, "PRECONDITION_FAILED", message, err, contextInfo)
+}
+
+// SendConflictError는 충돌(409) 오류를 반환하는 헬퍼 함수입니다.
+func SendConflictError(c *gin.Context, message string) {
+	SendError(c, http.StatusConflict, "CONFLICT", message)
+}
+
+// SendConflictErrorWithLogging는 충돌 오류를 로깅하고 반환하는 헬퍼 함수입니다.
+func SendConflictErrorWithLogging(c *gin.Context, logger *utils.Logger, message string, err error, contextInfo map[string]string) {
+	SendErrorWithLogging(c, logger, http.StatusConflict, "CONFLICT", message, err, contextInfo)
+}
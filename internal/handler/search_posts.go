@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"bumsiku/internal/search"
+	"bumsiku/internal/utils"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxSearchQueryLength는 검색어 최대 글자 수입니다.
+const maxSearchQueryLength = 100
+
+// @Summary     게시물 검색
+// @Description 제목, 요약, 본문에서 검색어를 찾아 관련도순으로 반환합니다
+// @Description 한글은 조사를 제거하고 2글자 단위로 비교하며, 검색어의 모든 단어를 포함하는 게시물만 반환합니다
+// @Description 각 결과의 snippet은 HTML 이스케이프된 본문 발췌이며 검색어는 <mark>로 감싸져 있습니다
+// @Tags        게시물
+// @Accept      json
+// @Produce     json
+// @Param       q query string true "검색어"
+// @Param       page query int false "페이지 번호 (기본값: 1)"
+// @Param       pageSize query int false "페이지 크기 (기본값: 10, 최대: 100)"
+// @Success     200 {object} GetPostsResponse{posts=[]search.Result}
+// @Failure     400 {object} ErrorResponse "검색어 누락 또는 너무 긴 검색어"
+// @Router      /search [get]
+// SearchPosts는 게시글 검색 핸들러입니다.
+func SearchPosts(index *search.Index, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		query := strings.TrimSpace(c.Query("q"))
+		if query == "" || utf8.RuneCountInString(query) > maxSearchQueryLength {
+			contextInfo := map[string]string{
+				"handler":  "SearchPosts",
+				"step":     "파라미터 검증",
+				"query":    query,
+				"clientIP": c.ClientIP(),
+			}
+			SendBadRequestErrorWithLogging(c, logger, fmt.Sprintf("검색어는 1자 이상 %d자 이하로 입력해 주세요", maxSearchQueryLength), nil, contextInfo)
+			return
+		}
+
+		// 기본값 설정
+		page := int32(1)
+		pageSize := int32(10)
+
+		if p, err := strconv.ParseInt(c.Query("page"), 10, 32); err == nil && p > 0 {
+			page = int32(p)
+		}
+		if size, err := strconv.ParseInt(c.Query("pageSize"), 10, 32); err == nil && size > 0 {
+			pageSize = int32(min(size, maxPageSize))
+		}
+
+		// 큰 페이지 번호에서 넘치지 않도록 int64로 계산
+		offset := int64(page-1) * int64(pageSize)
+		results, total := index.Search(query, int(offset), int(pageSize))
+
+		logger.Info(c.Request.Context(), "게시글 검색 성공", map[string]string{
+			"handler":    "SearchPosts",
+			"query":      query,
+			"page":       fmt.Sprintf("%d", page),
+			"pageSize":   fmt.Sprintf("%d", pageSize),
+			"totalCount": fmt.Sprintf("%d", total),
+			"clientIP":   c.ClientIP(),
+		})
+
+		SendSuccess(c, http.StatusOK, GetPostsResponse{
+			Posts:       results,
+			TotalCount:  int64(total),
+			CurrentPage: page,
+			TotalPages:  int32((int64(total) + int64(pageSize) - 1) / int64(pageSize)),
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+	"bumsiku/internal/utils"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+)
+
+// SessionResponse 로그인 세션 응답 구조체
+type SessionResponse struct {
+	model.Session
+	Current bool `json:"current" example:"true"` // 요청에 사용한 세션 여부
+}
+
+// GetSessionsResponse 로그인 세션 목록 응답 구조체
+type GetSessionsResponse struct {
+	Sessions []SessionResponse `json:"sessions"` // 최근 사용순 로그인 세션 목록 (만료된 세션 제외)
+}
+
+// @Summary     로그인 세션 목록 조회
+// @Description 로그인한 계정의 유효한 로그인 세션 목록을 조회합니다 (IP, 브라우저, 로그인 시간 포함)
+// @Description owner는 username 파라미터로 다른 계정의 세션을 조회할 수 있습니다
+// @Tags        인증
+// @Accept      json
+// @Produce     json
+// @Security    AdminAuth
+// @Param       username query string false "조회할 계정 (owner 전용)"
+// @Success     200 {object} GetSessionsResponse
+// @Failure     401 {object} ErrorResponse "인증 실패"
+// @Failure     403 {object} ErrorResponse "권한 없음"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /admin/sessions [get]
+func GetSessions(sessionRepo repository.SessionRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		username := c.GetString("username")
+		contextInfo := map[string]string{
+			"handler":  "GetSessions",
+			"username": username,
+		}
+
+		target := username
+		if query := c.Query("username"); query != "" && query != username {
+			if c.GetString("role") != model.UserRoleOwner {
+				contextInfo["step"] = "권한 확인"
+				contextInfo["target"] = query
+				SendForbiddenErrorWithLogging(c, logger, "다른 계정의 세션은 owner만 조회할 수 있습니다", nil, contextInfo)
+				return
+			}
+			target = query
+		}
+
+		stored, err := sessionRepo.GetSessions(c.Request.Context(), target)
+		if err != nil {
+			contextInfo["step"] = "세션 목록 조회"
+			contextInfo["target"] = target
+			SendInternalServerErrorWithLogging(c, logger, "로그인 세션 목록 조회에 실패했습니다", err, contextInfo)
+			return
+		}
+
+		// 만료되어 아직 정리되지 않은 세션은 제외
+		now := time.Now()
+		currentID := sessions.Default(c).ID()
+		result := make([]SessionResponse, 0, len(stored))
+		for _, session := range stored {
+			if session.IsExpired(now) {
+				continue
+			}
+			result = append(result, SessionResponse{
+				Session: session,
+				Current: session.SessionID == currentID,
+			})
+		}
+
+		SendSuccess(c, http.StatusOK, GetSessionsResponse{Sessions: result})
+	}
+}
+
+// @Summary     로그인 세션 폐기
+// @Description 로그인 세션을 폐기합니다. 폐기한 세션은 다음 요청부터 인증에 실패합니다
+// @Description 자신의 세션만 폐기할 수 있으며, owner는 모든 계정의 세션을 폐기할 수 있습니다
+// @Tags        인증
+// @Accept      json
+// @Produce     json
+// @Security    AdminAuth
+// @Param       id path string true "세션 ID"
+// @Success     200 {object} map[string]string "폐기 성공"
+// @Failure     401 {object} ErrorResponse "인증 실패"
+// @Failure     404 {object} ErrorResponse "세션 없음"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /admin/sessions/{id} [delete]
+func DeleteSession(sessionRepo repository.SessionRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		sessionID := c.Param("id")
+		contextInfo := map[string]string{
+			"handler":   "DeleteSession",
+			"sessionId": sessionID,
+			"username":  c.GetString("username"),
+		}
+
+		session, err := sessionRepo.GetSession(c.Request.Context(), sessionID)
+		if err != nil {
+			contextInfo["step"] = "세션 조회"
+			SendInternalServerErrorWithLogging(c, logger, "로그인 세션 폐기에 실패했습니다", err, contextInfo)
+			return
+		}
+		// 다른 계정의 세션은 존재 여부를 드러내지 않음
+		if session == nil || (session.Username != c.GetString("username") && c.GetString("role") != model.UserRoleOwner) {
+			contextInfo["step"] = "세션 조회"
+			SendNotFoundErrorWithLogging(c, logger, "존재하지 않는 로그인 세션입니다", nil, contextInfo)
+			return
+		}
+
+		err = sessionRepo.DeleteSession(c.Request.Context(), sessionID)
+		if _, ok := err.(*repository.SessionNotFoundError); ok {
+			contextInfo["step"] = "세션 삭제"
+			SendNotFoundErrorWithLogging(c, logger, "존재하지 않는 로그인 세션입니다", err, contextInfo)
+			return
+		}
+		if err != nil {
+			contextInfo["step"] = "세션 삭제"
+			SendInternalServerErrorWithLogging(c, logger, "로그인 세션 폐기에 실패했습니다", err, contextInfo)
+			return
+		}
+
+		contextInfo["owner"] = session.Username
+		contextInfo["current"] = fmt.Sprintf("%t", sessionID == sessions.Default(c).ID())
+		logger.Info(c.Request.Context(), "로그인 세션 폐기", contextInfo)
+		SendSuccess(c, http.StatusOK, map[string]string{
+			"message": "로그인 세션이 폐기되었습니다",
+		})
+	}
+}
@@ -0,0 +1,389 @@
+package handler
+
+import (
+	"bumsiku/internal/auth"
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+	loginsession "bumsiku/internal/session"
+	"bumsiku/internal/utils"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+)
+
+// 2단계 인증 세션 키
+const (
+	// SessionKeyTOTPVerified는 로그인할 때 2단계 인증을 마쳤는지 기록합니다.
+	// 로그인 뒤에 2단계 인증을 등록한 계정의 다른 세션은 이 값이 없으므로 인증 미들웨어에서 거부됩니다.
+	SessionKeyTOTPVerified = "totpVerified"
+
+	sessionKeyTOTPPending   = "totpPendingUsername" // 비밀번호 확인을 마치고 TOTP 확인을 기다리는 계정
+	sessionKeyTOTPPendingAt = "totpPendingAt"       // 비밀번호 확인 시간 (Unix 초)
+)
+
+// totpLoginTimeout은 비밀번호 확인 후 TOTP 코드를 입력해야 하는 시간입니다.
+const totpLoginTimeout = 5 * time.Minute
+
+// TOTPCodeRequest 2단계 인증 코드 요청 구조체
+type TOTPCodeRequest struct {
+	Code string `json:"code" binding:"required" example:"123456"` // 인증 앱의 6자리 코드 또는 복구 코드
+}
+
+// TOTPEnrollResponse 2단계 인증 등록 시작 응답 구조체
+type TOTPEnrollResponse struct {
+	Secret          string `json:"secret" example:"JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"`                                     // 인증 앱에 직접 입력할 비밀 키 (base32)
+	ProvisioningURI string `json:"provisioningUri" example:"otpauth://totp/bumsiku:admin?issuer=bumsiku&secret=JBSWY3DP"` // QR 코드로 표시할 otpauth URI
+}
+
+// TOTPConfirmResponse 2단계 인증 등록 완료 응답 구조체
+type TOTPConfirmResponse struct {
+	RecoveryCodes []string `json:"recoveryCodes" example:"abcde-fghij"` // 인증 앱을 사용할 수 없을 때 한 번씩 사용할 수 있는 복구 코드 (다시 조회할 수 없음)
+}
+
+// @Summary     로그인 2단계 인증
+// @Description 비밀번호 확인 후 totpRequired 응답을 받은 세션에서 인증 앱의 코드 또는 복구 코드로 로그인을 완료합니다
+// @Description 비밀번호 확인 후 5분 안에 요청해야 하며, 실패는 로그인 실패와 같이 제한됩니다
+// @Tags        인증
+// @Accept      json
+// @Produce     json
+// @Param       request body TOTPCodeRequest true "인증 코드"
+// @Success     200 {object} LoginResponse "로그인 성공"
+// @Failure     400 {object} ErrorResponse "잘못된 요청"
+// @Failure     401 {object} ErrorResponse "인증 실패 또는 비밀번호 확인 필요"
+// @Failure     429 {object} ErrorResponse "요청 수 제한 초과 또는 로그인 잠금 (Retry-After 헤더 참고)"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /login/totp [post]
+// PostLoginTOTP는 로그인 대기 중인 세션을 2단계 인증 코드로 확인해 로그인을 완료하는 핸들러입니다.
+func PostLoginTOTP(userRepo repository.UserRepositoryInterface, throttle *auth.LoginThrottle, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		contextInfo := map[string]string{
+			"handler": "PostLoginTOTP",
+			"ip":      c.ClientIP(),
+		}
+
+		var req TOTPCodeRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			contextInfo["step"] = "요청 검증"
+			SendBadRequestErrorWithLogging(c, logger, "잘못된 요청 형식입니다", err, contextInfo)
+			return
+		}
+
+		// 1. 비밀번호 확인을 마친 세션인지 확인
+		session := sessions.Default(c)
+		username, _ := session.Get(sessionKeyTOTPPending).(string)
+		pendingAt, _ := session.Get(sessionKeyTOTPPendingAt).(int64)
+		if username == "" || time.Since(time.Unix(pendingAt, 0)) > totpLoginTimeout {
+			contextInfo["step"] = "로그인 대기 확인"
+			SendUnauthorizedErrorWithLogging(c, logger, "비밀번호 확인부터 다시 로그인해주세요", nil, contextInfo)
+			return
+		}
+		contextInfo["username"] = username
+
+		// 2. 연속 실패로 지연 또는 잠금 중이면 거부 (비밀번호 로그인과 같은 기록 사용)
+		keys := auth.LoginKeys(username, c.ClientIP())
+		if wait, locked := throttle.Wait(keys, time.Now()); wait > 0 {
+			sendLoginThrottled(c, logger, wait, locked, contextInfo)
+			return
+		}
+
+		user, err := userRepo.GetUser(c.Request.Context(), username)
+		if err != nil {
+			contextInfo["step"] = "계정 조회"
+			SendInternalServerErrorWithLogging(c, logger, "로그인 처리 중 오류가 발생했습니다", err, contextInfo)
+			return
+		}
+		if user == nil || !user.TOTPEnabled {
+			contextInfo["step"] = "계정 조회"
+			SendUnauthorizedErrorWithLogging(c, logger, "비밀번호 확인부터 다시 로그인해주세요", nil, contextInfo)
+			return
+		}
+
+		// 3. 인증 코드 확인
+		recoveryCodes := len(user.RecoveryCodes)
+		if !auth.VerifySecondFactor(user, req.Code, time.Now()) {
+			throttle.Failure(keys, time.Now())
+			contextInfo["step"] = "인증 코드 확인"
+			logger.Warn(c.Request.Context(), "로그인 실패: 잘못된 2단계 인증 코드", contextInfo)
+			SendUnauthorizedErrorWithLogging(c, logger, "인증 코드가 올바르지 않습니다", nil, contextInfo)
+			return
+		}
+		if len(user.RecoveryCodes) < recoveryCodes {
+			contextInfo["recoveryCodeUsed"] = "true"
+			contextInfo["recoveryCodesLeft"] = fmt.Sprintf("%d", len(user.RecoveryCodes))
+		}
+
+		// 사용한 코드는 다시 사용할 수 없도록 저장
+		if err := userRepo.UpdateUser(c.Request.Context(), user); err != nil {
+			contextInfo["step"] = "계정 저장"
+			SendInternalServerErrorWithLogging(c, logger, "로그인 처리 중 오류가 발생했습니다", err, contextInfo)
+			return
+		}
+		throttle.Success(keys)
+
+		csrfToken, err := activateSession(c, user)
+		if err != nil {
+			contextInfo["step"] = "세션 활성화"
+			SendInternalServerErrorWithLogging(c, logger, "세션 저장에 실패했습니다", err, contextInfo)
+			return
+		}
+
+		contextInfo["role"] = user.Role
+		logger.Info(c.Request.Context(), "관리자 로그인 성공 (2단계 인증)", contextInfo)
+
+		SendSuccess(c, http.StatusOK, LoginResponse{
+			Message:   "로그인에 성공했습니다",
+			Role:      user.Role,
+			CSRFToken: csrfToken,
+		})
+	}
+}
+
+// @Summary     2단계 인증 등록 시작
+// @Description 새 TOTP 비밀 키를 발급합니다. 인증 앱에 등록한 뒤 /admin/me/totp/confirm으로 코드를 확인해야 사용이 시작됩니다
+// @Tags        인증
+// @Accept      json
+// @Produce     json
+// @Security    AdminAuth
+// @Success     200 {object} TOTPEnrollResponse
+// @Failure     401 {object} ErrorResponse "인증 실패"
+// @Failure     409 {object} ErrorResponse "이미 2단계 인증 사용 중"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /admin/me/totp [post]
+func EnrollTOTP(userRepo repository.UserRepositoryInterface, issuer string, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		contextInfo := map[string]string{
+			"handler": "EnrollTOTP",
+		}
+
+		user, ok := loadCurrentUser(c, userRepo, logger, contextInfo)
+		if !ok {
+			return
+		}
+		if user.TOTPEnabled {
+			contextInfo["step"] = "등록 상태 확인"
+			SendConflictErrorWithLogging(c, logger, "이미 2단계 인증을 사용 중입니다", nil, contextInfo)
+			return
+		}
+
+		secret, err := auth.GenerateTOTPSecret()
+		if err != nil {
+			contextInfo["step"] = "비밀 키 생성"
+			SendInternalServerErrorWithLogging(c, logger, "2단계 인증 등록에 실패했습니다", err, contextInfo)
+			return
+		}
+
+		// 확인 전까지는 사용하지 않는 상태로 비밀 키만 저장
+		user.TOTPSecret = secret
+		user.TOTPLastStep = 0
+		if err := userRepo.UpdateUser(c.Request.Context(), user); err != nil {
+			contextInfo["step"] = "계정 저장"
+			SendInternalServerErrorWithLogging(c, logger, "2단계 인증 등록에 실패했습니다", err, contextInfo)
+			return
+		}
+
+		logger.Info(c.Request.Context(), "2단계 인증 등록 시작", contextInfo)
+		SendSuccess(c, http.StatusOK, TOTPEnrollResponse{
+			Secret:          secret,
+			ProvisioningURI: auth.TOTPProvisioningURI(issuer, user.Username, secret),
+		})
+	}
+}
+
+// @Summary     2단계 인증 등록 완료
+// @Description 인증 앱의 코드로 등록을 확인하고 2단계 인증을 사용합니다. 응답의 복구 코드는 다시 조회할 수 없습니다
+// @Description 실패는 로그인 실패와 같이 제한됩니다
+// @Tags        인증
+// @Accept      json
+// @Produce     json
+// @Security    AdminAuth
+// @Param       request body TOTPCodeRequest true "인증 앱의 코드"
+// @Success     200 {object} TOTPConfirmResponse
+// @Failure     400 {object} ErrorResponse "잘못된 요청 또는 코드 불일치"
+// @Failure     401 {object} ErrorResponse "인증 실패"
+// @Failure     409 {object} ErrorResponse "이미 2단계 인증 사용 중"
+// @Failure     429 {object} ErrorResponse "로그인 잠금 (Retry-After 헤더 참고)"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /admin/me/totp/confirm [post]
+func ConfirmTOTP(userRepo repository.UserRepositoryInterface, throttle *auth.LoginThrottle, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		contextInfo := map[string]string{
+			"handler": "ConfirmTOTP",
+		}
+
+		var req TOTPCodeRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			contextInfo["step"] = "요청 검증"
+			SendBadRequestErrorWithLogging(c, logger, "잘못된 요청 형식입니다", err, contextInfo)
+			return
+		}
+
+		user, ok := loadCurrentUser(c, userRepo, logger, contextInfo)
+		if !ok {
+			return
+		}
+		if user.TOTPEnabled {
+			contextInfo["step"] = "등록 상태 확인"
+			SendConflictErrorWithLogging(c, logger, "이미 2단계 인증을 사용 중입니다", nil, contextInfo)
+			return
+		}
+		if user.TOTPSecret == "" {
+			contextInfo["step"] = "등록 상태 확인"
+			SendBadRequestErrorWithLogging(c, logger, "2단계 인증 등록을 먼저 시작해주세요", nil, contextInfo)
+			return
+		}
+
+		// 로그인과 같은 실패 기록을 사용해 세션을 가진 사람이 코드를 대입하지 못하게 함
+		keys := auth.LoginKeys(user.Username, c.ClientIP())
+		if wait, locked := throttle.Wait(keys, time.Now()); wait > 0 {
+			sendLoginThrottled(c, logger, wait, locked, contextInfo)
+			return
+		}
+
+		step, ok := auth.VerifyTOTP(user.TOTPSecret, req.Code, time.Now(), user.TOTPLastStep)
+		if !ok {
+			throttle.Failure(keys, time.Now())
+			contextInfo["step"] = "인증 코드 확인"
+			SendBadRequestErrorWithLogging(c, logger, "인증 코드가 올바르지 않습니다", nil, contextInfo)
+			return
+		}
+
+		codes, hashes, err := auth.GenerateRecoveryCodes()
+		if err != nil {
+			contextInfo["step"] = "복구 코드 생성"
+			SendInternalServerErrorWithLogging(c, logger, "2단계 인증 등록에 실패했습니다", err, contextInfo)
+			return
+		}
+
+		throttle.Success(keys)
+
+		user.TOTPEnabled = true
+		user.TOTPLastStep = step
+		user.RecoveryCodes = hashes
+		user.UpdatedAt = time.Now()
+		if err := userRepo.UpdateUser(c.Request.Context(), user); err != nil {
+			contextInfo["step"] = "계정 저장"
+			SendInternalServerErrorWithLogging(c, logger, "2단계 인증 등록에 실패했습니다", err, contextInfo)
+			return
+		}
+
+		// 등록한 세션은 2단계 인증을 마친 것으로 간주하고, 다른 세션은 다시 로그인해야 함
+		session := sessions.Default(c)
+		session.Set(SessionKeyTOTPVerified, true)
+		if err := session.Save(); err != nil {
+			contextInfo["step"] = "세션 저장"
+			SendInternalServerErrorWithLogging(c, logger, "세션 저장에 실패했습니다", err, contextInfo)
+			return
+		}
+
+		logger.Info(c.Request.Context(), "2단계 인증 등록 완료", contextInfo)
+		SendSuccess(c, http.StatusOK, TOTPConfirmResponse{RecoveryCodes: codes})
+	}
+}
+
+// @Summary     2단계 인증 해제
+// @Description 인증 앱의 코드 또는 복구 코드를 확인하고 2단계 인증을 해제합니다
+// @Description 실패는 로그인 실패와 같이 제한됩니다
+// @Tags        인증
+// @Accept      json
+// @Produce     json
+// @Security    AdminAuth
+// @Param       request body TOTPCodeRequest true "인증 코드"
+// @Success     200 {object} map[string]string "해제 성공"
+// @Failure     400 {object} ErrorResponse "잘못된 요청 또는 코드 불일치"
+// @Failure     401 {object} ErrorResponse "인증 실패"
+// @Failure     429 {object} ErrorResponse "로그인 잠금 (Retry-After 헤더 참고)"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /admin/me/totp [delete]
+func DisableTOTP(userRepo repository.UserRepositoryInterface, throttle *auth.LoginThrottle, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		contextInfo := map[string]string{
+			"handler": "DisableTOTP",
+		}
+
+		var req TOTPCodeRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			contextInfo["step"] = "요청 검증"
+			SendBadRequestErrorWithLogging(c, logger, "잘못된 요청 형식입니다", err, contextInfo)
+			return
+		}
+
+		user, ok := loadCurrentUser(c, userRepo, logger, contextInfo)
+		if !ok {
+			return
+		}
+		if !user.TOTPEnabled {
+			contextInfo["step"] = "등록 상태 확인"
+			SendBadRequestErrorWithLogging(c, logger, "2단계 인증을 사용하고 있지 않습니다", nil, contextInfo)
+			return
+		}
+
+		keys := auth.LoginKeys(user.Username, c.ClientIP())
+		if wait, locked := throttle.Wait(keys, time.Now()); wait > 0 {
+			sendLoginThrottled(c, logger, wait, locked, contextInfo)
+			return
+		}
+		if !auth.VerifySecondFactor(user, req.Code, time.Now()) {
+			throttle.Failure(keys, time.Now())
+			contextInfo["step"] = "인증 코드 확인"
+			SendBadRequestErrorWithLogging(c, logger, "인증 코드가 올바르지 않습니다", nil, contextInfo)
+			return
+		}
+		throttle.Success(keys)
+
+		resetTOTP(user)
+		user.UpdatedAt = time.Now()
+		if err := userRepo.UpdateUser(c.Request.Context(), user); err != nil {
+			contextInfo["step"] = "계정 저장"
+			SendInternalServerErrorWithLogging(c, logger, "2단계 인증 해제에 실패했습니다", err, contextInfo)
+			return
+		}
+
+		logger.Info(c.Request.Context(), "2단계 인증 해제", contextInfo)
+		SendSuccess(c, http.StatusOK, map[string]string{
+			"message": "2단계 인증이 해제되었습니다",
+		})
+	}
+}
+
+// startTOTPLogin은 비밀번호 확인을 마친 계정을 세션에 로그인 대기 상태로 기록합니다.
+// 이전 로그인 정보는 지워 2단계 인증을 마칠 때까지 관리자 API를 사용할 수 없게 합니다.
+func startTOTPLogin(c *gin.Context, username string) error {
+	session := sessions.Default(c)
+	// 비밀번호 확인을 마친 세션은 로그인 전 세션 토큰을 이어 쓰지 않음
+	loginsession.Regenerate(session)
+	session.Delete("username")
+	session.Delete(SessionKeyTOTPVerified)
+	session.Set(sessionKeyTOTPPending, username)
+	session.Set(sessionKeyTOTPPendingAt, time.Now().Unix())
+	return session.Save()
+}
+
+// loadCurrentUser는 로그인한 관리자 계정을 조회합니다. 실패하면 오류 응답을 보내고 false를 반환합니다.
+func loadCurrentUser(c *gin.Context, userRepo repository.UserRepositoryInterface, logger *utils.Logger, contextInfo map[string]string) (*model.User, bool) {
+	username := c.GetString("username")
+	contextInfo["username"] = username
+
+	user, err := userRepo.GetUser(c.Request.Context(), username)
+	if err != nil {
+		contextInfo["step"] = "계정 조회"
+		SendInternalServerErrorWithLogging(c, logger, "계정 조회에 실패했습니다", err, contextInfo)
+		return nil, false
+	}
+	if user == nil {
+		contextInfo["step"] = "계정 조회"
+		SendUnauthorizedErrorWithLogging(c, logger, "로그인이 필요합니다", nil, contextInfo)
+		return nil, false
+	}
+	return user, true
+}
+
+// resetTOTP는 계정의 2단계 인증 설정을 모두 지웁니다.
+func resetTOTP(user *model.User) {
+	user.TOTPEnabled = false
+	user.TOTPSecret = ""
+	user.TOTPLastStep = 0
+	user.RecoveryCodes = nil
+}
@@ -23,6 +23,7 @@ type UpdateCategoryRequest struct {
 // @Accept      json
 // @Produce     json
 // @Security    AdminAuth
+// @Security    BearerAuth
 // @Param       request body UpdateCategoryRequest true "카테고리 정보"
 // @Success     200 {object} model.Category
 // @Failure     400 {object} ErrorResponse "잘못된 요청"
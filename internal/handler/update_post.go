@@ -12,28 +12,34 @@ import (
 
 // UpdatePostRequest는 게시글 수정 요청 구조체입니다.
 type UpdatePostRequest struct {
-	Title    string `json:"title" binding:"required" example:"수정된 블로그 게시물"`        // 게시물 제목
-	Content  string `json:"content" binding:"required" example:"수정된 게시물 본문 내용..."` // 게시물 내용
-	Summary  string `json:"summary" binding:"required" example:"수정된 게시물 요약..."`    // 게시물 요약
-	Category string `json:"category" binding:"required" example:"technology"`      // 카테고리
+	Title    string   `json:"title" binding:"required" example:"수정된 블로그 게시물"`        // 게시물 제목
+	Content  string   `json:"content" binding:"required" example:"수정된 게시물 본문 내용..."` // 게시물 내용
+	Summary  string   `json:"summary" binding:"required" example:"수정된 게시물 요약..."`    // 게시물 요약
+	Category string   `json:"category" binding:"required" example:"technology"`      // 카테고리
+	Tags     []string `json:"tags,omitempty" example:"golang,concurrency"`           // 태그 (생략하면 기존 태그 유지, 빈 배열이면 모두 제거)
 }
 
 // @Summary     게시물 수정
-// @Description 기존 블로그 게시물을 수정합니다 (관리자 전용)
+// @Description 기존 블로그 게시물을 수정하고 수정 결과를 리비전으로 기록합니다 (관리자 전용)
+// @Description If-Match에 조회 시 받은 ETag를 전달하면 그 사이 다른 곳에서 수정된 경우 412로 거부합니다
 // @Tags        게시물
 // @Accept      json
 // @Produce     json
 // @Security    AdminAuth
+// @Security    BearerAuth
 // @Param       id path string true "게시물 ID"
+// @Param       If-Match header string false "게시물 ETag (예: \"3\")"
 // @Param       request body UpdatePostRequest true "수정할 게시물 정보"
 // @Success     200 {object} model.Post
+// @Header      200 {string} ETag "수정된 게시물 버전"
 // @Failure     400 {object} ErrorResponse "잘못된 요청"
 // @Failure     401 {object} ErrorResponse "인증 실패"
 // @Failure     404 {object} ErrorResponse "게시물을 찾을 수 없음"
+// @Failure     412 {object} ErrorResponse "다른 곳에서 먼저 수정됨"
 // @Failure     500 {object} ErrorResponse "서버 오류"
 // @Router      /admin/posts/{id} [put]
 // UpdatePost는 관리자 전용 게시글 수정 핸들러입니다.
-func UpdatePost(postRepo repository.PostRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
+func UpdatePost(postRepo repository.PostRepositoryInterface, revisionRepo repository.RevisionRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// 1. 경로 파라미터 확인
 		postID := c.Param("id")
@@ -58,7 +64,46 @@ func UpdatePost(postRepo repository.PostRepositoryInterface, logger *utils.Logge
 			return
 		}
 
-		// 3. 업데이트 시간 설정
+		// 태그를 생략하면 nil로 두어 기존 태그 유지
+		var tags []string
+		if req.Tags != nil {
+			normalized, err := model.NormalizeTags(req.Tags)
+			if err != nil {
+				contextInfo := map[string]string{
+					"handler": "UpdatePost",
+					"step":    "요청 검증",
+					"postID":  postID,
+				}
+				SendBadRequestErrorWithLogging(c, logger, err.Error(), nil, contextInfo)
+				return
+			}
+			tags = normalized
+		}
+
+		expectedVersion, err := parseIfMatch(c.GetHeader("If-Match"))
+		if err != nil {
+			contextInfo := map[string]string{
+				"handler": "UpdatePost",
+				"step":    "요청 검증",
+				"postID":  postID,
+				"ifMatch": c.GetHeader("If-Match"),
+			}
+			SendBadRequestErrorWithLogging(c, logger, err.Error(), nil, contextInfo)
+			return
+		}
+
+		// 3. 리비전 기록 이전 게시글이면 수정 전 내용 보존
+		if err := preserveOriginalRevision(c.Request.Context(), postRepo, revisionRepo, postID); err != nil {
+			contextInfo := map[string]string{
+				"handler": "UpdatePost",
+				"step":    "원본 리비전 보존",
+				"postID":  postID,
+			}
+			SendInternalServerErrorWithLogging(c, logger, "게시글 리비전 기록에 실패했습니다", err, contextInfo)
+			return
+		}
+
+		// 업데이트 시간 설정
 		now := time.Now()
 
 		// 4. Post 모델 생성
@@ -68,11 +113,12 @@ func UpdatePost(postRepo repository.PostRepositoryInterface, logger *utils.Logge
 			Content:   req.Content,
 			Summary:   req.Summary,
 			Category:  req.Category,
+			Tags:      tags,
 			UpdatedAt: now,
 		}
 
 		// 5. 게시글 업데이트
-		err := postRepo.UpdatePost(c.Request.Context(), post)
+		err = postRepo.UpdatePost(c.Request.Context(), post, expectedVersion)
 		if err != nil {
 			contextInfo := map[string]string{
 				"handler":  "UpdatePost",
@@ -88,6 +134,12 @@ func UpdatePost(postRepo repository.PostRepositoryInterface, logger *utils.Logge
 				return
 			}
 
+			// PostVersionConflictError 확인
+			if _, ok := err.(*repository.PostVersionConflictError); ok {
+				SendPreconditionFailedErrorWithLogging(c, logger, "다른 곳에서 먼저 수정된 게시글입니다. 최신 내용을 다시 불러와 주세요", err, contextInfo)
+				return
+			}
+
 			SendInternalServerErrorWithLogging(c, logger, "게시글 수정에 실패했습니다", err, contextInfo)
 			return
 		}
@@ -104,6 +156,20 @@ func UpdatePost(postRepo repository.PostRepositoryInterface, logger *utils.Logge
 			return
 		}
 
+		// 7. 수정 결과를 리비전으로 기록
+		if updatedPost != nil {
+			revision := newPostRevision(updatedPost, sessionUsername(c), now)
+			if err := revisionRepo.CreateRevision(c.Request.Context(), revision); err != nil {
+				// 리비전 기록 실패 로그를 남기지만, 사용자에게는 수정 성공으로 응답
+				logger.Warn(c.Request.Context(), "게시글 수정 성공 후 리비전 기록 실패", map[string]string{
+					"handler": "UpdatePost",
+					"step":    "리비전 기록",
+					"postID":  postID,
+					"error":   err.Error(),
+				})
+			}
+		}
+
 		// 로그 남기기 - 성공 케이스
 		logger.Info(c.Request.Context(), "게시글이 성공적으로 수정되었습니다", map[string]string{
 			"handler":   "UpdatePost",
@@ -113,7 +179,8 @@ func UpdatePost(postRepo repository.PostRepositoryInterface, logger *utils.Logge
 			"updatedAt": now.Format(time.RFC3339),
 		})
 
-		// 7. 성공 응답
+		// 8. 성공 응답
+		c.Header("ETag", postETag(post.Version))
 		SendSuccess(c, http.StatusOK, updatedPost)
 	}
 }
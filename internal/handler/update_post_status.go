@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+	"bumsiku/internal/utils"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// UpdatePostStatusRequest는 게시글 상태 변경 요청 구조체입니다.
+type UpdatePostStatusRequest struct {
+	Status    string     `json:"status" binding:"required" example:"scheduled"`           // 변경할 상태 (draft, scheduled, published, archived)
+	PublishAt *time.Time `json:"publishAt,omitempty" example:"2030-01-01T09:00:00+09:00"` // 발행 예정 시간 (scheduled인 경우 필수)
+}
+
+// allowedPostStatusTransitions는 현재 상태에서 변경 가능한 상태 목록입니다.
+var allowedPostStatusTransitions = map[string][]string{
+	model.PostStatusDraft:     {model.PostStatusScheduled, model.PostStatusPublished, model.PostStatusArchived},
+	model.PostStatusScheduled: {model.PostStatusDraft, model.PostStatusPublished, model.PostStatusArchived},
+	model.PostStatusPublished: {model.PostStatusDraft, model.PostStatusArchived},
+	model.PostStatusArchived:  {model.PostStatusDraft, model.PostStatusPublished},
+}
+
+// canTransitionPostStatus는 from 상태에서 to 상태로 변경할 수 있는지 확인합니다.
+// 상태 필드 도입 이전 게시글(빈 상태)은 published로 간주합니다.
+func canTransitionPostStatus(from, to string) bool {
+	if from == "" {
+		from = model.PostStatusPublished
+	}
+	for _, allowed := range allowedPostStatusTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// resolvePublishAt은 변경할 상태에 맞는 발행 시간을 결정합니다.
+// 반환하는 오류의 메시지는 그대로 클라이언트에 전달됩니다.
+func resolvePublishAt(status string, publishAt *time.Time, now time.Time) (*time.Time, error) {
+	switch status {
+	case model.PostStatusScheduled:
+		if publishAt == nil || !publishAt.After(now) {
+			return nil, errors.New("예약 발행은 현재 이후의 publishAt이 필요합니다")
+		}
+		return publishAt, nil
+	case model.PostStatusPublished:
+		if publishAt == nil {
+			return &now, nil
+		}
+		if publishAt.After(now) {
+			return nil, errors.New("미래 시점 발행은 scheduled 상태를 사용해야 합니다")
+		}
+		return publishAt, nil
+	case model.PostStatusDraft:
+		return nil, nil
+	default:
+		return publishAt, nil
+	}
+}
+
+// @Summary     게시물 상태 변경
+// @Description 게시물을 초안, 예약, 발행, 보관 상태로 변경합니다 (관리자 전용)
+// @Tags        게시물
+// @Accept      json
+// @Produce     json
+// @Security    AdminAuth
+// @Security    BearerAuth
+// @Param       id path string true "게시물 ID"
+// @Param       request body UpdatePostStatusRequest true "변경할 상태 정보"
+// @Success     200 {object} model.Post
+// @Header      200 {string} ETag "변경된 게시물 버전"
+// @Failure     400 {object} ErrorResponse "잘못된 요청 또는 허용되지 않는 상태 전환"
+// @Failure     401 {object} ErrorResponse "인증 실패"
+// @Failure     404 {object} ErrorResponse "게시물을 찾을 수 없음"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /admin/posts/{id}/status [put]
+// UpdatePostStatus는 관리자 전용 게시글 상태 변경 핸들러입니다.
+func UpdatePostStatus(postRepo repository.PostRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 1. 경로 파라미터 확인
+		postID := c.Param("id")
+		if postID == "" {
+			contextInfo := map[string]string{
+				"handler": "UpdatePostStatus",
+				"step":    "경로 파라미터 확인",
+			}
+			SendBadRequestErrorWithLogging(c, logger, "게시글 ID가 필요합니다", nil, contextInfo)
+			return
+		}
+
+		// 2. 요청 바디 검증
+		var req UpdatePostStatusRequest
+		if err := c.ShouldBindJSON(&req); err != nil || !model.IsValidPostStatus(req.Status) {
+			contextInfo := map[string]string{
+				"handler": "UpdatePostStatus",
+				"step":    "요청 검증",
+				"postID":  postID,
+				"status":  req.Status,
+			}
+			SendBadRequestErrorWithLogging(c, logger, "요청 형식이 올바르지 않습니다", err, contextInfo)
+			return
+		}
+
+		// 3. 현재 게시글 조회
+		post, err := postRepo.GetPostByID(c.Request.Context(), postID)
+		if err != nil {
+			contextInfo := map[string]string{
+				"handler": "UpdatePostStatus",
+				"step":    "게시글 조회",
+				"postID":  postID,
+			}
+			SendInternalServerErrorWithLogging(c, logger, "게시글 조회에 실패했습니다", err, contextInfo)
+			return
+		}
+		if post == nil {
+			contextInfo := map[string]string{
+				"handler": "UpdatePostStatus",
+				"step":    "게시글 조회",
+				"postID":  postID,
+			}
+			SendNotFoundErrorWithLogging(c, logger, "게시글을 찾을 수 없습니다", nil, contextInfo)
+			return
+		}
+
+		contextInfo := map[string]string{
+			"handler": "UpdatePostStatus",
+			"postID":  postID,
+			"from":    post.Status,
+			"to":      req.Status,
+		}
+
+		// 4. 상태 전환 및 발행 시간 검증
+		if !canTransitionPostStatus(post.Status, req.Status) {
+			contextInfo["step"] = "상태 전환 검증"
+			SendBadRequestErrorWithLogging(c, logger, "허용되지 않는 상태 전환입니다", nil, contextInfo)
+			return
+		}
+
+		requestedPublishAt := req.PublishAt
+		if requestedPublishAt == nil && req.Status == model.PostStatusArchived {
+			// 보관 시에는 기존 발행 시간을 유지
+			requestedPublishAt = post.PublishAt
+		}
+		publishAt, err := resolvePublishAt(req.Status, requestedPublishAt, time.Now())
+		if err != nil {
+			contextInfo["step"] = "발행 시간 검증"
+			SendBadRequestErrorWithLogging(c, logger, err.Error(), nil, contextInfo)
+			return
+		}
+
+		// 5. 상태 변경
+		err = postRepo.UpdatePostStatus(c.Request.Context(), postID, req.Status, publishAt)
+		if err != nil {
+			contextInfo["step"] = "상태 변경"
+
+			// PostNotFoundError 확인
+			if _, ok := err.(*repository.PostNotFoundError); ok {
+				SendNotFoundErrorWithLogging(c, logger, "게시글을 찾을 수 없습니다", err, contextInfo)
+				return
+			}
+
+			SendInternalServerErrorWithLogging(c, logger, "게시글 상태 변경에 실패했습니다", err, contextInfo)
+			return
+		}
+
+		// 6. 변경된 게시글 조회 (상태 변경도 버전을 올리므로 응답에 새 버전을 반영)
+		updatedPost, err := postRepo.GetPostByID(c.Request.Context(), postID)
+		if err != nil {
+			contextInfo["step"] = "변경된 게시글 조회"
+			SendInternalServerErrorWithLogging(c, logger, "변경된 게시글 조회에 실패했습니다", err, contextInfo)
+			return
+		}
+		if updatedPost == nil {
+			contextInfo["step"] = "변경된 게시글 조회"
+			SendNotFoundErrorWithLogging(c, logger, "게시글을 찾을 수 없습니다", nil, contextInfo)
+			return
+		}
+
+		// 로그 남기기 - 성공 케이스
+		logger.Info(c.Request.Context(), "게시글 상태가 변경되었습니다", contextInfo)
+
+		// 7. 성공 응답
+		c.Header("ETag", postETag(updatedPost.Version))
+		SendSuccess(c, http.StatusOK, updatedPost)
+	}
+}
@@ -0,0 +1,228 @@
+package handler
+
+import (
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+	"bumsiku/internal/utils"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// retagAttempts는 태그 변경 중 게시글이 동시에 수정되어 버전이 충돌할 때 재시도하는 횟수입니다.
+const retagAttempts = 3
+
+// RenameTagRequest는 태그 이름 변경 요청 구조체입니다.
+type RenameTagRequest struct {
+	Name string `json:"name" binding:"required" example:"go"` // 새 태그 이름
+}
+
+// MergeTagRequest는 태그 병합 요청 구조체입니다.
+type MergeTagRequest struct {
+	Into string `json:"into" binding:"required" example:"go"` // 병합 대상 태그
+}
+
+// UpdateTagResponse는 태그 이름 변경/병합 결과입니다.
+type UpdateTagResponse struct {
+	From         string `json:"from" example:"golang"`     // 기존 태그
+	To           string `json:"to" example:"go"`           // 변경된 태그
+	UpdatedPosts int    `json:"updatedPosts" example:"12"` // 태그가 변경된 게시물 수
+}
+
+// @Summary     태그 이름 변경
+// @Description 태그가 달린 모든 게시물에서 태그 이름을 변경합니다 (관리자 전용)
+// @Description 새 이름이 이미 사용 중이면 409를 반환하며, 이 경우 병합을 사용합니다
+// @Tags        태그
+// @Accept      json
+// @Produce     json
+// @Security    AdminAuth
+// @Security    BearerAuth
+// @Param       tag path string true "기존 태그"
+// @Param       request body RenameTagRequest true "새 태그 이름"
+// @Success     200 {object} UpdateTagResponse
+// @Failure     400 {object} ErrorResponse "잘못된 요청"
+// @Failure     401 {object} ErrorResponse "인증 실패"
+// @Failure     404 {object} ErrorResponse "태그를 찾을 수 없음"
+// @Failure     409 {object} ErrorResponse "이미 사용 중인 태그"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /admin/tags/{tag} [put]
+// RenameTag는 관리자 전용 태그 이름 변경 핸들러입니다.
+func RenameTag(postRepo repository.PostRepositoryInterface, tagRepo repository.TagRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req RenameTagRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			contextInfo := map[string]string{
+				"handler": "RenameTag",
+				"step":    "요청 검증",
+				"tag":     c.Param("tag"),
+			}
+			SendBadRequestErrorWithLogging(c, logger, "요청 형식이 올바르지 않습니다", err, contextInfo)
+			return
+		}
+
+		updateTag(c, postRepo, tagRepo, logger, "RenameTag", req.Name, false)
+	}
+}
+
+// @Summary     태그 병합
+// @Description 태그가 달린 모든 게시물에서 태그를 대상 태그로 바꿉니다 (관리자 전용)
+// @Description 두 태그가 모두 달린 게시물은 대상 태그 하나만 남습니다
+// @Tags        태그
+// @Accept      json
+// @Produce     json
+// @Security    AdminAuth
+// @Security    BearerAuth
+// @Param       tag path string true "병합할 태그"
+// @Param       request body MergeTagRequest true "병합 대상 태그"
+// @Success     200 {object} UpdateTagResponse
+// @Failure     400 {object} ErrorResponse "잘못된 요청"
+// @Failure     401 {object} ErrorResponse "인증 실패"
+// @Failure     404 {object} ErrorResponse "태그를 찾을 수 없음"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /admin/tags/{tag}/merge [post]
+// MergeTag는 관리자 전용 태그 병합 핸들러입니다.
+func MergeTag(postRepo repository.PostRepositoryInterface, tagRepo repository.TagRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req MergeTagRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			contextInfo := map[string]string{
+				"handler": "MergeTag",
+				"step":    "요청 검증",
+				"tag":     c.Param("tag"),
+			}
+			SendBadRequestErrorWithLogging(c, logger, "요청 형식이 올바르지 않습니다", err, contextInfo)
+			return
+		}
+
+		updateTag(c, postRepo, tagRepo, logger, "MergeTag", req.Into, true)
+	}
+}
+
+// updateTag는 경로의 태그를 target으로 바꿉니다. merge가 false이면 target이 이미 사용 중일 때 거부합니다.
+func updateTag(c *gin.Context, postRepo repository.PostRepositoryInterface, tagRepo repository.TagRepositoryInterface, logger *utils.Logger, handlerName string, target string, merge bool) {
+	from := model.NormalizeTag(c.Param("tag"))
+	to := model.NormalizeTag(target)
+
+	contextInfo := map[string]string{
+		"handler": handlerName,
+		"step":    "요청 검증",
+		"from":    from,
+		"to":      to,
+	}
+
+	if err := model.ValidateTag(to); err != nil {
+		SendBadRequestErrorWithLogging(c, logger, err.Error(), nil, contextInfo)
+		return
+	}
+	if from == to {
+		SendBadRequestErrorWithLogging(c, logger, "기존 태그와 같은 태그입니다", nil, contextInfo)
+		return
+	}
+
+	// 변경할 게시글 조회
+	postIDs, err := tagRepo.GetPostIDsByTag(c.Request.Context(), from)
+	if err != nil {
+		contextInfo["step"] = "태그 게시글 조회"
+		SendInternalServerErrorWithLogging(c, logger, "태그 조회에 실패했습니다", err, contextInfo)
+		return
+	}
+	if len(postIDs) == 0 {
+		SendNotFoundErrorWithLogging(c, logger, "태그를 찾을 수 없습니다", nil, contextInfo)
+		return
+	}
+
+	// 이름 변경은 기존 태그와 합쳐지지 않도록 대상 태그가 비어 있어야 함
+	if !merge {
+		existing, err := tagRepo.GetPostIDsByTag(c.Request.Context(), to)
+		if err != nil {
+			contextInfo["step"] = "대상 태그 조회"
+			SendInternalServerErrorWithLogging(c, logger, "태그 조회에 실패했습니다", err, contextInfo)
+			return
+		}
+		if len(existing) > 0 {
+			SendConflictErrorWithLogging(c, logger, "이미 사용 중인 태그입니다. 병합을 사용해 주세요", nil, contextInfo)
+			return
+		}
+	}
+
+	updated := 0
+	for _, postID := range postIDs {
+		changed, err := retagPost(c.Request.Context(), postRepo, postID, from, to)
+		if err != nil {
+			contextInfo["step"] = "게시글 태그 변경"
+			contextInfo["postID"] = postID
+			contextInfo["updatedPosts"] = fmt.Sprintf("%d", updated)
+			SendInternalServerErrorWithLogging(c, logger, "게시글 태그 변경에 실패했습니다", err, contextInfo)
+			return
+		}
+		if changed {
+			updated++
+		}
+	}
+
+	// 성공 로깅
+	logger.Info(c.Request.Context(), "태그 변경 성공", map[string]string{
+		"handler":      handlerName,
+		"from":         from,
+		"to":           to,
+		"updatedPosts": fmt.Sprintf("%d", updated),
+		"updatedBy":    sessionUsername(c),
+	})
+
+	SendSuccess(c, http.StatusOK, UpdateTagResponse{From: from, To: to, UpdatedPosts: updated})
+}
+
+// retagPost는 게시글의 태그 from을 to로 바꿉니다. 게시글이 없거나 태그가 없으면 false를 반환합니다.
+// 조회와 수정 사이에 게시글이 수정되어도 그 내용을 덮어쓰지 않도록 버전 조건으로 저장합니다.
+func retagPost(ctx context.Context, postRepo repository.PostRepositoryInterface, postID, from, to string) (bool, error) {
+	for attempt := 0; attempt < retagAttempts; attempt++ {
+		post, err := postRepo.GetPostByID(ctx, postID)
+		if err != nil {
+			return false, err
+		}
+		if post == nil {
+			return false, nil
+		}
+
+		tags, changed := replaceTag(post.Tags, from, to)
+		if !changed {
+			return false, nil
+		}
+		post.Tags = tags
+
+		// 태그 변경은 본문 수정이 아니므로 수정 시간은 유지
+		expectedVersion := post.Version
+		err = postRepo.UpdatePost(ctx, post, &expectedVersion)
+		if _, ok := err.(*repository.PostVersionConflictError); ok {
+			continue
+		}
+		if err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	return false, errors.New("게시글이 계속 수정되어 태그를 변경하지 못했습니다: " + postID)
+}
+
+// replaceTag는 태그 목록에서 from을 to로 바꾸고 중복을 제거합니다. from이 없으면 false를 반환합니다.
+func replaceTag(tags []string, from, to string) ([]string, bool) {
+	changed := false
+	seen := make(map[string]bool, len(tags))
+	replaced := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if tag == from {
+			tag = to
+			changed = true
+		}
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		replaced = append(replaced, tag)
+	}
+	return replaced, changed
+}
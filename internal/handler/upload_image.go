@@ -3,7 +3,6 @@ package handler
 import (
 	"bumsiku/internal/model"
 	"bumsiku/internal/utils"
-	"context"
 	"fmt"
 	"net/http"
 	"time"
@@ -19,6 +18,7 @@ import (
 // @Accept      multipart/form-data
 // @Produce     json
 // @Security    AdminAuth
+// @Security    BearerAuth
 // @Param       image formData file true "이미지 파일"
 // @Success     200 {object} model.UploadImageResponse "업로드 성공"
 // @Failure     400 {object} ErrorResponse "잘못된 요청"
@@ -51,8 +51,7 @@ func UploadImage(s3Client *s3.Client, logger *utils.Logger) gin.HandlerFunc {
 		}
 
 		// 이미지 처리 및 S3 업로드
-		ctx := context.Background()
-		webpBytes, fileName, s3URL, err := utils.ProcessImage(ctx, s3Client, file)
+		webpBytes, fileName, s3URL, err := utils.ProcessImage(c.Request.Context(), s3Client, file)
 		if err != nil {
 			contextInfo := map[string]string{
 				"handler":  "UploadImage",
@@ -0,0 +1,369 @@
+package handler
+
+import (
+	"bumsiku/internal/auth"
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+	"bumsiku/internal/utils"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// minPasswordLength는 관리자 비밀번호 최소 길이입니다.
+const minPasswordLength = 8
+
+// GetUsersResponse 관리자 계정 목록 응답 구조체
+type GetUsersResponse struct {
+	Users []model.User `json:"users"` // 사용자 이름순 계정 목록
+}
+
+// CreateUserRequest 관리자 계정 생성 요청 구조체
+type CreateUserRequest struct {
+	Username string `json:"username" binding:"required" example:"editor1"`  // 사용자 이름 (영문 소문자로 시작하는 3~32자의 영문 소문자, 숫자, '-', '_', '.')
+	Password string `json:"password" binding:"required" example:"********"` // 비밀번호 (8자 이상)
+	Role     string `json:"role" binding:"required" example:"editor"`       // 역할 (owner, editor, moderator)
+}
+
+// UpdateUserRequest 관리자 계정 수정 요청 구조체
+type UpdateUserRequest struct {
+	Role      string `json:"role,omitempty" example:"moderator"`    // 변경할 역할 (선택)
+	Password  string `json:"password,omitempty" example:"********"` // 변경할 비밀번호 (선택, 8자 이상)
+	ResetTOTP bool   `json:"resetTotp,omitempty" example:"false"`   // 2단계 인증 해제 (인증 앱과 복구 코드를 모두 잃어버린 경우)
+}
+
+// @Summary     관리자 계정 목록 조회
+// @Description 모든 관리자 계정과 역할을 조회합니다 (owner 전용)
+// @Tags        사용자
+// @Accept      json
+// @Produce     json
+// @Security    AdminAuth
+// @Success     200 {object} GetUsersResponse
+// @Failure     401 {object} ErrorResponse "인증 실패"
+// @Failure     403 {object} ErrorResponse "권한 없음"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /admin/users [get]
+func GetUsers(userRepo repository.UserRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		users, err := userRepo.GetUsers(c.Request.Context())
+		if err != nil {
+			contextInfo := map[string]string{
+				"handler": "GetUsers",
+				"step":    "계정 목록 조회",
+			}
+			SendInternalServerErrorWithLogging(c, logger, "계정 목록 조회에 실패했습니다", err, contextInfo)
+			return
+		}
+
+		SendSuccess(c, http.StatusOK, GetUsersResponse{Users: users})
+	}
+}
+
+// @Summary     내 계정 조회
+// @Description 로그인한 관리자 계정과 역할을 조회합니다
+// @Tags        사용자
+// @Accept      json
+// @Produce     json
+// @Security    AdminAuth
+// @Success     200 {object} model.User
+// @Failure     401 {object} ErrorResponse "인증 실패"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /admin/me [get]
+func GetCurrentUser(userRepo repository.UserRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		username := c.GetString("username")
+		user, err := userRepo.GetUser(c.Request.Context(), username)
+		if err != nil {
+			contextInfo := map[string]string{
+				"handler":  "GetCurrentUser",
+				"step":     "계정 조회",
+				"username": username,
+			}
+			SendInternalServerErrorWithLogging(c, logger, "계정 조회에 실패했습니다", err, contextInfo)
+			return
+		}
+		if user == nil {
+			SendUnauthorizedError(c, "로그인이 필요합니다")
+			return
+		}
+
+		SendSuccess(c, http.StatusOK, user)
+	}
+}
+
+// @Summary     관리자 계정 생성
+// @Description 새 관리자 계정을 생성합니다 (owner 전용)
+// @Tags        사용자
+// @Accept      json
+// @Produce     json
+// @Security    AdminAuth
+// @Param       request body CreateUserRequest true "계정 정보"
+// @Success     201 {object} model.User
+// @Failure     400 {object} ErrorResponse "잘못된 요청"
+// @Failure     401 {object} ErrorResponse "인증 실패"
+// @Failure     403 {object} ErrorResponse "권한 없음"
+// @Failure     409 {object} ErrorResponse "이미 존재하는 사용자 이름"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /admin/users [post]
+func CreateUser(userRepo repository.UserRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		contextInfo := map[string]string{
+			"handler":   "CreateUser",
+			"createdBy": c.GetString("username"),
+		}
+
+		// 1. 요청 검증
+		var req CreateUserRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			contextInfo["step"] = "요청 검증"
+			SendBadRequestErrorWithLogging(c, logger, "요청 형식이 올바르지 않습니다", err, contextInfo)
+			return
+		}
+		contextInfo["username"] = req.Username
+		contextInfo["role"] = req.Role
+
+		if message := validateUserInput(req.Username, req.Role, req.Password, true); message != "" {
+			contextInfo["step"] = "요청 검증"
+			SendBadRequestErrorWithLogging(c, logger, message, nil, contextInfo)
+			return
+		}
+
+		// 2. 비밀번호 해시
+		passwordHash, err := auth.HashPassword(req.Password)
+		if err != nil {
+			contextInfo["step"] = "비밀번호 해시"
+			SendInternalServerErrorWithLogging(c, logger, "계정 생성에 실패했습니다", err, contextInfo)
+			return
+		}
+
+		// 3. 계정 저장
+		user := &model.User{Username: req.Username, PasswordHash: passwordHash, Role: req.Role}
+		err = userRepo.CreateUser(c.Request.Context(), user)
+		if _, ok := err.(*repository.UserExistsError); ok {
+			contextInfo["step"] = "계정 저장"
+			SendConflictErrorWithLogging(c, logger, "이미 존재하는 사용자 이름입니다", err, contextInfo)
+			return
+		}
+		if err != nil {
+			contextInfo["step"] = "계정 저장"
+			SendInternalServerErrorWithLogging(c, logger, "계정 생성에 실패했습니다", err, contextInfo)
+			return
+		}
+
+		logger.Info(c.Request.Context(), "관리자 계정 생성 성공", contextInfo)
+		SendSuccess(c, http.StatusCreated, user)
+	}
+}
+
+// @Summary     관리자 계정 수정
+// @Description 관리자 계정의 역할이나 비밀번호를 변경하거나 2단계 인증을 해제합니다 (owner 전용)
+// @Description 마지막 owner 계정의 역할은 변경할 수 없습니다
+// @Tags        사용자
+// @Accept      json
+// @Produce     json
+// @Security    AdminAuth
+// @Param       username path string true "사용자 이름"
+// @Param       request body UpdateUserRequest true "변경할 정보"
+// @Success     200 {object} model.User
+// @Failure     400 {object} ErrorResponse "잘못된 요청"
+// @Failure     401 {object} ErrorResponse "인증 실패"
+// @Failure     403 {object} ErrorResponse "권한 없음"
+// @Failure     404 {object} ErrorResponse "계정을 찾을 수 없음"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /admin/users/{username} [put]
+func UpdateUser(userRepo repository.UserRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		username := c.Param("username")
+		contextInfo := map[string]string{
+			"handler":   "UpdateUser",
+			"username":  username,
+			"updatedBy": c.GetString("username"),
+		}
+
+		// 1. 요청 검증
+		var req UpdateUserRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			contextInfo["step"] = "요청 검증"
+			SendBadRequestErrorWithLogging(c, logger, "요청 형식이 올바르지 않습니다", err, contextInfo)
+			return
+		}
+		if req.Role == "" && req.Password == "" && !req.ResetTOTP {
+			contextInfo["step"] = "요청 검증"
+			SendBadRequestErrorWithLogging(c, logger, "변경할 역할, 비밀번호 또는 2단계 인증 해제가 필요합니다", nil, contextInfo)
+			return
+		}
+		if message := validateUserInput("", req.Role, req.Password, false); message != "" {
+			contextInfo["step"] = "요청 검증"
+			SendBadRequestErrorWithLogging(c, logger, message, nil, contextInfo)
+			return
+		}
+
+		// 2. 계정 조회
+		users, err := userRepo.GetUsers(c.Request.Context())
+		if err != nil {
+			contextInfo["step"] = "계정 조회"
+			SendInternalServerErrorWithLogging(c, logger, "계정 수정에 실패했습니다", err, contextInfo)
+			return
+		}
+		user := findUser(users, username)
+		if user == nil {
+			contextInfo["step"] = "계정 조회"
+			SendNotFoundErrorWithLogging(c, logger, "존재하지 않는 계정입니다", nil, contextInfo)
+			return
+		}
+
+		// 3. 변경 내용 적용
+		if req.Role != "" && req.Role != user.Role {
+			if user.Role == model.UserRoleOwner && countOwners(users) == 1 {
+				contextInfo["step"] = "역할 변경"
+				SendBadRequestErrorWithLogging(c, logger, "마지막 owner 계정의 역할은 변경할 수 없습니다", nil, contextInfo)
+				return
+			}
+			contextInfo["role"] = req.Role
+			user.Role = req.Role
+		}
+		if req.Password != "" {
+			passwordHash, err := auth.HashPassword(req.Password)
+			if err != nil {
+				contextInfo["step"] = "비밀번호 해시"
+				SendInternalServerErrorWithLogging(c, logger, "계정 수정에 실패했습니다", err, contextInfo)
+				return
+			}
+			contextInfo["passwordChanged"] = "true"
+			user.PasswordHash = passwordHash
+		}
+		if req.ResetTOTP {
+			contextInfo["totpReset"] = "true"
+			resetTOTP(user)
+		}
+		user.UpdatedAt = time.Now()
+
+		// 4. 계정 저장
+		err = userRepo.UpdateUser(c.Request.Context(), user)
+		if _, ok := err.(*repository.UserNotFoundError); ok {
+			contextInfo["step"] = "계정 저장"
+			SendNotFoundErrorWithLogging(c, logger, "존재하지 않는 계정입니다", err, contextInfo)
+			return
+		}
+		if err != nil {
+			contextInfo["step"] = "계정 저장"
+			SendInternalServerErrorWithLogging(c, logger, "계정 수정에 실패했습니다", err, contextInfo)
+			return
+		}
+
+		logger.Info(c.Request.Context(), "관리자 계정 수정 성공", contextInfo)
+		SendSuccess(c, http.StatusOK, user)
+	}
+}
+
+// @Summary     관리자 계정 삭제
+// @Description 관리자 계정과 계정이 발급한 API 토큰을 삭제합니다 (owner 전용). 자기 자신과 마지막 owner 계정은 삭제할 수 없습니다
+// @Tags        사용자
+// @Accept      json
+// @Produce     json
+// @Security    AdminAuth
+// @Param       username path string true "사용자 이름"
+// @Success     200 {object} map[string]string "삭제 성공"
+// @Failure     400 {object} ErrorResponse "잘못된 요청"
+// @Failure     401 {object} ErrorResponse "인증 실패"
+// @Failure     403 {object} ErrorResponse "권한 없음"
+// @Failure     404 {object} ErrorResponse "계정을 찾을 수 없음"
+// @Failure     500 {object} ErrorResponse "서버 오류"
+// @Router      /admin/users/{username} [delete]
+func DeleteUser(userRepo repository.UserRepositoryInterface, tokenRepo repository.APITokenRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		username := c.Param("username")
+		contextInfo := map[string]string{
+			"handler":   "DeleteUser",
+			"username":  username,
+			"deletedBy": c.GetString("username"),
+		}
+
+		if username == c.GetString("username") {
+			contextInfo["step"] = "요청 검증"
+			SendBadRequestErrorWithLogging(c, logger, "자기 자신의 계정은 삭제할 수 없습니다", nil, contextInfo)
+			return
+		}
+
+		users, err := userRepo.GetUsers(c.Request.Context())
+		if err != nil {
+			contextInfo["step"] = "계정 조회"
+			SendInternalServerErrorWithLogging(c, logger, "계정 삭제에 실패했습니다", err, contextInfo)
+			return
+		}
+		user := findUser(users, username)
+		if user == nil {
+			contextInfo["step"] = "계정 조회"
+			SendNotFoundErrorWithLogging(c, logger, "존재하지 않는 계정입니다", nil, contextInfo)
+			return
+		}
+		if user.Role == model.UserRoleOwner && countOwners(users) == 1 {
+			contextInfo["step"] = "요청 검증"
+			SendBadRequestErrorWithLogging(c, logger, "마지막 owner 계정은 삭제할 수 없습니다", nil, contextInfo)
+			return
+		}
+
+		err = userRepo.DeleteUser(c.Request.Context(), username)
+		if _, ok := err.(*repository.UserNotFoundError); ok {
+			contextInfo["step"] = "계정 삭제"
+			SendNotFoundErrorWithLogging(c, logger, "존재하지 않는 계정입니다", err, contextInfo)
+			return
+		}
+		if err != nil {
+			contextInfo["step"] = "계정 삭제"
+			SendInternalServerErrorWithLogging(c, logger, "계정 삭제에 실패했습니다", err, contextInfo)
+			return
+		}
+
+		// 삭제한 계정이 발급한 API 토큰 폐기
+		if err := tokenRepo.DeleteTokensByUsername(c.Request.Context(), username); err != nil {
+			// 토큰 폐기 실패 로그를 남기지만, 계정이 없으면 토큰 인증도 실패하므로 삭제 성공으로 응답
+			logger.Warn(c.Request.Context(), "관리자 계정 삭제 성공 후 API 토큰 폐기 실패", map[string]string{
+				"handler":  "DeleteUser",
+				"step":     "API 토큰 폐기",
+				"username": username,
+				"error":    err.Error(),
+			})
+		}
+
+		logger.Info(c.Request.Context(), "관리자 계정 삭제 성공", contextInfo)
+		SendSuccess(c, http.StatusOK, map[string]string{
+			"message": "계정이 삭제되었습니다",
+		})
+	}
+}
+
+// validateUserInput은 계정 입력값을 검증하고 오류 메시지를 반환합니다. 빈 값은 required가 false이면 검증하지 않습니다.
+func validateUserInput(username, role, password string, required bool) string {
+	if (required || username != "") && !model.IsValidUsername(username) {
+		return "사용자 이름은 영문 소문자로 시작하는 3~32자의 영문 소문자, 숫자, '-', '_', '.'만 사용할 수 있습니다"
+	}
+	if (required || role != "") && !model.IsValidUserRole(role) {
+		return "유효하지 않은 역할입니다 (owner, editor, moderator)"
+	}
+	if (required || password != "") && len([]rune(password)) < minPasswordLength {
+		return fmt.Sprintf("비밀번호는 %d자 이상이어야 합니다", minPasswordLength)
+	}
+	return ""
+}
+
+func findUser(users []model.User, username string) *model.User {
+	for i := range users {
+		if users[i].Username == username {
+			return &users[i]
+		}
+	}
+	return nil
+}
+
+func countOwners(users []model.User) int {
+	count := 0
+	for _, user := range users {
+		if user.Role == model.UserRoleOwner {
+			count++
+		}
+	}
+	return count
+}
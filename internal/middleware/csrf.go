@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"bumsiku/internal/auth"
+	"bumsiku/internal/handler"
+	"net/http"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+)
+
+// CSRFMiddleware는 로그인 세션으로 인증된 변경 요청(GET, HEAD, OPTIONS 외)의 X-CSRF-Token 헤더가
+// 세션에 저장된 CSRF 토큰과 같은지 확인합니다.
+// 브라우저가 자동으로 보내지 않는 Authorization 헤더로 인증한 API 토큰 요청은 확인하지 않습니다.
+// AuthMiddleware 뒤에 사용합니다.
+func CSRFMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if isSafeMethod(c.Request.Method) {
+			c.Next()
+			return
+		}
+		if _, isToken := tokenScopes(c); isToken {
+			c.Next()
+			return
+		}
+
+		expected, _ := sessions.Default(c).Get(handler.SessionKeyCSRFToken).(string)
+		if !auth.VerifyCSRFToken(expected, c.GetHeader(handler.CSRFHeaderName)) {
+			handler.SendError(c, http.StatusForbidden, "CSRF_TOKEN_INVALID", "CSRF 토큰이 없거나 올바르지 않습니다. GET /csrf로 토큰을 다시 받아주세요")
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// isSafeMethod는 서버 상태를 변경하지 않는 HTTP 메서드인지 확인합니다.
+func isSafeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
@@ -2,7 +2,6 @@ package middleware
 
 import (
 	"bumsiku/internal/utils"
-	"context"
 	"fmt"
 	"net/http"
 
@@ -26,7 +25,6 @@ func ErrorHandlingMiddleware(logger *utils.Logger) gin.HandlerFunc {
 		if len(c.Errors) > 0 {
 			// 첫 번째 에러 가져오기
 			err := c.Errors.Last()
-			requestID, _ := c.Get("requestID")
 
 			// 상태 코드 설정
 			status := http.StatusInternalServerError
@@ -51,18 +49,14 @@ func ErrorHandlingMiddleware(logger *utils.Logger) gin.HandlerFunc {
 				"errorType": fmt.Sprintf("%d", err.Type),
 			}
 
-			if requestID != nil {
-				fields["requestID"] = requestID.(string)
-			}
-
-			// 에러 로깅
-			logger.Error(context.Background(), fmt.Sprintf("에러 발생: %v", err.Error()), fields)
+			// 에러 로깅 (요청 ID와 추적 정보는 요청 컨텍스트에서 붙음)
+			logger.Error(c.Request.Context(), fmt.Sprintf("에러 발생: %v", err.Error()), fields)
 
 			// 에러 응답 보내기
 			c.JSON(status, ErrorResponse{
 				Status:    status,
 				Message:   err.Error(),
-				RequestID: fmt.Sprintf("%v", requestID),
+				RequestID: utils.RequestIDFromContext(c.Request.Context()),
 			})
 			c.Abort()
 		}
@@ -74,8 +68,6 @@ func RecoveryWithLogger(logger *utils.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		defer func() {
 			if r := recover(); r != nil {
-				requestID, _ := c.Get("requestID")
-
 				// panic 로깅
 				fields := map[string]string{
 					"method": c.Request.Method,
@@ -83,17 +75,13 @@ func RecoveryWithLogger(logger *utils.Logger) gin.HandlerFunc {
 					"ip":     c.ClientIP(),
 				}
 
-				if requestID != nil {
-					fields["requestID"] = requestID.(string)
-				}
-
-				logger.Error(context.Background(), fmt.Sprintf("Panic 복구: %v", r), fields)
+				logger.Error(c.Request.Context(), fmt.Sprintf("Panic 복구: %v", r), fields)
 
 				// 클라이언트에게 500 에러 반환
 				c.JSON(http.StatusInternalServerError, ErrorResponse{
 					Status:    http.StatusInternalServerError,
 					Message:   "서버 내부 오류가 발생했습니다",
-					RequestID: fmt.Sprintf("%v", requestID),
+					RequestID: utils.RequestIDFromContext(c.Request.Context()),
 				})
 				c.Abort()
 			}
@@ -3,9 +3,9 @@ package middleware
 import (
 	"bumsiku/internal/utils"
 	"bytes"
-	"context"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -24,16 +24,48 @@ func (w bodyLogWriter) Write(b []byte) (int, error) {
 	return w.ResponseWriter.Write(b)
 }
 
+// 요청 추적 헤더
+const (
+	// RequestIDHeader는 요청 ID를 주고받는 헤더입니다. 요청에 올바른 값이 있으면 이어서 사용하고, 응답에 항상 포함합니다.
+	RequestIDHeader = "X-Request-ID"
+	// TraceParentHeader는 W3C Trace Context 헤더입니다.
+	TraceParentHeader = "traceparent"
+)
+
+// maxRequestIDLength는 이어서 사용할 요청 ID의 최대 길이입니다.
+const maxRequestIDLength = 128
+
+// isValidRequestID는 요청의 X-Request-ID를 로그와 응답 헤더에 그대로 사용해도 되는지 확인합니다.
+// 영문, 숫자, '-', '_', '.', ':'만 허용합니다.
+func isValidRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range requestID {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') && !strings.ContainsRune("-_.:", r) {
+			return false
+		}
+	}
+	return true
+}
+
 // LoggingMiddleware 모든 요청과 응답을 로깅하는 미들웨어
-func LoggingMiddleware(logger *utils.Logger) gin.HandlerFunc {
+// 헤더와 바디의 비밀번호, 토큰, 쿠키 같은 민감한 값은 redactor로 가리고, 큰 바디는 잘라서 기록합니다.
+func LoggingMiddleware(logger *utils.Logger, redactor *Redactor) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// 요청 시작 시간
 		startTime := time.Now()
 
-		// 요청 ID 생성
-		requestID := uuid.New().String()
-		c.Set("requestID", requestID)
-		c.Header("X-Request-ID", requestID)
+		// 요청 ID (클라이언트나 프록시가 보낸 X-Request-ID가 올바르면 사용)와 추적 정보를 요청 컨텍스트에 저장
+		// 핸들러와 저장소는 c.Request.Context()를 사용하므로, 이 컨텍스트로 남긴 로그에는 요청 ID가 함께 기록됨
+		requestID := c.GetHeader(RequestIDHeader)
+		if !isValidRequestID(requestID) {
+			requestID = uuid.New().String()
+		}
+		trace := utils.NewTraceContext(c.GetHeader(TraceParentHeader))
+		ctx := utils.WithTraceContext(utils.WithRequestID(c.Request.Context(), requestID), trace)
+		c.Request = c.Request.WithContext(ctx)
+		c.Header(RequestIDHeader, requestID)
 
 		// 요청 바디 읽기
 		var requestBody []byte
@@ -56,16 +88,21 @@ func LoggingMiddleware(logger *utils.Logger) gin.HandlerFunc {
 			"path":      c.Request.URL.Path,
 			"ip":        c.ClientIP(),
 			"userAgent": c.Request.UserAgent(),
-			"requestID": requestID,
+		}
+		if trace.ParentID != "" {
+			fields["parentSpanID"] = trace.ParentID
+		}
+		if headers := redactor.Headers(c.Request.Header); headers != "" {
+			fields["requestHeaders"] = headers
 		}
 
 		// 요청 바디 로깅 (선택적으로 사용 가능)
 		if len(requestBody) > 0 && shouldLogBody(c.Request.URL.Path) {
 			// 보안 상 로깅하면 안 되는 필드 필터링 (비밀번호 등)
-			fields["requestBody"] = filterSensitiveData(string(requestBody))
+			fields["requestBody"] = redactor.Body(requestBody, c.ContentType())
 		}
 
-		logger.Info(context.Background(), fmt.Sprintf("요청 시작: %s %s", c.Request.Method, c.Request.URL.Path), fields)
+		logger.Info(c.Request.Context(), fmt.Sprintf("요청 시작: %s %s", c.Request.Method, c.Request.URL.Path), fields)
 
 		// 다음 핸들러 호출
 		c.Next()
@@ -77,18 +114,19 @@ func LoggingMiddleware(logger *utils.Logger) gin.HandlerFunc {
 		responseFields := map[string]string{
 			"method":        c.Request.Method,
 			"path":          c.Request.URL.Path,
-			"requestID":     requestID,
 			"statusCode":    fmt.Sprintf("%d", c.Writer.Status()),
 			"duration":      duration.String(),
 			"contentType":   c.Writer.Header().Get("Content-Type"),
 			"contentLength": fmt.Sprintf("%d", c.Writer.Size()),
 		}
+		if headers := redactor.Headers(c.Writer.Header()); headers != "" {
+			responseFields["responseHeaders"] = headers
+		}
 
 		// 응답 바디 (선택적으로 사용 가능)
 		if shouldLogBody(c.Request.URL.Path) && blw.body.Len() > 0 {
-			responseBody := blw.body.String()
 			// 보안 상 로깅하면 안 되는 필드 필터링
-			responseFields["responseBody"] = filterSensitiveData(responseBody)
+			responseFields["responseBody"] = redactor.Body(blw.body.Bytes(), c.Writer.Header().Get("Content-Type"))
 		}
 
 		// 에러가 있었을 경우 로깅 레벨 변경
@@ -103,7 +141,7 @@ func LoggingMiddleware(logger *utils.Logger) gin.HandlerFunc {
 
 		// 응답 로깅
 		logger.Log(
-			context.Background(),
+			c.Request.Context(),
 			logLevel,
 			fmt.Sprintf("응답 완료: %s %s %d", c.Request.Method, c.Request.URL.Path, c.Writer.Status()),
 			responseFields,
@@ -120,10 +158,3 @@ func shouldLogBody(path string) bool {
 	}
 	return true
 }
-
-// filterSensitiveData 민감한 데이터를 필터링 (비밀번호 등)
-func filterSensitiveData(data string) string {
-	// 여기서 정규식 등을 사용하여 비밀번호, 토큰 등 민감 데이터 필터링 가능
-	// 간단한 예제: 실제 구현시 정규식으로 더 정확하게 처리해야 함
-	return data
-}
@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"bumsiku/internal/auth"
+	"bumsiku/internal/handler"
+	"bumsiku/internal/middleware"
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-contrib/sessions/cookie"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// [GIVEN] CSRF 토큰이 저장된 로그인 세션과 API 토큰
+// [WHEN] 관리자 API에 조회 요청과 변경 요청
+// [THEN] 세션 요청의 변경 요청은 X-CSRF-Token 헤더가 세션의 토큰과 같을 때만 통과하고, 조회와 API 토큰 요청은 확인하지 않음 확인
+func TestCSRFMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+	userRepo := repository.NewMemoryUserRepository()
+	tokenRepo := repository.NewMemoryAPITokenRepository()
+	require.NoError(t, userRepo.CreateUser(ctx, &model.User{Username: "editor1", Role: model.UserRoleEditor}))
+
+	value, tokenID, hash, err := auth.GenerateAPIToken()
+	require.NoError(t, err)
+	require.NoError(t, tokenRepo.CreateToken(ctx, &model.APIToken{
+		TokenID:   tokenID,
+		Username:  "editor1",
+		Name:      "CI",
+		Scopes:    []string{model.APITokenScopePostsWrite},
+		TokenHash: hash,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}))
+
+	csrfToken, err := auth.GenerateCSRFToken()
+	require.NoError(t, err)
+
+	router := gin.New()
+	router.Use(sessions.Sessions("session", cookie.NewStore([]byte("secret"))))
+	router.GET("/login", func(c *gin.Context) {
+		session := sessions.Default(c)
+		session.Set("username", "editor1")
+		if c.Query("csrf") != "false" {
+			session.Set(handler.SessionKeyCSRFToken, csrfToken)
+		}
+		require.NoError(t, session.Save())
+	})
+	admin := router.Group("/admin", middleware.AuthMiddleware(userRepo, tokenRepo, nil), middleware.CSRFMiddleware())
+	admin.GET("/posts", func(c *gin.Context) { c.Status(http.StatusNoContent) })
+	admin.POST("/posts", func(c *gin.Context) { c.Status(http.StatusNoContent) })
+
+	login := func(path string) []*http.Cookie {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w.Result().Cookies()
+	}
+	request := func(method string, cookies []*http.Cookie, headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/admin/posts", nil)
+		for _, cookie := range cookies {
+			req.AddCookie(cookie)
+		}
+		for key, value := range headers {
+			req.Header.Set(key, value)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	cookies := login("/login")
+	assert.Equal(t, http.StatusNoContent, request(http.MethodGet, cookies, nil).Code)
+
+	w := request(http.MethodPost, cookies, nil)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Contains(t, w.Body.String(), "CSRF_TOKEN_INVALID")
+
+	assert.Equal(t, http.StatusForbidden, request(http.MethodPost, cookies, map[string]string{handler.CSRFHeaderName: "wrong"}).Code)
+	assert.Equal(t, http.StatusNoContent, request(http.MethodPost, cookies, map[string]string{handler.CSRFHeaderName: csrfToken}).Code)
+
+	// CSRF 토큰이 없는 세션은 어떤 헤더로도 통과하지 못함
+	legacy := login("/login?csrf=false")
+	assert.Equal(t, http.StatusForbidden, request(http.MethodPost, legacy, map[string]string{handler.CSRFHeaderName: ""}).Code)
+
+	// API 토큰 요청은 CSRF 토큰 없이 통과
+	assert.Equal(t, http.StatusNoContent, request(http.MethodPost, nil, map[string]string{"Authorization": "Bearer " + value}).Code)
+}
@@ -0,0 +1,188 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"bumsiku/internal/middleware"
+	"bumsiku/internal/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newLoggingRouter는 메모리 싱크에 로그를 기록하는 LoggingMiddleware 라우터를 생성합니다.
+func newLoggingRouter(t *testing.T, options middleware.RedactOptions) (*gin.Engine, *utils.Logger, *utils.MemorySink) {
+	gin.SetMode(gin.TestMode)
+	sink := utils.NewMemorySink()
+	logger := utils.NewLoggerWithOptions(utils.LoggerOptions{FlushInterval: time.Hour}, sink)
+	t.Cleanup(func() { logger.Close(context.Background()) })
+
+	router := gin.New()
+	router.Use(middleware.LoggingMiddleware(logger, middleware.NewRedactor(options)))
+	return router, logger, sink
+}
+
+// loggedFields는 요청 시작과 응답 완료 로그의 필드를 반환합니다.
+func loggedFields(t *testing.T, logger *utils.Logger, sink *utils.MemorySink) (request, response map[string]string) {
+	require.NoError(t, logger.Flush(context.Background()))
+	entries := sink.Entries()
+	require.Len(t, entries, 2)
+	return entries[0].Fields, entries[1].Fields
+}
+
+// [GIVEN] 비밀번호를 담은 로그인 요청과 CSRF 토큰, 세션 쿠키를 담은 응답
+// [WHEN] LoggingMiddleware로 요청 처리
+// [THEN] 바디의 민감한 키와 Authorization, 쿠키 값은 가려지고 나머지 값은 그대로 기록됨 확인
+func TestLoggingMiddleware_RedactsSensitiveData(t *testing.T) {
+	router, logger, sink := newLoggingRouter(t, middleware.RedactOptions{Keys: []string{"phone"}})
+	router.POST("/login", func(c *gin.Context) {
+		http.SetCookie(c.Writer, &http.Cookie{Name: "loginSession", Value: "session-secret-value", Path: "/", HttpOnly: true})
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    gin.H{"username": "admin", "csrfToken": "csrf-secret-value"},
+			"errors":  []gin.H{{"code": "NONE", "errorCode": "E1"}},
+		})
+	})
+
+	body := `{"username":"admin","password":"hunter22!","profile":{"newPassword":"again","phone":"010-1234-5678"},"code":"123456"}`
+	req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer bsk_abc_secret")
+	req.Header.Set("Cookie", "loginSession=old-session-value; theme=dark")
+	req.Header.Set("X-CSRF-Token", "header-csrf-value")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	request, response := loggedFields(t, logger, sink)
+	for _, value := range []string{request["requestBody"], request["requestHeaders"], response["responseBody"], response["responseHeaders"]} {
+		for _, secret := range []string{"hunter22!", "again", "010-1234-5678", "123456", "bsk_abc_secret", "old-session-value", "dark", "header-csrf-value", "csrf-secret-value", "session-secret-value"} {
+			assert.NotContains(t, value, secret)
+		}
+	}
+
+	assert.Contains(t, request["requestBody"], `"username":"admin"`)
+	assert.Contains(t, request["requestBody"], `"password":"[REDACTED]"`)
+	assert.Contains(t, request["requestBody"], `"newPassword":"[REDACTED]"`)
+	assert.Contains(t, request["requestHeaders"], `"Authorization":"Bearer [REDACTED]"`)
+	assert.Contains(t, request["requestHeaders"], `"Cookie":"loginSession=[REDACTED]; theme=[REDACTED]"`)
+	assert.Contains(t, request["requestHeaders"], `"Content-Type":"application/json"`)
+
+	assert.Contains(t, response["responseBody"], `"username":"admin"`)
+	assert.Contains(t, response["responseBody"], `"errorCode":"E1"`)
+	assert.Contains(t, response["responseHeaders"], "loginSession=[REDACTED]; Path=/; HttpOnly")
+}
+
+// [GIVEN] 폼 요청, 잘못된 JSON 요청, 최대 크기를 넘는 응답
+// [WHEN] LoggingMiddleware로 요청 처리
+// [THEN] 폼의 민감한 값은 가려지고, 해석할 수 없는 JSON은 통째로 가려지며, 큰 바디는 잘려서 기록됨 확인
+func TestLoggingMiddleware_FormInvalidJSONAndTruncation(t *testing.T) {
+	router, logger, sink := newLoggingRouter(t, middleware.RedactOptions{MaxBodySize: 64})
+	router.POST("/form", func(c *gin.Context) {
+		c.String(http.StatusOK, strings.Repeat("가", 100))
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/form", strings.NewReader("name=kim&password=hunter22"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	request, response := loggedFields(t, logger, sink)
+	assert.Equal(t, "name=kim&password=[REDACTED]", request["requestBody"])
+	assert.True(t, strings.HasPrefix(response["responseBody"], strings.Repeat("가", 21)+"...(truncated"))
+
+	sink.Reset()
+	req = httptest.NewRequest(http.MethodPost, "/form", strings.NewReader(`{"password":"hunter22"`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	request, _ = loggedFields(t, logger, sink)
+	assert.NotContains(t, request["requestBody"], "hunter22")
+	assert.True(t, strings.HasPrefix(request["requestBody"], middleware.RedactedValue))
+}
+
+// [GIVEN] X-Request-ID와 traceparent 헤더를 보낸 요청
+// [WHEN] LoggingMiddleware를 거친 핸들러가 요청 컨텍스트로 로그를 남김
+// [THEN] 요청 ID와 추적 ID를 이어받아 응답 헤더와 모든 로그에 기록하고, 잘못된 헤더는 새 값으로 대체됨 확인
+func TestLoggingMiddleware_PropagatesRequestContext(t *testing.T) {
+	router, logger, sink := newLoggingRouter(t, middleware.RedactOptions{})
+	router.GET("/posts", func(c *gin.Context) {
+		trace, ok := utils.TraceContextFromContext(c.Request.Context())
+		require.True(t, ok)
+		c.Header("X-Trace-Parent", trace.TraceParent())
+		require.NoError(t, logger.Info(c.Request.Context(), "핸들러 로그", nil))
+		c.Status(http.StatusNoContent)
+	})
+
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	req := httptest.NewRequest(http.MethodGet, "/posts", nil)
+	req.Header.Set(middleware.RequestIDHeader, "client-req_1.2:3")
+	req.Header.Set(middleware.TraceParentHeader, "00-"+traceID+"-00f067aa0ba902b7-01")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, "client-req_1.2:3", w.Header().Get(middleware.RequestIDHeader))
+	traceParent := w.Header().Get("X-Trace-Parent")
+	assert.True(t, strings.HasPrefix(traceParent, "00-"+traceID+"-"))
+	assert.NotContains(t, traceParent, "00f067aa0ba902b7")
+
+	require.NoError(t, logger.Flush(context.Background()))
+	entries := sink.Entries()
+	require.Len(t, entries, 3)
+	for _, entry := range entries {
+		assert.Equal(t, "client-req_1.2:3", entry.Fields["requestID"], entry.Message)
+		assert.Equal(t, traceID, entry.Fields["traceID"], entry.Message)
+	}
+	assert.Equal(t, "00f067aa0ba902b7", entries[0].Fields["parentSpanID"])
+
+	// 형식이 잘못된 헤더는 사용하지 않음
+	sink.Reset()
+	req = httptest.NewRequest(http.MethodGet, "/posts", nil)
+	req.Header.Set(middleware.RequestIDHeader, "bad id\r\ninjected")
+	req.Header.Set(middleware.TraceParentHeader, "00-"+strings.Repeat("0", 32)+"-00f067aa0ba902b7-01")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	requestID := w.Header().Get(middleware.RequestIDHeader)
+	assert.Len(t, requestID, 36)
+	assert.False(t, strings.HasPrefix(w.Header().Get("X-Trace-Parent"), "00-"+strings.Repeat("0", 32)))
+
+	require.NoError(t, logger.Flush(context.Background()))
+	for _, entry := range sink.Entries() {
+		assert.Equal(t, requestID, entry.Fields["requestID"])
+		assert.Empty(t, entry.Fields["parentSpanID"])
+	}
+}
+
+// [GIVEN] 오류를 기록하는 핸들러
+// [WHEN] LoggingMiddleware와 ErrorHandlingMiddleware를 거쳐 요청 처리
+// [THEN] 오류 응답과 오류 로그에 요청 ID가 포함됨 확인
+func TestErrorHandlingMiddleware_UsesRequestContext(t *testing.T) {
+	router, logger, sink := newLoggingRouter(t, middleware.RedactOptions{})
+	router.Use(middleware.ErrorHandlingMiddleware(logger))
+	router.GET("/fail", func(c *gin.Context) {
+		_ = c.Error(errors.New("실패"))
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/fail", nil)
+	req.Header.Set(middleware.RequestIDHeader, "req-42")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Contains(t, w.Body.String(), `"requestId":"req-42"`)
+	require.NoError(t, logger.Flush(context.Background()))
+	var found bool
+	for _, entry := range sink.Entries() {
+		if strings.HasPrefix(entry.Message, "에러 발생") {
+			found = true
+			assert.Equal(t, "req-42", entry.Fields["requestID"])
+		}
+	}
+	assert.True(t, found)
+}
@@ -0,0 +1,105 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"bumsiku/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var baseTime = time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+// [GIVEN] 1분에 3번, 최대 3번까지 몰아서 요청할 수 있는 제한
+// [WHEN] 같은 키로 연속 요청 후 시간이 지나 다시 요청
+// [THEN] 버킷이 비면 거부되고 재시도 시간 반환, 시간이 지나면 토큰이 채워짐 확인
+func TestMemoryRateLimitStore_TokenBucket(t *testing.T) {
+	ctx := context.Background()
+	store := middleware.NewMemoryRateLimitStore()
+	limit := middleware.RateLimit{Requests: 3, Per: time.Minute}
+
+	for i := 2; i >= 0; i-- {
+		result, err := store.Take(ctx, "login:1.2.3.4", limit, baseTime)
+		require.NoError(t, err)
+		assert.True(t, result.Allowed)
+		assert.Equal(t, i, result.Remaining)
+	}
+
+	result, err := store.Take(ctx, "login:1.2.3.4", limit, baseTime.Add(5*time.Second))
+	require.NoError(t, err)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, 15*time.Second, result.RetryAfter)
+
+	// 다른 키는 따로 제한
+	result, err = store.Take(ctx, "login:5.6.7.8", limit, baseTime)
+	require.NoError(t, err)
+	assert.True(t, result.Allowed)
+
+	// 20초마다 토큰 하나씩 채워짐
+	result, err = store.Take(ctx, "login:1.2.3.4", limit, baseTime.Add(20*time.Second))
+	require.NoError(t, err)
+	assert.True(t, result.Allowed)
+	assert.Equal(t, 0, result.Remaining)
+
+	// 오래 지나도 최대 Burst개까지만 채워짐
+	for i := 0; i < 3; i++ {
+		result, err = store.Take(ctx, "login:1.2.3.4", limit, baseTime.Add(time.Hour))
+		require.NoError(t, err)
+		assert.True(t, result.Allowed)
+	}
+	result, err = store.Take(ctx, "login:1.2.3.4", limit, baseTime.Add(time.Hour))
+	require.NoError(t, err)
+	assert.False(t, result.Allowed)
+}
+
+// [GIVEN] Burst를 따로 지정한 제한
+// [WHEN] Burst만큼 연속 요청
+// [THEN] Requests가 아닌 Burst까지 허용 확인
+func TestMemoryRateLimitStore_Burst(t *testing.T) {
+	ctx := context.Background()
+	store := middleware.NewMemoryRateLimitStore()
+	limit := middleware.RateLimit{Requests: 1, Per: time.Second, Burst: 5}
+
+	for i := 0; i < 5; i++ {
+		result, err := store.Take(ctx, "global:1.2.3.4", limit, baseTime)
+		require.NoError(t, err)
+		assert.True(t, result.Allowed)
+	}
+	result, err := store.Take(ctx, "global:1.2.3.4", limit, baseTime)
+	require.NoError(t, err)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, time.Second, result.RetryAfter)
+}
+
+// [GIVEN] 요청 수 제한 미들웨어를 적용한 라우터
+// [WHEN] 제한 이내로 요청
+// [THEN] 요청이 처리되고 남은 요청 수 헤더 반환, 제한이 꺼져 있으면 헤더 없이 처리 확인
+func TestRateLimitMiddleware_Allowed(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	store := middleware.NewMemoryRateLimitStore()
+
+	router := gin.New()
+	router.GET("/limited", middleware.RateLimitMiddleware("test", middleware.RateLimit{Requests: 2, Per: time.Minute}, store, nil), func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+	router.GET("/unlimited", middleware.RateLimitMiddleware("off", middleware.RateLimit{}, store, nil), func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/limited", nil))
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, "2", w.Header().Get("X-RateLimit-Limit"))
+	assert.Equal(t, "1", w.Header().Get("X-RateLimit-Remaining"))
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/unlimited", nil))
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Empty(t, w.Header().Get("X-RateLimit-Limit"))
+}
@@ -0,0 +1,109 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bumsiku/internal/handler"
+	"bumsiku/internal/middleware"
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-contrib/sessions/cookie"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// [GIVEN] 역할별 허용 목록
+// [WHEN] HasRole 호출
+// [THEN] 목록에 있는 역할과 owner만 허용 확인
+func TestHasRole(t *testing.T) {
+	assert.True(t, middleware.HasRole(model.UserRoleOwner))
+	assert.True(t, middleware.HasRole(model.UserRoleEditor, model.UserRoleEditor))
+	assert.True(t, middleware.HasRole(model.UserRoleModerator, model.UserRoleEditor, model.UserRoleModerator))
+	assert.False(t, middleware.HasRole(model.UserRoleModerator, model.UserRoleEditor))
+	assert.False(t, middleware.HasRole(model.UserRoleEditor))
+	assert.False(t, middleware.HasRole("", model.UserRoleEditor))
+}
+
+// [GIVEN] editor 역할만 허용하는 라우트
+// [WHEN] 역할별로 요청
+// [THEN] editor와 owner는 통과하고 moderator는 403 반환 확인
+func TestRequireRole(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	for role, expected := range map[string]int{
+		model.UserRoleOwner:     http.StatusOK,
+		model.UserRoleEditor:    http.StatusOK,
+		model.UserRoleModerator: http.StatusForbidden,
+	} {
+		router := gin.New()
+		router.Use(func(c *gin.Context) {
+			c.Set(middleware.ContextKeyRole, role)
+		})
+		router.DELETE("/admin/posts/:id", middleware.RequireRole(model.UserRoleEditor), func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/admin/posts/post-1", nil))
+		assert.Equal(t, expected, w.Code, role)
+	}
+}
+
+// [GIVEN] 2단계 인증을 사용하는 계정과 사용하지 않는 계정
+// [WHEN] TOTP 확인 여부가 다른 세션으로 관리자 API 요청
+// [THEN] 2단계 인증 계정은 TOTP 확인을 마친 세션만 통과하고 역할이 컨텍스트에 저장됨 확인
+func TestSessionAuthMiddleware_RequiresSecondFactor(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+	userRepo := repository.NewMemoryUserRepository()
+	require.NoError(t, userRepo.CreateUser(ctx, &model.User{Username: "admin", Role: model.UserRoleOwner, TOTPEnabled: true, TOTPSecret: "SECRET"}))
+	require.NoError(t, userRepo.CreateUser(ctx, &model.User{Username: "editor1", Role: model.UserRoleEditor}))
+
+	router := gin.New()
+	router.Use(sessions.Sessions("session", cookie.NewStore([]byte("secret"))))
+	router.GET("/login/:username/:verified", func(c *gin.Context) {
+		session := sessions.Default(c)
+		session.Set("username", c.Param("username"))
+		session.Set(handler.SessionKeyTOTPVerified, c.Param("verified") == "true")
+		require.NoError(t, session.Save())
+	})
+	router.GET("/admin/me", middleware.SessionAuthMiddleware(userRepo, nil), func(c *gin.Context) {
+		c.String(http.StatusOK, c.GetString(middleware.ContextKeyRole))
+	})
+
+	request := func(username, verified string) *httptest.ResponseRecorder {
+		login := httptest.NewRecorder()
+		router.ServeHTTP(login, httptest.NewRequest(http.MethodGet, "/login/"+username+"/"+verified, nil))
+
+		req := httptest.NewRequest(http.MethodGet, "/admin/me", nil)
+		for _, cookie := range login.Result().Cookies() {
+			req.AddCookie(cookie)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, request("admin", "false").Code)
+
+	w := request("admin", "true")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, model.UserRoleOwner, w.Body.String())
+
+	w = request("editor1", "false")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, model.UserRoleEditor, w.Body.String())
+
+	assert.Equal(t, http.StatusUnauthorized, request("missing", "true").Code)
+
+	// 세션이 없는 요청
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/admin/me", nil))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
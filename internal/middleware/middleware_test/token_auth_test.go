@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"bumsiku/internal/auth"
+	"bumsiku/internal/middleware"
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-contrib/sessions/cookie"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// [GIVEN] posts:write 범위만 있는 editor 계정의 API 토큰
+// [WHEN] Bearer 헤더로 관리자 API 요청
+// [THEN] 범위에 포함된 작업만 통과하고, 세션 전용 API와 잘못된 토큰은 거부되며, 사용 시간이 기록됨 확인
+func TestAuthMiddleware_BearerToken(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+	userRepo := repository.NewMemoryUserRepository()
+	tokenRepo := repository.NewMemoryAPITokenRepository()
+	require.NoError(t, userRepo.CreateUser(ctx, &model.User{Username: "editor1", Role: model.UserRoleEditor}))
+
+	value, tokenID, hash, err := auth.GenerateAPIToken()
+	require.NoError(t, err)
+	require.NoError(t, tokenRepo.CreateToken(ctx, &model.APIToken{
+		TokenID:   tokenID,
+		Username:  "editor1",
+		Name:      "CI",
+		Scopes:    []string{model.APITokenScopePostsWrite},
+		TokenHash: hash,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}))
+
+	router := gin.New()
+	router.Use(sessions.Sessions("session", cookie.NewStore([]byte("secret"))))
+	admin := router.Group("/admin", middleware.AuthMiddleware(userRepo, tokenRepo, nil))
+	ok := func(c *gin.Context) {
+		c.String(http.StatusOK, c.GetString(middleware.ContextKeyUsername))
+	}
+	admin.POST("/posts", middleware.RequireRole(model.UserRoleEditor), middleware.RequireScope(model.APITokenScopePostsWrite), ok)
+	admin.POST("/images", middleware.RequireRole(model.UserRoleEditor), middleware.RequireScope(model.APITokenScopeImagesWrite), ok)
+	admin.GET("/users", middleware.SessionOnly(), ok)
+
+	request := func(method, path, authorization string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := request(http.MethodPost, "/admin/posts", "Bearer "+value)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "editor1", w.Body.String())
+
+	assert.Equal(t, http.StatusForbidden, request(http.MethodPost, "/admin/images", "Bearer "+value).Code)
+	assert.Equal(t, http.StatusForbidden, request(http.MethodGet, "/admin/users", "bearer "+value).Code)
+
+	w = request(http.MethodPost, "/admin/posts", "Bearer bsk_0000000000000000_invalid")
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Contains(t, w.Header().Get("WWW-Authenticate"), "Bearer")
+
+	// 헤더가 없으면 세션 인증
+	assert.Equal(t, http.StatusUnauthorized, request(http.MethodPost, "/admin/posts", "").Code)
+
+	token, err := tokenRepo.GetToken(ctx, tokenID)
+	require.NoError(t, err)
+	assert.NotNil(t, token.LastUsedAt)
+}
@@ -0,0 +1,160 @@
+package middleware
+
+import (
+	"bumsiku/internal/handler"
+	"bumsiku/internal/utils"
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RateLimit은 토큰 버킷 설정입니다. Per 동안 Requests개의 요청을 허용하며, 한 번에 최대 Burst개까지 몰아서 요청할 수 있습니다.
+type RateLimit struct {
+	Requests int
+	Per      time.Duration
+	Burst    int // 0이면 Requests와 같음
+}
+
+// Enabled는 요청 수 제한이 설정되어 있는지 확인합니다.
+func (l RateLimit) Enabled() bool {
+	return l.Requests > 0 && l.Per > 0
+}
+
+func (l RateLimit) capacity() float64 {
+	if l.Burst > 0 {
+		return float64(l.Burst)
+	}
+	return float64(l.Requests)
+}
+
+// RateLimitResult는 요청 하나에 대한 판정 결과입니다.
+type RateLimitResult struct {
+	Allowed    bool
+	Remaining  int           // 남은 요청 수
+	RetryAfter time.Duration // 거부된 경우 다음 요청까지 기다려야 하는 시간
+}
+
+// RateLimitStore는 키별 토큰 버킷 저장소입니다.
+// 여러 서버 인스턴스가 제한을 공유하려면 Redis 등 공유 저장소로 구현합니다.
+type RateLimitStore interface {
+	Take(ctx context.Context, key string, limit RateLimit, now time.Time) (RateLimitResult, error)
+}
+
+// memoryBucketSweepInterval은 다 채워진 버킷을 정리하는 주기입니다.
+const memoryBucketSweepInterval = time.Minute
+
+// MemoryRateLimitStore는 서버 메모리에 버킷을 저장하는 RateLimitStore입니다. 서버 인스턴스마다 따로 제한합니다.
+type MemoryRateLimitStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+type tokenBucket struct {
+	tokens    float64
+	updatedAt time.Time
+	full      time.Time // 토큰이 다시 가득 차는 시각
+}
+
+func NewMemoryRateLimitStore() *MemoryRateLimitStore {
+	return &MemoryRateLimitStore{buckets: make(map[string]*tokenBucket)}
+}
+
+func (s *MemoryRateLimitStore) Take(ctx context.Context, key string, limit RateLimit, now time.Time) (RateLimitResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweepLocked(now)
+
+	capacity := limit.capacity()
+	rate := float64(limit.Requests) / limit.Per.Seconds() // 초당 채워지는 토큰 수
+
+	bucket, ok := s.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{tokens: capacity, updatedAt: now}
+		s.buckets[key] = bucket
+	} else if elapsed := now.Sub(bucket.updatedAt).Seconds(); elapsed > 0 {
+		bucket.tokens = math.Min(capacity, bucket.tokens+elapsed*rate)
+		bucket.updatedAt = now
+	}
+
+	if bucket.tokens < 1 {
+		wait := time.Duration((1 - bucket.tokens) / rate * float64(time.Second))
+		return RateLimitResult{Allowed: false, RetryAfter: wait}, nil
+	}
+
+	bucket.tokens--
+	bucket.full = now.Add(time.Duration((capacity - bucket.tokens) / rate * float64(time.Second)))
+	return RateLimitResult{Allowed: true, Remaining: int(bucket.tokens)}, nil
+}
+
+// sweepLocked는 다시 가득 찬 버킷을 삭제합니다. 가득 찬 버킷은 새로 만든 버킷과 같으므로 삭제해도 판정이 바뀌지 않습니다.
+func (s *MemoryRateLimitStore) sweepLocked(now time.Time) {
+	if now.Sub(s.lastSweep) < memoryBucketSweepInterval {
+		return
+	}
+	s.lastSweep = now
+
+	for key, bucket := range s.buckets {
+		if !now.Before(bucket.full) {
+			delete(s.buckets, key)
+		}
+	}
+}
+
+// RateLimitMiddleware는 클라이언트 IP별로 요청 수를 제한하는 미들웨어입니다.
+// name은 라우트 그룹 이름으로, 같은 저장소를 쓰는 그룹끼리도 제한을 따로 셉니다.
+// 클라이언트 IP는 gin의 신뢰할 수 있는 프록시 설정(SetTrustedProxies)에 따라 결정됩니다.
+// 제한을 넘으면 Retry-After 헤더와 함께 429를 반환하고, 저장소 오류 시에는 요청을 통과시킵니다.
+func RateLimitMiddleware(name string, limit RateLimit, store RateLimitStore, logger *utils.Logger) gin.HandlerFunc {
+	if !limit.Enabled() {
+		return func(c *gin.Context) {
+			c.Next()
+		}
+	}
+
+	return func(c *gin.Context) {
+		clientIP := c.ClientIP()
+		result, err := store.Take(c.Request.Context(), name+":"+clientIP, limit, time.Now())
+		if err != nil {
+			logger.Error(c.Request.Context(), "요청 수 제한 확인 실패", map[string]string{
+				"rateLimit":   name,
+				"ip":          clientIP,
+				"path":        c.Request.URL.Path,
+				"errorDetail": err.Error(),
+			})
+			c.Next()
+			return
+		}
+
+		c.Header("X-RateLimit-Limit", strconv.Itoa(int(limit.capacity())))
+		c.Header("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		if result.Allowed {
+			c.Next()
+			return
+		}
+
+		retryAfter := int(math.Ceil(result.RetryAfter.Seconds()))
+		if retryAfter < 1 {
+			retryAfter = 1
+		}
+		c.Header("Retry-After", strconv.Itoa(retryAfter))
+
+		logger.Warn(c.Request.Context(), "요청 수 제한 초과", map[string]string{
+			"rateLimit":  name,
+			"ip":         clientIP,
+			"method":     c.Request.Method,
+			"path":       c.Request.URL.Path,
+			"retryAfter": strconv.Itoa(retryAfter),
+		})
+
+		handler.SendError(c, http.StatusTooManyRequests, "TOO_MANY_REQUESTS", fmt.Sprintf("요청이 너무 많습니다. %d초 후 다시 시도해주세요", retryAfter))
+		c.Abort()
+	}
+}
@@ -0,0 +1,223 @@
+package middleware
+
+import (
+	"bumsiku/internal/config"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// RedactedValue는 로그에서 가린 값 대신 기록하는 문자열입니다.
+const RedactedValue = "[REDACTED]"
+
+// DefaultRedactKeys는 값을 가릴 키의 기본 목록입니다.
+// 대소문자와 '_', '-'를 무시하고 비교하며, 키 이름에 포함되어 있으면 가립니다 (예: newPassword, csrfToken, X-CSRF-Token).
+var DefaultRedactKeys = []string{
+	"password", "passwd", "secret", "token", "apikey", "credential",
+	"authorization", "cookie",
+	"recoverycode", "provisioninguri", // 2단계 인증 설정 응답
+}
+
+// redactExactKeys는 다른 키 이름에도 흔히 포함되어 있어 정확히 일치할 때만 가리는 키입니다.
+// 예를 들어 2단계 인증 코드(code)는 가리지만 오류 코드(errorCode)는 가리지 않습니다.
+var redactExactKeys = []string{"code", "otp"}
+
+// RedactOptions는 로그에 기록할 값을 가리는 방식을 설정합니다. 0인 항목은 기본값을 사용합니다.
+type RedactOptions struct {
+	Keys        []string // 기본 목록에 더해 가릴 키 (키 이름에 포함되면 가림)
+	MaxBodySize int      // 바디를 이 크기(바이트)에서 자름 (0이면 config.DefaultLogBodyMaxSize)
+}
+
+// Redactor는 요청/응답 로그에서 비밀번호, 토큰, 쿠키 같은 민감한 값을 가립니다.
+type Redactor struct {
+	keys        []string
+	maxBodySize int
+}
+
+// NewRedactor는 기본 키 목록에 options.Keys를 더해 Redactor를 생성합니다.
+func NewRedactor(options RedactOptions) *Redactor {
+	keys := append([]string(nil), DefaultRedactKeys...)
+	for _, key := range options.Keys {
+		if key = normalizeRedactKey(key); key != "" {
+			keys = append(keys, key)
+		}
+	}
+
+	maxBodySize := options.MaxBodySize
+	if maxBodySize <= 0 {
+		maxBodySize = config.DefaultLogBodyMaxSize
+	}
+	return &Redactor{keys: keys, maxBodySize: maxBodySize}
+}
+
+// normalizeRedactKey는 키를 비교하기 위해 소문자로 바꾸고 '_', '-'를 제거합니다.
+func normalizeRedactKey(key string) string {
+	return strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(strings.TrimSpace(key)))
+}
+
+// IsSensitiveKey는 key의 값을 가려야 하는지 확인합니다.
+func (r *Redactor) IsSensitiveKey(key string) bool {
+	key = normalizeRedactKey(key)
+	for _, exact := range redactExactKeys {
+		if key == exact {
+			return true
+		}
+	}
+	for _, sensitive := range r.keys {
+		if strings.Contains(key, sensitive) {
+			return true
+		}
+	}
+	return false
+}
+
+// Body는 요청/응답 바디의 민감한 값을 가리고 최대 크기에 맞게 자른 문자열을 반환합니다.
+// JSON과 폼(application/x-www-form-urlencoded) 바디는 키 이름으로 값을 가리며, 해석할 수 없는 JSON은 통째로 가립니다.
+func (r *Redactor) Body(body []byte, contentType string) string {
+	trimmed := bytes.TrimSpace(body)
+	switch {
+	case len(trimmed) == 0:
+		return ""
+
+	case strings.Contains(contentType, "json") || trimmed[0] == '{' || trimmed[0] == '[':
+		decoder := json.NewDecoder(bytes.NewReader(trimmed))
+		decoder.UseNumber()
+		var value interface{}
+		if err := decoder.Decode(&value); err != nil || decoder.More() {
+			return fmt.Sprintf("%s (JSON 해석 실패, %d바이트)", RedactedValue, len(body))
+		}
+		redacted, err := json.Marshal(r.redactJSON(value))
+		if err != nil {
+			return fmt.Sprintf("%s (JSON 인코딩 실패, %d바이트)", RedactedValue, len(body))
+		}
+		return r.truncate(string(redacted))
+
+	case strings.HasPrefix(contentType, "application/x-www-form-urlencoded"):
+		values, err := url.ParseQuery(string(trimmed))
+		if err != nil {
+			return fmt.Sprintf("%s (폼 해석 실패, %d바이트)", RedactedValue, len(body))
+		}
+		return r.truncate(r.redactForm(values))
+	}
+
+	return r.truncate(string(body))
+}
+
+// redactJSON은 JSON 값에서 민감한 키의 값을 가립니다. 중첩된 객체와 배열도 확인합니다.
+func (r *Redactor) redactJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if r.IsSensitiveKey(key) {
+				v[key] = RedactedValue
+			} else {
+				v[key] = r.redactJSON(item)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = r.redactJSON(item)
+		}
+	}
+	return value
+}
+
+// redactForm은 폼 값에서 민감한 키의 값을 가리고 키 순서로 나열합니다.
+func (r *Redactor) redactForm(values url.Values) string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var pairs []string
+	for _, key := range keys {
+		for _, value := range values[key] {
+			if r.IsSensitiveKey(key) {
+				value = RedactedValue
+			}
+			pairs = append(pairs, key+"="+value)
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// Headers는 헤더를 로그에 기록할 JSON 문자열로 만듭니다.
+// Authorization은 인증 방식(예: Bearer)만 남기고, 쿠키는 이름만 남기며, 그 밖의 민감한 헤더는 값을 가립니다.
+func (r *Redactor) Headers(header http.Header) string {
+	if len(header) == 0 {
+		return ""
+	}
+
+	redacted := make(map[string]string, len(header))
+	for name, values := range header {
+		masked := make([]string, 0, len(values))
+		for _, value := range values {
+			switch http.CanonicalHeaderKey(name) {
+			case "Authorization", "Proxy-Authorization":
+				value = maskAuthorization(value)
+			case "Cookie":
+				value = maskCookies(value)
+			case "Set-Cookie":
+				value = maskSetCookie(value)
+			default:
+				if r.IsSensitiveKey(name) {
+					value = RedactedValue
+				}
+			}
+			masked = append(masked, value)
+		}
+		redacted[name] = strings.Join(masked, ", ")
+	}
+
+	data, err := json.Marshal(redacted)
+	if err != nil {
+		return RedactedValue
+	}
+	return r.truncate(string(data))
+}
+
+// maskAuthorization은 "Bearer bsk_..." 같은 값에서 인증 방식만 남깁니다.
+func maskAuthorization(value string) string {
+	if scheme, _, ok := strings.Cut(strings.TrimSpace(value), " "); ok {
+		return scheme + " " + RedactedValue
+	}
+	return RedactedValue
+}
+
+// maskCookies는 Cookie 헤더의 쿠키 값을 모두 가리고 이름만 남깁니다.
+func maskCookies(value string) string {
+	cookies := strings.Split(value, ";")
+	for i, cookie := range cookies {
+		name, _, _ := strings.Cut(strings.TrimSpace(cookie), "=")
+		cookies[i] = name + "=" + RedactedValue
+	}
+	return strings.Join(cookies, "; ")
+}
+
+// maskSetCookie는 Set-Cookie 헤더의 쿠키 값을 가리고 이름과 속성(Path, Expires 등)만 남깁니다.
+func maskSetCookie(value string) string {
+	cookie, attributes, _ := strings.Cut(value, ";")
+	name, _, _ := strings.Cut(strings.TrimSpace(cookie), "=")
+	if attributes != "" {
+		return name + "=" + RedactedValue + ";" + attributes
+	}
+	return name + "=" + RedactedValue
+}
+
+// truncate는 값을 최대 바디 크기에 맞게 자릅니다. UTF-8 문자 중간에서는 자르지 않습니다.
+func (r *Redactor) truncate(value string) string {
+	if len(value) <= r.maxBodySize {
+		return value
+	}
+
+	cut := r.maxBodySize
+	for cut > 0 && value[cut]&0xC0 == 0x80 {
+		cut--
+	}
+	return fmt.Sprintf("%s...(truncated, %d바이트)", value[:cut], len(value))
+}
@@ -1,21 +1,86 @@
 package middleware
 
 import (
+	"bumsiku/internal/handler"
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+	"bumsiku/internal/utils"
 	"net/http"
 
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
 )
 
-func SessionAuthMiddleware() gin.HandlerFunc {
+// 인증된 요청의 gin 컨텍스트 키
+const (
+	ContextKeyUsername = "username" // 로그인한 관리자 사용자 이름
+	ContextKeyRole     = "role"     // 로그인한 관리자 역할
+)
+
+// SessionAuthMiddleware는 로그인 세션의 관리자 계정을 확인합니다.
+// 계정이 삭제되었거나 역할이 바뀐 경우를 반영하도록 요청마다 계정을 다시 조회하고,
+// 2단계 인증을 사용하는 계정은 TOTP 확인까지 마친 세션인지 확인한 뒤
+// 사용자 이름과 역할을 컨텍스트에 저장합니다.
+func SessionAuthMiddleware(userRepo repository.UserRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		session := sessions.Default(c)
-		admin := session.Get("admin")
-		if admin == nil || admin != true {
+		username, _ := session.Get("username").(string)
+		if username == "" {
+			c.JSON(http.StatusUnauthorized, gin.H{"status": "unauthorized"})
+			c.Abort()
+			return
+		}
+
+		user, err := userRepo.GetUser(c.Request.Context(), username)
+		if err != nil {
+			handler.SendInternalServerErrorWithLogging(c, logger, "계정 확인에 실패했습니다", err, map[string]string{
+				"middleware": "SessionAuth",
+				"username":   username,
+			})
+			c.Abort()
+			return
+		}
+		if user == nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"status": "unauthorized"})
+			c.Abort()
+			return
+		}
+
+		// 2단계 인증을 사용하는 계정은 로그인할 때 TOTP 확인을 마친 세션만 허용
+		if verified, _ := session.Get(handler.SessionKeyTOTPVerified).(bool); user.TOTPEnabled && !verified {
 			c.JSON(http.StatusUnauthorized, gin.H{"status": "unauthorized"})
 			c.Abort()
 			return
 		}
+
+		c.Set(ContextKeyUsername, user.Username)
+		c.Set(ContextKeyRole, user.Role)
+		c.Next()
+	}
+}
+
+// RequireRole은 로그인한 관리자의 역할이 roles 중 하나인지 확인합니다. owner는 항상 허용합니다.
+// SessionAuthMiddleware 뒤에 사용합니다.
+func RequireRole(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !HasRole(c.GetString(ContextKeyRole), roles...) {
+			handler.SendForbiddenError(c, "이 작업을 수행할 권한이 없습니다")
+			c.Abort()
+			return
+		}
 		c.Next()
 	}
 }
+
+// HasRole은 역할이 roles 중 하나이거나 owner인지 확인합니다.
+func HasRole(role string, roles ...string) bool {
+	if role == model.UserRoleOwner {
+		return true
+	}
+	for _, allowed := range roles {
+		if role == allowed {
+			return true
+		}
+	}
+	return false
+}
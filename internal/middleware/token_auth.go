@@ -0,0 +1,128 @@
+package middleware
+
+import (
+	"bumsiku/internal/auth"
+	"bumsiku/internal/handler"
+	"bumsiku/internal/repository"
+	"bumsiku/internal/utils"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// API 토큰으로 인증된 요청의 gin 컨텍스트 키
+const (
+	ContextKeyTokenID     = "tokenId"     // 사용한 API 토큰 ID
+	ContextKeyTokenScopes = "tokenScopes" // API 토큰의 권한 범위
+)
+
+// apiTokenTouchInterval은 토큰의 마지막 사용 시간을 다시 기록하기까지의 최소 간격입니다.
+// 요청마다 저장소에 쓰지 않도록 간격 안의 사용은 기록하지 않습니다.
+const apiTokenTouchInterval = time.Minute
+
+// AuthMiddleware는 Authorization: Bearer 헤더가 있으면 API 토큰으로, 없으면 로그인 세션으로 관리자를 인증합니다.
+func AuthMiddleware(userRepo repository.UserRepositoryInterface, tokenRepo repository.APITokenRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
+	sessionAuth := SessionAuthMiddleware(userRepo, logger)
+	tokenAuth := TokenAuthMiddleware(userRepo, tokenRepo, logger)
+
+	return func(c *gin.Context) {
+		if _, ok := bearerToken(c); ok {
+			tokenAuth(c)
+			return
+		}
+		sessionAuth(c)
+	}
+}
+
+// TokenAuthMiddleware는 Authorization: Bearer 헤더의 API 토큰을 확인합니다.
+// 토큰을 발급한 계정의 사용자 이름과 역할, 토큰의 권한 범위를 컨텍스트에 저장합니다.
+func TokenAuthMiddleware(userRepo repository.UserRepositoryInterface, tokenRepo repository.APITokenRepositoryInterface, logger *utils.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		raw, _ := bearerToken(c)
+		now := time.Now()
+
+		token, user, err := auth.AuthenticateAPIToken(c.Request.Context(), tokenRepo, userRepo, raw, now)
+		if err != nil {
+			handler.SendInternalServerErrorWithLogging(c, logger, "API 토큰 확인에 실패했습니다", err, map[string]string{
+				"middleware": "TokenAuth",
+			})
+			c.Abort()
+			return
+		}
+		if token == nil {
+			c.Header("WWW-Authenticate", `Bearer error="invalid_token"`)
+			handler.SendUnauthorizedError(c, "유효하지 않거나 만료된 API 토큰입니다")
+			c.Abort()
+			return
+		}
+
+		if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) >= apiTokenTouchInterval {
+			if err := tokenRepo.TouchToken(c.Request.Context(), token.TokenID, now); err != nil {
+				logger.Warn(c.Request.Context(), "API 토큰 사용 시간 기록 실패", map[string]string{
+					"middleware": "TokenAuth",
+					"tokenId":    token.TokenID,
+					"error":      err.Error(),
+				})
+			}
+		}
+
+		c.Set(ContextKeyUsername, user.Username)
+		c.Set(ContextKeyRole, user.Role)
+		c.Set(ContextKeyTokenID, token.TokenID)
+		c.Set(ContextKeyTokenScopes, token.Scopes)
+		c.Next()
+	}
+}
+
+// RequireScope는 API 토큰으로 인증된 요청의 토큰에 scope가 포함되어 있는지 확인합니다.
+// 로그인 세션으로 인증된 요청은 역할로만 권한을 확인하므로 그대로 통과합니다.
+func RequireScope(scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		scopes, isToken := tokenScopes(c)
+		if !isToken {
+			c.Next()
+			return
+		}
+		for _, s := range scopes {
+			if s == scope {
+				c.Next()
+				return
+			}
+		}
+
+		handler.SendForbiddenError(c, fmt.Sprintf("API 토큰에 이 작업의 권한 범위(%s)가 없습니다", scope))
+		c.Abort()
+	}
+}
+
+// SessionOnly는 API 토큰으로 인증된 요청을 거부합니다. 계정과 토큰 관리처럼 로그인 세션으로만 할 수 있는 작업에 사용합니다.
+func SessionOnly() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, isToken := tokenScopes(c); isToken {
+			handler.SendForbiddenError(c, "API 토큰으로는 사용할 수 없는 기능입니다")
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// bearerToken은 Authorization 헤더의 Bearer 토큰을 반환합니다.
+func bearerToken(c *gin.Context) (string, bool) {
+	header := c.GetHeader("Authorization")
+	if len(header) < 7 || !strings.EqualFold(header[:7], "Bearer ") {
+		return "", false
+	}
+	return strings.TrimSpace(header[7:]), true
+}
+
+func tokenScopes(c *gin.Context) ([]string, bool) {
+	value, ok := c.Get(ContextKeyTokenScopes)
+	if !ok {
+		return nil, false
+	}
+	scopes, _ := value.([]string)
+	return scopes, true
+}
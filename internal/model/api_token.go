@@ -0,0 +1,61 @@
+package model
+
+import "time"
+
+// API 토큰 권한 범위. 토큰은 발급한 계정의 역할이 허용하는 작업 중 범위에 포함된 작업만 할 수 있습니다.
+const (
+	APITokenScopePostsRead     = "posts:read"     // 관리자 게시글 목록, 리비전 조회
+	APITokenScopePostsWrite    = "posts:write"    // 게시글 작성, 수정, 발행 상태 변경, 삭제
+	APITokenScopeImagesWrite   = "images:write"   // 이미지 업로드
+	APITokenScopeCommentsRead  = "comments:read"  // 관리자 댓글 목록 조회
+	APITokenScopeCommentsWrite = "comments:write" // 댓글 검토와 삭제
+	APITokenScopeTaxonomyWrite = "taxonomy:write" // 카테고리, 태그 수정
+)
+
+// APITokenScopes는 지원하는 API 토큰 권한 범위 목록입니다.
+var APITokenScopes = []string{
+	APITokenScopePostsRead,
+	APITokenScopePostsWrite,
+	APITokenScopeImagesWrite,
+	APITokenScopeCommentsRead,
+	APITokenScopeCommentsWrite,
+	APITokenScopeTaxonomyWrite,
+}
+
+// IsValidAPITokenScope는 지원하는 권한 범위인지 확인합니다.
+func IsValidAPITokenScope(scope string) bool {
+	for _, s := range APITokenScopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// APIToken은 자동화와 CI 배포에 사용하는 개인 API 토큰입니다. Partition Key로 tokenId를 사용합니다.
+// 토큰 값은 발급할 때 한 번만 보여주고 해시만 저장합니다.
+type APIToken struct {
+	TokenID    string     `json:"tokenId" dynamodbav:"tokenId" example:"3f2a9c1d7e5b4a60"`                               // 토큰 ID (토큰 값에 포함)
+	Username   string     `json:"username" dynamodbav:"username" example:"editor1"`                                      // 발급한 계정
+	Name       string     `json:"name" dynamodbav:"name" example:"GitHub Actions 배포"`                                    // 토큰 이름 (용도)
+	Scopes     []string   `json:"scopes" dynamodbav:"scopes" example:"posts:write,images:write"`                         // 권한 범위
+	TokenHash  string     `json:"-" dynamodbav:"tokenHash"`                                                              // 토큰 값의 해시
+	CreatedAt  time.Time  `json:"createdAt" dynamodbav:"createdAt" example:"2023-01-01T00:00:00Z"`                       // 발급 시간
+	ExpiresAt  time.Time  `json:"expiresAt" dynamodbav:"expiresAt" example:"2023-04-01T00:00:00Z"`                       // 만료 시간
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty" dynamodbav:"lastUsedAt,omitempty" example:"2023-01-02T00:00:00Z"` // 마지막 사용 시간
+}
+
+// HasScope는 토큰에 권한 범위가 포함되어 있는지 확인합니다.
+func (t *APIToken) HasScope(scope string) bool {
+	for _, s := range t.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// IsExpired는 now 기준으로 토큰이 만료되었는지 확인합니다.
+func (t *APIToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
@@ -2,11 +2,90 @@ package model
 
 import "time"
 
+// 댓글 검토 상태
+const (
+	CommentStatusPending  = "pending"  // 검토 대기 (비공개)
+	CommentStatusApproved = "approved" // 승인 (공개)
+	CommentStatusRejected = "rejected" // 거절 (비공개)
+	CommentStatusSpam     = "spam"     // 스팸 (비공개)
+)
+
+// IsValidCommentStatus는 지원하는 댓글 상태인지 확인합니다.
+func IsValidCommentStatus(status string) bool {
+	switch status {
+	case CommentStatusPending, CommentStatusApproved, CommentStatusRejected, CommentStatusSpam:
+		return true
+	}
+	return false
+}
+
+// MaxCommentDepth는 최상위 댓글을 포함한 댓글 중첩 단계 수입니다. 최상위 댓글의 Depth는 0입니다.
+const MaxCommentDepth = 3
+
 // Comment는 Partition Key로 postId, Sort Key로 commentId를 사용합니다.
 type Comment struct {
-	CommentID string    `json:"commentId" dynamodbav:"commentId" example:"comment-123"`          // 댓글 ID
-	PostID    string    `json:"postId" dynamodbav:"postId" example:"post-123"`                   // 게시물 ID
-	Nickname  string    `json:"nickname" dynamodbav:"nickname" example:"익명사용자"`                  // 닉네임
-	Content   string    `json:"content" dynamodbav:"content" example:"댓글 내용입니다."`                // 댓글 내용
-	CreatedAt time.Time `json:"createdAt" dynamodbav:"createdAt" example:"2023-01-01T00:00:00Z"` // 생성 시간
+	CommentID       string    `json:"commentId" dynamodbav:"commentId" example:"comment-123"`                                 // 댓글 ID
+	PostID          string    `json:"postId" dynamodbav:"postId" example:"post-123"`                                          // 게시물 ID
+	ParentCommentID string    `json:"parentCommentId,omitempty" dynamodbav:"parentCommentId,omitempty" example:"comment-100"` // 부모 댓글 ID (답글인 경우)
+	Depth           int       `json:"depth" dynamodbav:"depth" example:"1"`                                                   // 중첩 단계 (최상위 댓글은 0)
+	Nickname        string    `json:"nickname" dynamodbav:"nickname" example:"익명사용자"`                                         // 닉네임
+	Content         string    `json:"content" dynamodbav:"content" example:"댓글 내용입니다."`                                       // 댓글 내용
+	Deleted         bool      `json:"deleted,omitempty" dynamodbav:"deleted,omitempty" example:"false"`                       // 답글이 있어 내용만 지운 댓글 여부
+	Status          string    `json:"status" dynamodbav:"status" example:"approved"`                                          // 검토 상태 (pending, approved, rejected, spam)
+	Moderated       bool      `json:"moderated,omitempty" dynamodbav:"moderated,omitempty" example:"true"`                    // 관리자가 검토 상태를 지정했는지 여부 (스팸 필터 판정만 받은 댓글은 false)
+	CreatedAt       time.Time `json:"createdAt" dynamodbav:"createdAt" example:"2023-01-01T00:00:00Z"`                        // 생성 시간
+}
+
+// IsApproved는 댓글이 공개 상태인지 확인합니다.
+// 검토 상태 도입 이전에 작성된 댓글(빈 상태)은 승인된 것으로 간주합니다.
+func (c *Comment) IsApproved() bool {
+	return c.Status == CommentStatusApproved || c.Status == ""
+}
+
+// Tombstone은 답글이 남아 있는 댓글을 삭제하거나 공개하지 않을 때 작성자와 내용을 지운 댓글을 반환합니다.
+func (c Comment) Tombstone() Comment {
+	c.Nickname = ""
+	c.Content = ""
+	c.Deleted = true
+	return c
+}
+
+// CommentThread는 댓글과 그 답글 트리입니다.
+type CommentThread struct {
+	Comment
+	Replies []CommentThread `json:"replies"` // 등록순 답글
+}
+
+// BuildCommentThreads는 등록순 댓글 목록을 트리로 변환합니다.
+// 부모가 목록에 없는 답글은 최상위에 두고, 답글이 모두 삭제된 삭제 표시 댓글은 제외합니다.
+func BuildCommentThreads(comments []Comment) []CommentThread {
+	exists := make(map[string]bool, len(comments))
+	for _, comment := range comments {
+		exists[comment.CommentID] = true
+	}
+
+	children := make(map[string][]Comment)
+	var roots []Comment
+	for _, comment := range comments {
+		if comment.ParentCommentID != "" && exists[comment.ParentCommentID] && comment.ParentCommentID != comment.CommentID {
+			children[comment.ParentCommentID] = append(children[comment.ParentCommentID], comment)
+		} else {
+			roots = append(roots, comment)
+		}
+	}
+
+	var build func(list []Comment) []CommentThread
+	build = func(list []Comment) []CommentThread {
+		threads := make([]CommentThread, 0, len(list))
+		for _, comment := range list {
+			replies := build(children[comment.CommentID])
+			if comment.Deleted && len(replies) == 0 {
+				continue
+			}
+			threads = append(threads, CommentThread{Comment: comment, Replies: replies})
+		}
+		return threads
+	}
+
+	return build(roots)
 }
@@ -2,14 +2,44 @@ package model
 
 import "time"
 
+// 게시글 공개 상태
+const (
+	PostStatusDraft     = "draft"     // 작성 중 (비공개)
+	PostStatusScheduled = "scheduled" // 예약 발행 대기 (publishAt 이후 공개)
+	PostStatusPublished = "published" // 공개
+	PostStatusArchived  = "archived"  // 보관 (비공개)
+)
+
+// IsValidPostStatus는 지원하는 게시글 상태인지 확인합니다.
+func IsValidPostStatus(status string) bool {
+	switch status {
+	case PostStatusDraft, PostStatusScheduled, PostStatusPublished, PostStatusArchived:
+		return true
+	}
+	return false
+}
+
 // Post는 블로그 게시물 정보를 담는 구조체입니다. Partition Key로 postId, Sort Key로 createdAt을 사용합니다.
-// GSI: categoryId, Sort Key: createdAt
+// GSI: category-index(category, createdAt), category-updated-index(category, updatedAt)
+// GSI: feed-index(feedKey, createdAt), feed-updated-index(feedKey, updatedAt)
 type Post struct {
-	PostID    string    `json:"postId" dynamodbav:"postId" example:"post-123"`                   // 게시물 ID
-	Title     string    `json:"title" dynamodbav:"title" example:"블로그 제목"`                       // 게시물 제목
-	CreatedAt time.Time `json:"createdAt" dynamodbav:"createdAt" example:"2023-01-01T00:00:00Z"` // 생성 시간
-	UpdatedAt time.Time `json:"updatedAt" dynamodbav:"updatedAt" example:"2023-01-01T00:00:00Z"` // 수정 시간
-	Content   string    `json:"content" dynamodbav:"content" example:"게시물 본문 내용..."`             // 게시물 내용
-	Summary   string    `json:"summary" dynamodbav:"summary" example:"게시물 요약..."`                // 게시물 요약
-	Category  string    `json:"category" dynamodbav:"category" example:"technology"`             // 카테고리
+	PostID    string     `json:"postId" dynamodbav:"postId" example:"post-123"`                                       // 게시물 ID
+	Title     string     `json:"title" dynamodbav:"title" example:"블로그 제목"`                                           // 게시물 제목
+	CreatedAt time.Time  `json:"createdAt" dynamodbav:"createdAt" example:"2023-01-01T00:00:00Z"`                     // 생성 시간
+	UpdatedAt time.Time  `json:"updatedAt" dynamodbav:"updatedAt" example:"2023-01-01T00:00:00Z"`                     // 수정 시간
+	Content   string     `json:"content" dynamodbav:"content" example:"게시물 본문 내용..."`                                 // 게시물 내용
+	Summary   string     `json:"summary" dynamodbav:"summary" example:"게시물 요약..."`                                    // 게시물 요약
+	Category  string     `json:"category" dynamodbav:"category" example:"technology"`                                 // 카테고리
+	Tags      []string   `json:"tags,omitempty" dynamodbav:"tags,omitempty" example:"golang,concurrency"`             // 태그 (정규화된 소문자)
+	Status    string     `json:"status" dynamodbav:"status" example:"published"`                                      // 공개 상태 (draft, scheduled, published, archived)
+	PublishAt *time.Time `json:"publishAt,omitempty" dynamodbav:"publishAt,omitempty" example:"2023-01-01T00:00:00Z"` // 발행(예정) 시간
+	Version   int64      `json:"version" dynamodbav:"version" example:"3"`                                            // 수정할 때마다 1씩 증가하는 버전 (ETag)
+	AuthorID  string     `json:"authorId,omitempty" dynamodbav:"authorId,omitempty" example:"editor1"`                // 작성한 관리자 사용자 이름
+	FeedKey   string     `json:"-" dynamodbav:"feedKey,omitempty"`                                                    // 시간순 피드 인덱스용 고정 파티션 키
+}
+
+// IsPublished는 게시글이 공개 상태인지 확인합니다.
+// 상태 필드 도입 이전에 작성된 게시글(빈 상태)은 공개로 간주합니다.
+func (p *Post) IsPublished() bool {
+	return p.Status == PostStatusPublished || p.Status == ""
 }
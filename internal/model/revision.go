@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// PostRevision은 게시글이 저장된 시점의 전체 스냅샷입니다. Partition Key로 postId, Sort Key로 revision을 사용합니다.
+// 한 번 저장된 리비전은 수정하지 않습니다.
+type PostRevision struct {
+	PostID       string    `json:"postId" dynamodbav:"postId" example:"post-123"`                          // 게시물 ID
+	Revision     int       `json:"revision" dynamodbav:"revision" example:"3"`                             // 리비전 번호 (게시글별 1부터 증가)
+	Title        string    `json:"title" dynamodbav:"title" example:"블로그 제목"`                              // 게시물 제목
+	Content      string    `json:"content,omitempty" dynamodbav:"content" example:"게시물 본문 내용..."`          // 게시물 내용 (목록 조회에서는 생략)
+	Summary      string    `json:"summary" dynamodbav:"summary" example:"게시물 요약..."`                       // 게시물 요약
+	Category     string    `json:"category" dynamodbav:"category" example:"technology"`                    // 카테고리
+	Editor       string    `json:"editor" dynamodbav:"editor" example:"admin"`                             // 저장한 관리자 (기록 이전 원본이면 빈 값)
+	RestoredFrom int       `json:"restoredFrom,omitempty" dynamodbav:"restoredFrom,omitempty" example:"1"` // 복원으로 생성된 경우 원본 리비전 번호
+	CreatedAt    time.Time `json:"createdAt" dynamodbav:"createdAt" example:"2023-01-01T00:00:00Z"`        // 저장 시간
+}
@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// Session은 서버에 저장한 로그인 세션입니다. Partition Key로 sessionId를 사용합니다.
+// 쿠키에는 임의의 세션 토큰을 저장하고, 세션 ID는 토큰의 해시이므로 세션 목록에 노출해도 세션을 가로챌 수 없습니다.
+type Session struct {
+	SessionID  string    `json:"sessionId" dynamodbav:"sessionId" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"` // 세션 ID (세션 토큰의 해시)
+	Username   string    `json:"username" dynamodbav:"username" example:"editor1"`                                                            // 로그인한 계정 (2단계 인증 대기 중이면 빈 값)
+	IP         string    `json:"ip" dynamodbav:"ip" example:"203.0.113.10"`                                                                   // 로그인한 클라이언트 IP
+	UserAgent  string    `json:"userAgent" dynamodbav:"userAgent" example:"Mozilla/5.0"`                                                      // 로그인한 브라우저
+	LoginTime  time.Time `json:"loginTime" dynamodbav:"loginTime" example:"2023-01-01T00:00:00Z"`                                             // 로그인 시간
+	LastSeenAt time.Time `json:"lastSeenAt" dynamodbav:"lastSeenAt" example:"2023-01-01T01:00:00Z"`                                           // 마지막 사용 시간
+	ExpiresAt  time.Time `json:"expiresAt" dynamodbav:"expiresAt" example:"2023-01-01T03:00:00Z"`                                             // 만료 시간 (사용할 때마다 연장)
+	Data       []byte    `json:"-" dynamodbav:"data"`                                                                                         // 세션 값 (gob 인코딩)
+}
+
+// IsExpired는 now 기준으로 세션이 만료되었는지 확인합니다.
+func (s *Session) IsExpired(now time.Time) bool {
+	return !now.Before(s.ExpiresAt)
+}
@@ -0,0 +1,65 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// 태그 제한
+const (
+	MaxTagsPerPost = 10 // 게시글당 최대 태그 수
+	MaxTagLength   = 30 // 태그 최대 글자 수
+)
+
+// Tag는 태그와 해당 태그가 달린 발행된 게시글 수입니다.
+type Tag struct {
+	Tag   string `json:"tag" example:"golang"` // 태그
+	Count int    `json:"count" example:"12"`   // 발행된 게시글 수
+}
+
+// NormalizeTag는 태그를 저장 형식으로 변환합니다.
+// 앞뒤 공백을 제거하고 소문자로 바꾸며, 중간 공백은 '-'로 바꿉니다.
+func NormalizeTag(tag string) string {
+	return strings.ToLower(strings.Join(strings.Fields(tag), "-"))
+}
+
+// NormalizeTags는 태그 목록을 정규화하고 중복을 제거합니다. 입력 순서는 유지합니다.
+// 글자, 숫자와 '-', '_', '.', '+', '#' 이외의 문자가 있거나 제한을 넘으면 오류를 반환합니다.
+func NormalizeTags(tags []string) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, raw := range tags {
+		tag := NormalizeTag(raw)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		if err := ValidateTag(tag); err != nil {
+			return nil, err
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+
+	if len(normalized) > MaxTagsPerPost {
+		return nil, fmt.Errorf("태그는 최대 %d개까지 지정할 수 있습니다", MaxTagsPerPost)
+	}
+	return normalized, nil
+}
+
+// ValidateTag는 정규화된 태그가 URL 경로에 쓸 수 있는 형식인지 확인합니다.
+func ValidateTag(tag string) error {
+	if tag == "" {
+		return fmt.Errorf("태그가 비어 있습니다")
+	}
+	if utf8.RuneCountInString(tag) > MaxTagLength {
+		return fmt.Errorf("태그는 %d자 이하로 입력해 주세요: %s", MaxTagLength, tag)
+	}
+	for _, r := range tag {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("-_.+#", r) {
+			return fmt.Errorf("태그에 사용할 수 없는 문자가 있습니다: %s", tag)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"regexp"
+	"time"
+)
+
+// 관리자 역할
+const (
+	UserRoleOwner     = "owner"     // 모든 권한 (사용자 관리 포함)
+	UserRoleEditor    = "editor"    // 게시글, 카테고리, 태그, 이미지, 댓글 관리
+	UserRoleModerator = "moderator" // 댓글 검토와 삭제
+)
+
+// IsValidUserRole은 지원하는 역할인지 확인합니다.
+func IsValidUserRole(role string) bool {
+	switch role {
+	case UserRoleOwner, UserRoleEditor, UserRoleModerator:
+		return true
+	}
+	return false
+}
+
+// usernamePattern은 사용자 이름 규칙입니다. 영문 소문자로 시작하는 3~32자의 영문 소문자, 숫자, '-', '_', '.'입니다.
+var usernamePattern = regexp.MustCompile(`^[a-z][a-z0-9._-]{2,31}$`)
+
+// IsValidUsername은 사용자 이름 규칙에 맞는지 확인합니다.
+func IsValidUsername(username string) bool {
+	return usernamePattern.MatchString(username)
+}
+
+// User는 관리자 계정입니다. Partition Key로 username을 사용합니다.
+type User struct {
+	Username      string    `json:"username" dynamodbav:"username" example:"editor1"`                // 사용자 이름 (로그인 ID)
+	PasswordHash  string    `json:"-" dynamodbav:"passwordHash"`                                     // 비밀번호 해시 (argon2id 또는 bcrypt)
+	Role          string    `json:"role" dynamodbav:"role" example:"editor"`                         // 역할 (owner, editor, moderator)
+	TOTPEnabled   bool      `json:"totpEnabled" dynamodbav:"totpEnabled" example:"false"`            // 2단계 인증(TOTP) 사용 여부
+	TOTPSecret    string    `json:"-" dynamodbav:"totpSecret,omitempty"`                             // TOTP 비밀 키 (base32, 등록 확인 전에도 저장)
+	TOTPLastStep  int64     `json:"-" dynamodbav:"totpLastStep,omitempty"`                           // 마지막으로 사용한 TOTP 시간 단계 (코드 재사용 방지)
+	RecoveryCodes []string  `json:"-" dynamodbav:"recoveryCodes,omitempty"`                          // 사용하지 않은 복구 코드의 해시
+	CreatedAt     time.Time `json:"createdAt" dynamodbav:"createdAt" example:"2023-01-01T00:00:00Z"` // 생성 시간
+	UpdatedAt     time.Time `json:"updatedAt" dynamodbav:"updatedAt" example:"2023-01-01T00:00:00Z"` // 수정 시간
+}
@@ -0,0 +1,215 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"bumsiku/internal/model"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// APITokenTableName은 API 토큰 테이블입니다. Partition Key로 tokenId를 사용합니다.
+const APITokenTableName = "blog_api_tokens"
+
+type APITokenRepositoryInterface interface {
+	// GetTokens는 계정이 발급한 토큰을 발급 시간순으로 반환합니다.
+	GetTokens(ctx context.Context, username string) ([]model.APIToken, error)
+	// GetToken은 토큰을 조회합니다. 없으면 nil을 반환합니다.
+	GetToken(ctx context.Context, tokenID string) (*model.APIToken, error)
+	// CreateToken은 토큰을 저장합니다.
+	CreateToken(ctx context.Context, token *model.APIToken) error
+	// DeleteToken은 토큰을 삭제합니다. 토큰이 없으면 APITokenNotFoundError를 반환합니다.
+	DeleteToken(ctx context.Context, tokenID string) error
+	// DeleteTokensByUsername은 계정이 발급한 토큰을 모두 삭제합니다.
+	DeleteTokensByUsername(ctx context.Context, username string) error
+	// TouchToken은 토큰의 마지막 사용 시간을 기록합니다. 토큰이 없으면 APITokenNotFoundError를 반환합니다.
+	TouchToken(ctx context.Context, tokenID string, usedAt time.Time) error
+}
+
+type APITokenRepository struct {
+	client *dynamodb.Client
+}
+
+func NewAPITokenRepository(client *dynamodb.Client) *APITokenRepository {
+	return &APITokenRepository{client: client}
+}
+
+// GetTokens는 토큰 수가 적으므로 username 조건으로 테이블을 스캔합니다.
+func (r *APITokenRepository) GetTokens(ctx context.Context, username string) ([]model.APIToken, error) {
+	expr, err := expression.NewBuilder().
+		WithFilter(expression.Name("username").Equal(expression.Value(username))).
+		Build()
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]model.APIToken, 0)
+	paginator := dynamodb.NewScanPaginator(r.client, &dynamodb.ScanInput{
+		TableName:                 aws.String(APITokenTableName),
+		FilterExpression:          expr.Filter(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		var items []model.APIToken
+		if err := attributevalue.UnmarshalListOfMaps(page.Items, &items); err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, items...)
+	}
+
+	sortAPITokens(tokens)
+	return tokens, nil
+}
+
+func (r *APITokenRepository) GetToken(ctx context.Context, tokenID string) (*model.APIToken, error) {
+	result, err := r.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(APITokenTableName),
+		Key:       apiTokenKey(tokenID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+
+	var token model.APIToken
+	if err := attributevalue.UnmarshalMap(result.Item, &token); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (r *APITokenRepository) CreateToken(ctx context.Context, token *model.APIToken) error {
+	if token.CreatedAt.IsZero() {
+		token.CreatedAt = time.Now()
+	}
+
+	item, err := attributevalue.MarshalMap(token)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(APITokenTableName),
+		Item:      item,
+	})
+	return err
+}
+
+func (r *APITokenRepository) DeleteToken(ctx context.Context, tokenID string) error {
+	expr, err := expression.NewBuilder().
+		WithCondition(expression.AttributeExists(expression.Name("tokenId"))).
+		Build()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName:                aws.String(APITokenTableName),
+		Key:                      apiTokenKey(tokenID),
+		ConditionExpression:      expr.Condition(),
+		ExpressionAttributeNames: expr.Names(),
+	})
+
+	var conditionErr *types.ConditionalCheckFailedException
+	if errors.As(err, &conditionErr) {
+		return &APITokenNotFoundError{TokenID: tokenID}
+	}
+	return err
+}
+
+func (r *APITokenRepository) DeleteTokensByUsername(ctx context.Context, username string) error {
+	tokens, err := r.GetTokens(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	var writeRequests []types.WriteRequest
+	for _, token := range tokens {
+		writeRequests = append(writeRequests, types.WriteRequest{
+			DeleteRequest: &types.DeleteRequest{Key: apiTokenKey(token.TokenID)},
+		})
+	}
+
+	// BatchWriteItem은 한 번에 최대 25개 항목만 처리할 수 있으므로 나누어 처리
+	for i := 0; i < len(writeRequests); i += 25 {
+		end := i + 25
+		if end > len(writeRequests) {
+			end = len(writeRequests)
+		}
+
+		_, err := r.client.BatchWriteItem(ctx, &dynamodb.BatchWriteItemInput{
+			RequestItems: map[string][]types.WriteRequest{
+				APITokenTableName: writeRequests[i:end],
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *APITokenRepository) TouchToken(ctx context.Context, tokenID string, usedAt time.Time) error {
+	expr, err := expression.NewBuilder().
+		WithUpdate(expression.Set(expression.Name("lastUsedAt"), expression.Value(usedAt))).
+		WithCondition(expression.AttributeExists(expression.Name("tokenId"))).
+		Build()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:                 aws.String(APITokenTableName),
+		Key:                       apiTokenKey(tokenID),
+		ConditionExpression:       expr.Condition(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		UpdateExpression:          expr.Update(),
+	})
+
+	var conditionErr *types.ConditionalCheckFailedException
+	if errors.As(err, &conditionErr) {
+		return &APITokenNotFoundError{TokenID: tokenID}
+	}
+	return err
+}
+
+func apiTokenKey(tokenID string) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"tokenId": &types.AttributeValueMemberS{Value: tokenID},
+	}
+}
+
+func sortAPITokens(tokens []model.APIToken) {
+	sort.Slice(tokens, func(i, j int) bool {
+		if tokens[i].CreatedAt.Equal(tokens[j].CreatedAt) {
+			return tokens[i].TokenID < tokens[j].TokenID
+		}
+		return tokens[i].CreatedAt.Before(tokens[j].CreatedAt)
+	})
+}
+
+// APITokenNotFoundError는 API 토큰을 찾을 수 없을 때 발생하는 오류입니다.
+type APITokenNotFoundError struct {
+	TokenID string
+}
+
+func (e *APITokenNotFoundError) Error() string {
+	return "API 토큰을 찾을 수 없음: " + e.TokenID
+}
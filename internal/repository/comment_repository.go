@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"sort"
 	"time"
 
 	"bumsiku/internal/model"
@@ -17,10 +19,17 @@ import (
 const CommentTableName = "blog_comments"
 
 type CommentRepositoryInterface interface {
+	// GetComments는 댓글을 등록순으로 반환합니다. 답글이 남아 있어 삭제 표시된 댓글도 포함합니다.
 	GetComments(ctx context.Context, input *GetCommentsInput) ([]model.Comment, error)
+	// CreateComment는 댓글을 생성합니다. 상태가 비어 있으면 승인 상태로 저장합니다.
 	CreateComment(ctx context.Context, comment *model.Comment) (*model.Comment, error)
 	DeleteCommentsByPostID(ctx context.Context, postID string) error
+	// DeleteComment는 답글이 있는 댓글은 삭제 표시(model.Comment.Tombstone)만 하고, 없으면 삭제합니다.
+	// 삭제로 답글이 모두 없어진 삭제 표시 상위 댓글도 함께 삭제합니다.
 	DeleteComment(ctx context.Context, commentID string) error
+	// SetCommentStatus는 관리자의 검토 결과로 댓글들의 상태를 변경하고 변경한 댓글 ID를 반환합니다. 없는 댓글은 건너뜁니다.
+	// 변경한 댓글은 Moderated로 표시합니다.
+	SetCommentStatus(ctx context.Context, commentIDs []string, status string) ([]string, error)
 }
 
 type CommentRepository struct {
@@ -33,6 +42,7 @@ func NewCommentRepository(client *dynamodb.Client) *CommentRepository {
 
 type GetCommentsInput struct {
 	PostID *string
+	Status string // 검토 상태 필터 (빈 문자열이면 전체)
 }
 
 func (r *CommentRepository) GetComments(ctx context.Context, input *GetCommentsInput) ([]model.Comment, error) {
@@ -51,7 +61,17 @@ func (r *CommentRepository) GetComments(ctx context.Context, input *GetCommentsI
 		return nil, err
 	}
 
-	return comments, nil
+	if input.Status == "" {
+		return comments, nil
+	}
+
+	filtered := make([]model.Comment, 0, len(comments))
+	for _, comment := range comments {
+		if comment.Status == input.Status {
+			filtered = append(filtered, comment)
+		}
+	}
+	return filtered, nil
 }
 
 // 특정 게시글의 댓글 조회
@@ -62,45 +82,74 @@ func (r *CommentRepository) getCommentsByPostID(ctx context.Context, postID stri
 		return nil, err
 	}
 
-	result, err := r.client.Query(ctx, &dynamodb.QueryInput{
-		TableName:                 aws.String(CommentTableName),
-		KeyConditionExpression:    expr.KeyCondition(),
-		ExpressionAttributeNames:  expr.Names(),
-		ExpressionAttributeValues: expr.Values(),
-		ScanIndexForward:          aws.Bool(true), // 등록순 정렬
-	})
-
-	if err != nil {
-		return nil, err
-	}
+	var items []map[string]types.AttributeValue
+	var startKey map[string]types.AttributeValue
+	for {
+		result, err := r.client.Query(ctx, &dynamodb.QueryInput{
+			TableName:                 aws.String(CommentTableName),
+			KeyConditionExpression:    expr.KeyCondition(),
+			ExpressionAttributeNames:  expr.Names(),
+			ExpressionAttributeValues: expr.Values(),
+			ExclusiveStartKey:         startKey,
+		})
+		if err != nil {
+			return nil, err
+		}
 
-	// 결과 변환
-	comments := make([]model.Comment, 0)
-	err = attributevalue.UnmarshalListOfMaps(result.Items, &comments)
-	if err != nil {
-		return nil, err
+		items = append(items, result.Items...)
+		if len(result.LastEvaluatedKey) == 0 {
+			break
+		}
+		startKey = result.LastEvaluatedKey
 	}
 
-	return comments, nil
+	return unmarshalComments(items)
 }
 
 // 모든 댓글 조회
 func (r *CommentRepository) getAllComments(ctx context.Context) ([]model.Comment, error) {
-	result, err := r.client.Scan(ctx, &dynamodb.ScanInput{
-		TableName: aws.String(CommentTableName),
-	})
+	var items []map[string]types.AttributeValue
+	var startKey map[string]types.AttributeValue
+	for {
+		result, err := r.client.Scan(ctx, &dynamodb.ScanInput{
+			TableName:         aws.String(CommentTableName),
+			ExclusiveStartKey: startKey,
+		})
+		if err != nil {
+			return nil, err
+		}
 
-	if err != nil {
-		return nil, err
+		items = append(items, result.Items...)
+		if len(result.LastEvaluatedKey) == 0 {
+			break
+		}
+		startKey = result.LastEvaluatedKey
 	}
 
-	// 결과 변환
-	comments := make([]model.Comment, 0)
-	err = attributevalue.UnmarshalListOfMaps(result.Items, &comments)
-	if err != nil {
+	return unmarshalComments(items)
+}
+
+// unmarshalComments는 조회한 항목을 등록순 댓글로 변환합니다.
+// 정렬 키(commentId)는 무작위 ID이므로 작성 시간으로 다시 정렬하고,
+// 검토 상태 도입 이전에 작성된 댓글은 승인 상태로 간주합니다.
+func unmarshalComments(items []map[string]types.AttributeValue) ([]model.Comment, error) {
+	comments := make([]model.Comment, 0, len(items))
+	if err := attributevalue.UnmarshalListOfMaps(items, &comments); err != nil {
 		return nil, err
 	}
 
+	for i := range comments {
+		if comments[i].Status == "" {
+			comments[i].Status = model.CommentStatusApproved
+		}
+	}
+	sort.Slice(comments, func(i, j int) bool {
+		if !comments[i].CreatedAt.Equal(comments[j].CreatedAt) {
+			return comments[i].CreatedAt.Before(comments[j].CreatedAt)
+		}
+		return comments[i].CommentID < comments[j].CommentID
+	})
+
 	return comments, nil
 }
 
@@ -108,6 +157,9 @@ func (r *CommentRepository) getAllComments(ctx context.Context) ([]model.Comment
 func (r *CommentRepository) CreateComment(ctx context.Context, comment *model.Comment) (*model.Comment, error) {
 	comment.CommentID = uuid.New().String()
 	comment.CreatedAt = time.Now()
+	if comment.Status == "" {
+		comment.Status = model.CommentStatusApproved
+	}
 
 	// DynamoDB 아이템으로 변환
 	item, err := attributevalue.MarshalMap(comment)
@@ -144,12 +196,7 @@ func (r *CommentRepository) DeleteCommentsByPostID(ctx context.Context, postID s
 	var writeRequests []types.WriteRequest
 	for _, comment := range comments {
 		writeRequests = append(writeRequests, types.WriteRequest{
-			DeleteRequest: &types.DeleteRequest{
-				Key: map[string]types.AttributeValue{
-					"postId":    &types.AttributeValueMemberS{Value: comment.PostID},
-					"commentId": &types.AttributeValueMemberS{Value: comment.CommentID},
-				},
-			},
+			DeleteRequest: &types.DeleteRequest{Key: commentKey(comment)},
 		})
 	}
 
@@ -185,7 +232,46 @@ func (e *CommentNotFoundError) Error() string {
 	return "댓글을 찾을 수 없음: " + e.CommentID
 }
 
-// DeleteComment는 특정 댓글을 삭제합니다.
+// commentDeletion은 댓글 하나를 삭제할 때 삭제 표시할 댓글과 실제로 삭제할 댓글입니다.
+type commentDeletion struct {
+	tombstone *model.Comment
+	remove    []model.Comment
+}
+
+// planCommentDeletion은 같은 게시글의 댓글 목록으로 target 삭제 방법을 결정합니다.
+// 답글이 있으면 target을 삭제 표시하고(이미 표시되어 있으면 변경 없음),
+// 없으면 target과 함께 답글이 target뿐인 삭제 표시 상위 댓글을 차례로 삭제합니다.
+func planCommentDeletion(comments []model.Comment, target model.Comment) commentDeletion {
+	byID := make(map[string]model.Comment, len(comments))
+	replies := make(map[string]int)
+	for _, comment := range comments {
+		byID[comment.CommentID] = comment
+		if comment.ParentCommentID != "" {
+			replies[comment.ParentCommentID]++
+		}
+	}
+
+	if replies[target.CommentID] > 0 {
+		if target.Deleted {
+			return commentDeletion{}
+		}
+		tombstone := target.Tombstone()
+		return commentDeletion{tombstone: &tombstone}
+	}
+
+	plan := commentDeletion{remove: []model.Comment{target}}
+	for parentID := target.ParentCommentID; parentID != ""; {
+		parent, ok := byID[parentID]
+		if !ok || !parent.Deleted || replies[parentID] > 1 {
+			break
+		}
+		plan.remove = append(plan.remove, parent)
+		parentID = parent.ParentCommentID
+	}
+	return plan
+}
+
+// DeleteComment는 특정 댓글을 삭제합니다. 답글이 있으면 삭제 표시만 합니다.
 func (r *CommentRepository) DeleteComment(ctx context.Context, commentID string) error {
 	// 먼저 댓글이 존재하는지 확인
 	// 모든 댓글을 조회하여 찾아야 함 (DynamoDB에서 commentId로 직접 찾을 수 없기 때문)
@@ -208,14 +294,100 @@ func (r *CommentRepository) DeleteComment(ctx context.Context, commentID string)
 		return &CommentNotFoundError{CommentID: commentID}
 	}
 
-	// 댓글 삭제
-	_, err = r.client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
-		TableName: aws.String(CommentTableName),
-		Key: map[string]types.AttributeValue{
-			"postId":    &types.AttributeValueMemberS{Value: targetComment.PostID},
-			"commentId": &types.AttributeValueMemberS{Value: targetComment.CommentID},
-		},
-	})
+	// 답글 여부는 같은 게시글의 댓글로 판단
+	postComments, err := r.getCommentsByPostID(ctx, targetComment.PostID)
+	if err != nil {
+		return err
+	}
+	plan := planCommentDeletion(postComments, *targetComment)
+
+	if plan.tombstone != nil {
+		update := expression.
+			Set(expression.Name("nickname"), expression.Value("")).
+			Set(expression.Name("content"), expression.Value("")).
+			Set(expression.Name("deleted"), expression.Value(true))
+		expr, err := expression.NewBuilder().WithUpdate(update).Build()
+		if err != nil {
+			return err
+		}
+
+		_, err = r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+			TableName:                 aws.String(CommentTableName),
+			Key:                       commentKey(*plan.tombstone),
+			UpdateExpression:          expr.Update(),
+			ExpressionAttributeNames:  expr.Names(),
+			ExpressionAttributeValues: expr.Values(),
+		})
+		return err
+	}
+
+	// 댓글 삭제 (답글이 없어진 삭제 표시 상위 댓글 포함)
+	for _, comment := range plan.remove {
+		_, err = r.client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+			TableName: aws.String(CommentTableName),
+			Key:       commentKey(comment),
+		})
+		if err != nil {
+			return err
+		}
+	}
 
-	return err
+	return nil
+}
+
+// SetCommentStatus는 댓글들의 검토 상태를 변경합니다.
+func (r *CommentRepository) SetCommentStatus(ctx context.Context, commentIDs []string, status string) ([]string, error) {
+	// commentId만으로는 키를 알 수 없으므로 전체 댓글에서 게시글 ID를 찾음
+	allComments, err := r.getAllComments(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]model.Comment, len(allComments))
+	for _, comment := range allComments {
+		byID[comment.CommentID] = comment
+	}
+
+	update := expression.Set(expression.Name("status"), expression.Value(status)).
+		Set(expression.Name("moderated"), expression.Value(true))
+	condition := expression.AttributeExists(expression.Name("commentId"))
+	expr, err := expression.NewBuilder().WithUpdate(update).WithCondition(condition).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	updated := make([]string, 0, len(commentIDs))
+	for _, commentID := range commentIDs {
+		comment, ok := byID[commentID]
+		if !ok {
+			continue
+		}
+
+		_, err := r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+			TableName:                 aws.String(CommentTableName),
+			Key:                       commentKey(comment),
+			UpdateExpression:          expr.Update(),
+			ConditionExpression:       expr.Condition(),
+			ExpressionAttributeNames:  expr.Names(),
+			ExpressionAttributeValues: expr.Values(),
+		})
+		if err != nil {
+			// 조회 이후 삭제된 댓글은 건너뜀
+			var conditionErr *types.ConditionalCheckFailedException
+			if errors.As(err, &conditionErr) {
+				continue
+			}
+			return updated, err
+		}
+		updated = append(updated, commentID)
+		delete(byID, commentID) // 중복 ID는 한 번만 처리
+	}
+
+	return updated, nil
+}
+
+func commentKey(comment model.Comment) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"postId":    &types.AttributeValueMemberS{Value: comment.PostID},
+		"commentId": &types.AttributeValueMemberS{Value: comment.CommentID},
+	}
 }
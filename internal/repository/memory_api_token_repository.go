@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"bumsiku/internal/model"
+)
+
+// MemoryAPITokenRepository는 프로세스 메모리에 API 토큰을 보관하는 저장소입니다.
+type MemoryAPITokenRepository struct {
+	mu     sync.RWMutex
+	tokens map[string]model.APIToken
+}
+
+func NewMemoryAPITokenRepository() *MemoryAPITokenRepository {
+	return &MemoryAPITokenRepository{tokens: make(map[string]model.APIToken)}
+}
+
+func (r *MemoryAPITokenRepository) GetTokens(ctx context.Context, username string) ([]model.APIToken, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tokens := make([]model.APIToken, 0)
+	for _, token := range r.tokens {
+		if token.Username == username {
+			tokens = append(tokens, copyAPIToken(token))
+		}
+	}
+
+	sortAPITokens(tokens)
+	return tokens, nil
+}
+
+func (r *MemoryAPITokenRepository) GetToken(ctx context.Context, tokenID string) (*model.APIToken, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	token, ok := r.tokens[tokenID]
+	if !ok {
+		return nil, nil
+	}
+	token = copyAPIToken(token)
+	return &token, nil
+}
+
+func (r *MemoryAPITokenRepository) CreateToken(ctx context.Context, token *model.APIToken) error {
+	if token.CreatedAt.IsZero() {
+		token.CreatedAt = time.Now()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.tokens[token.TokenID] = copyAPIToken(*token)
+	return nil
+}
+
+func (r *MemoryAPITokenRepository) DeleteToken(ctx context.Context, tokenID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.tokens[tokenID]; !ok {
+		return &APITokenNotFoundError{TokenID: tokenID}
+	}
+	delete(r.tokens, tokenID)
+	return nil
+}
+
+func (r *MemoryAPITokenRepository) DeleteTokensByUsername(ctx context.Context, username string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for tokenID, token := range r.tokens {
+		if token.Username == username {
+			delete(r.tokens, tokenID)
+		}
+	}
+	return nil
+}
+
+func (r *MemoryAPITokenRepository) TouchToken(ctx context.Context, tokenID string, usedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	token, ok := r.tokens[tokenID]
+	if !ok {
+		return &APITokenNotFoundError{TokenID: tokenID}
+	}
+	token.LastUsedAt = &usedAt
+	r.tokens[tokenID] = token
+	return nil
+}
+
+// copyAPIToken은 저장된 토큰과 권한 범위 목록을 공유하지 않도록 복사합니다.
+func copyAPIToken(token model.APIToken) model.APIToken {
+	token.Scopes = append([]string(nil), token.Scopes...)
+	if token.LastUsedAt != nil {
+		lastUsedAt := *token.LastUsedAt
+		token.LastUsedAt = &lastUsedAt
+	}
+	return token
+}
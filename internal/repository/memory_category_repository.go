@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"bumsiku/internal/model"
+)
+
+// MemoryCategoryRepository는 프로세스 메모리에 카테고리를 보관하는 저장소입니다.
+type MemoryCategoryRepository struct {
+	mu         sync.RWMutex
+	categories map[string]model.Category
+}
+
+func NewMemoryCategoryRepository() *MemoryCategoryRepository {
+	return &MemoryCategoryRepository{categories: make(map[string]model.Category)}
+}
+
+func (r *MemoryCategoryRepository) GetCategories(ctx context.Context) ([]model.Category, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	categories := make([]model.Category, 0, len(r.categories))
+	for _, category := range r.categories {
+		categories = append(categories, category)
+	}
+
+	// Order 필드 기준으로 정렬
+	sort.Slice(categories, func(i, j int) bool {
+		return categories[i].Order < categories[j].Order
+	})
+
+	return categories, nil
+}
+
+// UpsertCategory는 카테고리를 생성하거나 업데이트합니다
+func (r *MemoryCategoryRepository) UpsertCategory(ctx context.Context, category model.Category) error {
+	// CreatedAt이 설정되지 않은 경우에만 현재 시간으로 설정
+	if category.CreatedAt.IsZero() {
+		category.CreatedAt = time.Now()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.categories[category.Category] = category
+	return nil
+}
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"bumsiku/internal/model"
+
+	"github.com/google/uuid"
+)
+
+// MemoryCommentRepository는 프로세스 메모리에 댓글을 보관하는 저장소입니다.
+type MemoryCommentRepository struct {
+	mu       sync.RWMutex
+	comments map[string]model.Comment
+}
+
+func NewMemoryCommentRepository() *MemoryCommentRepository {
+	return &MemoryCommentRepository{comments: make(map[string]model.Comment)}
+}
+
+func (r *MemoryCommentRepository) GetComments(ctx context.Context, input *GetCommentsInput) ([]model.Comment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	comments := make([]model.Comment, 0)
+	for _, comment := range r.comments {
+		if input != nil && input.PostID != nil && *input.PostID != "" && comment.PostID != *input.PostID {
+			continue
+		}
+		if input != nil && input.Status != "" && comment.Status != input.Status {
+			continue
+		}
+		comments = append(comments, comment)
+	}
+
+	// 등록순 정렬
+	sort.Slice(comments, func(i, j int) bool {
+		if !comments[i].CreatedAt.Equal(comments[j].CreatedAt) {
+			return comments[i].CreatedAt.Before(comments[j].CreatedAt)
+		}
+		return comments[i].CommentID < comments[j].CommentID
+	})
+
+	return comments, nil
+}
+
+// CreateComment는 댓글을 생성합니다
+func (r *MemoryCommentRepository) CreateComment(ctx context.Context, comment *model.Comment) (*model.Comment, error) {
+	comment.CommentID = uuid.New().String()
+	comment.CreatedAt = time.Now()
+	if comment.Status == "" {
+		comment.Status = model.CommentStatusApproved
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.comments[comment.CommentID] = *comment
+	return comment, nil
+}
+
+func (r *MemoryCommentRepository) DeleteCommentsByPostID(ctx context.Context, postID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, comment := range r.comments {
+		if comment.PostID == postID {
+			delete(r.comments, id)
+		}
+	}
+	return nil
+}
+
+// DeleteComment는 특정 댓글을 삭제합니다. 답글이 있으면 삭제 표시만 합니다.
+func (r *MemoryCommentRepository) DeleteComment(ctx context.Context, commentID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	target, ok := r.comments[commentID]
+	if !ok {
+		return &CommentNotFoundError{CommentID: commentID}
+	}
+
+	postComments := make([]model.Comment, 0)
+	for _, comment := range r.comments {
+		if comment.PostID == target.PostID {
+			postComments = append(postComments, comment)
+		}
+	}
+
+	plan := planCommentDeletion(postComments, target)
+	if plan.tombstone != nil {
+		r.comments[commentID] = *plan.tombstone
+	}
+	for _, comment := range plan.remove {
+		delete(r.comments, comment.CommentID)
+	}
+	return nil
+}
+
+// SetCommentStatus는 댓글들의 검토 상태를 변경합니다.
+func (r *MemoryCommentRepository) SetCommentStatus(ctx context.Context, commentIDs []string, status string) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	updated := make([]string, 0, len(commentIDs))
+	seen := make(map[string]bool, len(commentIDs))
+	for _, commentID := range commentIDs {
+		comment, ok := r.comments[commentID]
+		if !ok || seen[commentID] {
+			continue
+		}
+		seen[commentID] = true
+
+		comment.Status = status
+		comment.Moderated = true
+		r.comments[commentID] = comment
+		updated = append(updated, commentID)
+	}
+	return updated, nil
+}
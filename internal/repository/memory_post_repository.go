@@ -0,0 +1,231 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"bumsiku/internal/model"
+)
+
+// MemoryPostRepository는 프로세스 메모리에 게시글을 보관하는 저장소입니다.
+// 로컬 실행과 테스트 용도로 사용합니다.
+type MemoryPostRepository struct {
+	mu      sync.RWMutex
+	posts   map[string]model.Post
+	cursors *CursorSigner // 페이지 커서 서명
+}
+
+func NewMemoryPostRepository(cursors *CursorSigner) *MemoryPostRepository {
+	return &MemoryPostRepository{posts: make(map[string]model.Post), cursors: cursors}
+}
+
+func (r *MemoryPostRepository) GetPosts(ctx context.Context, input *GetPostsInput) (*GetPostsOutput, error) {
+	// 페이지네이션 계산
+	if input.Page <= 0 {
+		input.Page = 1
+	}
+	if input.PageSize <= 0 {
+		input.PageSize = PageSize
+	}
+	if input.Sort == "" {
+		input.Sort = SortNewest
+	}
+
+	category := ""
+	if input.Category != nil {
+		category = *input.Category
+	}
+
+	// 페이지 번호 또는 커서에 담긴 오프셋으로 시작 위치 결정
+	scope := postListScope(category, input)
+	// 큰 페이지 번호에서 넘치지 않도록 int64로 계산
+	offset := int64(input.Page-1) * int64(input.PageSize)
+	cursorMode := input.Cursor != nil && *input.Cursor != ""
+	if cursorMode {
+		key, err := r.cursors.Decode(*input.Cursor, scope)
+		if err != nil {
+			return nil, err
+		}
+		offset, err = strconv.ParseInt(key["offset"], 10, 64)
+		if err != nil || offset < 0 {
+			return nil, &InvalidCursorError{Cursor: *input.Cursor}
+		}
+	}
+
+	r.mu.RLock()
+	posts := make([]model.Post, 0, len(r.posts))
+	for _, post := range r.posts {
+		if category != "" && post.Category != category {
+			continue
+		}
+		if input.Status != "" && post.Status != input.Status {
+			continue
+		}
+		if input.Tag != "" && !containsTag(post.Tags, input.Tag) {
+			continue
+		}
+		// 목록 조회에서는 Content 필드 제외
+		if !input.WithContent {
+			post.Content = ""
+		}
+		post.Tags = copyTags(post.Tags)
+		posts = append(posts, post)
+	}
+	r.mu.RUnlock()
+
+	sortPosts(posts, input.Sort)
+
+	output := &GetPostsOutput{Posts: []model.Post{}}
+	if !cursorMode {
+		output.TotalCount = int64(len(posts))
+	}
+	if offset < 0 || offset >= int64(len(posts)) {
+		return output, nil
+	}
+
+	start := int(offset)
+	end := len(posts)
+	if int64(input.PageSize) < int64(end-start) {
+		end = start + int(input.PageSize)
+	}
+	output.Posts = posts[start:end]
+
+	// 다음 페이지가 남아 있으면 커서 발급
+	if end < len(posts) {
+		nextCursor, err := r.cursors.Encode(scope, map[string]string{"offset": strconv.Itoa(end)})
+		if err != nil {
+			return nil, err
+		}
+		output.NextCursor = nextCursor
+	}
+
+	return output, nil
+}
+
+// sortPosts는 정렬 방식에 따라 게시글을 정렬합니다. 시간이 같으면 postId로 순서를 고정합니다.
+func sortPosts(posts []model.Post, sortBy string) {
+	sort.Slice(posts, func(i, j int) bool {
+		a, b := posts[i], posts[j]
+		switch sortBy {
+		case SortOldest:
+			if !a.CreatedAt.Equal(b.CreatedAt) {
+				return a.CreatedAt.Before(b.CreatedAt)
+			}
+			return a.PostID < b.PostID
+		case SortUpdated:
+			if !a.UpdatedAt.Equal(b.UpdatedAt) {
+				return a.UpdatedAt.After(b.UpdatedAt)
+			}
+			return a.PostID > b.PostID
+		default:
+			if !a.CreatedAt.Equal(b.CreatedAt) {
+				return a.CreatedAt.After(b.CreatedAt)
+			}
+			return a.PostID > b.PostID
+		}
+	})
+}
+
+func (r *MemoryPostRepository) GetPostByID(ctx context.Context, postID string) (*model.Post, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	post, ok := r.posts[postID]
+	if !ok {
+		return nil, nil
+	}
+	post.Tags = copyTags(post.Tags)
+	return &post, nil
+}
+
+// copyTags는 저장된 게시글과 호출자가 태그 슬라이스를 공유하지 않도록 복사합니다.
+func copyTags(tags []string) []string {
+	if tags == nil {
+		return nil
+	}
+	return append([]string{}, tags...)
+}
+
+// containsTag는 태그 목록에 tag가 있는지 확인합니다.
+func containsTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *MemoryPostRepository) CreatePost(ctx context.Context, post *model.Post) error {
+	post.FeedKey = FeedPartitionKey
+	if post.Status == "" {
+		post.Status = model.PostStatusPublished
+	}
+	post.Version = 1
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := *post
+	stored.Tags = copyTags(post.Tags)
+	r.posts[post.PostID] = stored
+	return nil
+}
+
+func (r *MemoryPostRepository) UpdatePost(ctx context.Context, post *model.Post, expectedVersion *int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.posts[post.PostID]
+	if !ok {
+		return &PostNotFoundError{PostID: post.PostID}
+	}
+	if expectedVersion != nil && existing.Version != *expectedVersion {
+		return &PostVersionConflictError{PostID: post.PostID, ExpectedVersion: *expectedVersion, CurrentVersion: existing.Version}
+	}
+
+	existing.Title = post.Title
+	existing.Content = post.Content
+	existing.Summary = post.Summary
+	existing.Category = post.Category
+	if post.Tags != nil {
+		existing.Tags = copyTags(post.Tags)
+	}
+	existing.UpdatedAt = post.UpdatedAt
+	existing.Version++
+	r.posts[post.PostID] = existing
+
+	post.Version = existing.Version
+	return nil
+}
+
+func (r *MemoryPostRepository) DeletePost(ctx context.Context, postID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.posts[postID]; !ok {
+		return &PostNotFoundError{PostID: postID}
+	}
+
+	delete(r.posts, postID)
+	return nil
+}
+
+func (r *MemoryPostRepository) UpdatePostStatus(ctx context.Context, postID string, status string, publishAt *time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.posts[postID]
+	if !ok {
+		return &PostNotFoundError{PostID: postID}
+	}
+
+	existing.Status = status
+	existing.PublishAt = publishAt
+	existing.Version++
+	r.posts[postID] = existing
+	return nil
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"sync"
+
+	"bumsiku/internal/model"
+)
+
+// MemoryRevisionRepository는 프로세스 메모리에 게시글 리비전을 보관하는 저장소입니다.
+type MemoryRevisionRepository struct {
+	mu        sync.RWMutex
+	revisions map[string][]model.PostRevision // postId별 리비전 (리비전 번호 오름차순)
+}
+
+func NewMemoryRevisionRepository() *MemoryRevisionRepository {
+	return &MemoryRevisionRepository{revisions: make(map[string][]model.PostRevision)}
+}
+
+func (r *MemoryRevisionRepository) CreateRevision(ctx context.Context, revision *model.PostRevision) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	revision.Revision = len(r.revisions[revision.PostID]) + 1
+	r.revisions[revision.PostID] = append(r.revisions[revision.PostID], *revision)
+	return nil
+}
+
+func (r *MemoryRevisionRepository) GetRevisions(ctx context.Context, postID string) ([]model.PostRevision, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stored := r.revisions[postID]
+	revisions := make([]model.PostRevision, 0, len(stored))
+	for i := len(stored) - 1; i >= 0; i-- {
+		rev := stored[i]
+		// 목록 조회에서는 Content 필드 제외
+		rev.Content = ""
+		revisions = append(revisions, rev)
+	}
+	return revisions, nil
+}
+
+func (r *MemoryRevisionRepository) GetRevision(ctx context.Context, postID string, revision int) (*model.PostRevision, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stored := r.revisions[postID]
+	if revision < 1 || revision > len(stored) {
+		return nil, nil
+	}
+	rev := stored[revision-1]
+	return &rev, nil
+}
+
+func (r *MemoryRevisionRepository) DeleteRevisionsByPostID(ctx context.Context, postID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.revisions, postID)
+	return nil
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"bumsiku/internal/model"
+)
+
+// MemorySessionRepository는 프로세스 메모리에 로그인 세션을 보관하는 저장소입니다.
+type MemorySessionRepository struct {
+	mu       sync.RWMutex
+	sessions map[string]model.Session
+}
+
+func NewMemorySessionRepository() *MemorySessionRepository {
+	return &MemorySessionRepository{sessions: make(map[string]model.Session)}
+}
+
+func (r *MemorySessionRepository) GetSession(ctx context.Context, sessionID string) (*model.Session, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	session, ok := r.sessions[sessionID]
+	if !ok {
+		return nil, nil
+	}
+	session = copySession(session)
+	return &session, nil
+}
+
+func (r *MemorySessionRepository) SaveSession(ctx context.Context, session *model.Session) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.sessions[session.SessionID] = copySession(*session)
+	return nil
+}
+
+func (r *MemorySessionRepository) TouchSession(ctx context.Context, sessionID string, lastSeenAt, expiresAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	session, ok := r.sessions[sessionID]
+	if !ok {
+		return &SessionNotFoundError{SessionID: sessionID}
+	}
+	session.LastSeenAt = lastSeenAt
+	session.ExpiresAt = expiresAt
+	r.sessions[sessionID] = session
+	return nil
+}
+
+func (r *MemorySessionRepository) DeleteSession(ctx context.Context, sessionID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.sessions[sessionID]; !ok {
+		return &SessionNotFoundError{SessionID: sessionID}
+	}
+	delete(r.sessions, sessionID)
+	return nil
+}
+
+func (r *MemorySessionRepository) GetSessions(ctx context.Context, username string) ([]model.Session, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	sessions := make([]model.Session, 0)
+	for _, session := range r.sessions {
+		if session.Username == username {
+			sessions = append(sessions, copySession(session))
+		}
+	}
+
+	sortSessions(sessions)
+	return sessions, nil
+}
+
+func (r *MemorySessionRepository) DeleteExpiredSessions(ctx context.Context, now time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deleted := 0
+	for sessionID, session := range r.sessions {
+		if session.IsExpired(now) {
+			delete(r.sessions, sessionID)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// copySession은 저장된 세션과 세션 값을 공유하지 않도록 복사합니다.
+func copySession(session model.Session) model.Session {
+	session.Data = append([]byte(nil), session.Data...)
+	return session
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"bumsiku/internal/model"
+)
+
+// MemoryTagRepository는 프로세스 메모리에 태그 색인을 보관하는 저장소입니다.
+type MemoryTagRepository struct {
+	mu   sync.RWMutex
+	tags map[string]map[string]bool // 태그 → postId → 발행 여부
+}
+
+func NewMemoryTagRepository() *MemoryTagRepository {
+	return &MemoryTagRepository{tags: make(map[string]map[string]bool)}
+}
+
+func (r *MemoryTagRepository) GetTags(ctx context.Context) ([]model.Tag, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	counts := make(map[string]int)
+	for tag, posts := range r.tags {
+		for _, published := range posts {
+			if published {
+				counts[tag]++
+			}
+		}
+	}
+
+	return sortTagCounts(counts), nil
+}
+
+func (r *MemoryTagRepository) GetPostIDsByTag(ctx context.Context, tag string) ([]string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	postIDs := make([]string, 0, len(r.tags[tag]))
+	for postID := range r.tags[tag] {
+		postIDs = append(postIDs, postID)
+	}
+	sort.Strings(postIDs)
+	return postIDs, nil
+}
+
+func (r *MemoryTagRepository) SetPostTags(ctx context.Context, postID string, tags []string, published bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.removeLocked(postID)
+	for _, tag := range tags {
+		if r.tags[tag] == nil {
+			r.tags[tag] = make(map[string]bool)
+		}
+		r.tags[tag][postID] = published
+	}
+	return nil
+}
+
+func (r *MemoryTagRepository) DeletePostTags(ctx context.Context, postID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.removeLocked(postID)
+	return nil
+}
+
+func (r *MemoryTagRepository) removeLocked(postID string) {
+	for tag, posts := range r.tags {
+		delete(posts, postID)
+		if len(posts) == 0 {
+			delete(r.tags, tag)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"bumsiku/internal/model"
+)
+
+// MemoryUserRepository는 프로세스 메모리에 관리자 계정을 보관하는 저장소입니다.
+type MemoryUserRepository struct {
+	mu    sync.RWMutex
+	users map[string]model.User
+}
+
+func NewMemoryUserRepository() *MemoryUserRepository {
+	return &MemoryUserRepository{users: make(map[string]model.User)}
+}
+
+func (r *MemoryUserRepository) GetUsers(ctx context.Context) ([]model.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	users := make([]model.User, 0, len(r.users))
+	for _, user := range r.users {
+		users = append(users, user)
+	}
+
+	sortUsers(users)
+	return users, nil
+}
+
+func (r *MemoryUserRepository) GetUser(ctx context.Context, username string) (*model.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	user, ok := r.users[username]
+	if !ok {
+		return nil, nil
+	}
+	return &user, nil
+}
+
+func (r *MemoryUserRepository) CreateUser(ctx context.Context, user *model.User) error {
+	setUserTimes(user, time.Now())
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.users[user.Username]; ok {
+		return &UserExistsError{Username: user.Username}
+	}
+	r.users[user.Username] = *user
+	return nil
+}
+
+func (r *MemoryUserRepository) UpdateUser(ctx context.Context, user *model.User) error {
+	if user.UpdatedAt.IsZero() {
+		user.UpdatedAt = time.Now()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.users[user.Username]
+	if !ok {
+		return &UserNotFoundError{Username: user.Username}
+	}
+
+	existing.Role = user.Role
+	existing.PasswordHash = user.PasswordHash
+	existing.TOTPEnabled = user.TOTPEnabled
+	existing.TOTPSecret = user.TOTPSecret
+	existing.TOTPLastStep = user.TOTPLastStep
+	existing.RecoveryCodes = append([]string(nil), user.RecoveryCodes...)
+	existing.UpdatedAt = user.UpdatedAt
+	r.users[user.Username] = existing
+	return nil
+}
+
+func (r *MemoryUserRepository) DeleteUser(ctx context.Context, username string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.users[username]; !ok {
+		return &UserNotFoundError{Username: username}
+	}
+	delete(r.users, username)
+	return nil
+}
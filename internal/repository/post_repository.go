@@ -2,6 +2,9 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	"bumsiku/internal/model"
 
@@ -15,31 +18,64 @@ import (
 const PostTableName = "blog_posts"
 const PageSize = 10
 
+// FeedPartitionKey는 모든 게시글이 공유하는 feedKey 값입니다.
+// feed-index(feedKey, createdAt)와 feed-updated-index(feedKey, updatedAt) GSI가
+// 이 고정 파티션을 통해 전체 게시글을 시간순으로 조회할 수 있게 합니다.
+const FeedPartitionKey = "POST"
+
+// 게시글 목록 정렬 방식
+const (
+	SortNewest  = "newest"  // 작성일 최신순 (기본값)
+	SortOldest  = "oldest"  // 작성일 오래된순
+	SortUpdated = "updated" // 수정일 최신순
+)
+
+// IsValidSort는 정렬 방식이 지원되는 값인지 확인합니다. 빈 문자열은 기본값으로 간주합니다.
+func IsValidSort(sort string) bool {
+	switch sort {
+	case "", SortNewest, SortOldest, SortUpdated:
+		return true
+	}
+	return false
+}
+
 type PostRepositoryInterface interface {
 	GetPosts(ctx context.Context, input *GetPostsInput) (*GetPostsOutput, error)
 	GetPostByID(ctx context.Context, postID string) (*model.Post, error)
 	CreatePost(ctx context.Context, post *model.Post) error
-	UpdatePost(ctx context.Context, post *model.Post) error
+	// UpdatePost는 expectedVersion이 nil이 아니면 저장된 버전이 같을 때만 수정합니다.
+	// post.Tags가 nil이면 기존 태그를 유지합니다.
+	UpdatePost(ctx context.Context, post *model.Post, expectedVersion *int64) error
 	DeletePost(ctx context.Context, postID string) error
+	UpdatePostStatus(ctx context.Context, postID string, status string, publishAt *time.Time) error
 }
 
 type PostRepository struct {
-	client *dynamodb.Client
+	client  *dynamodb.Client
+	cursors *CursorSigner // 페이지 커서 서명
 }
 
-func NewPostRepository(client *dynamodb.Client) *PostRepository {
-	return &PostRepository{client: client}
+func NewPostRepository(client *dynamodb.Client, cursors *CursorSigner) *PostRepository {
+	return &PostRepository{client: client, cursors: cursors}
 }
 
 type GetPostsInput struct {
 	Category *string
+	Status   string // 지정하면 해당 상태의 게시글만 조회 (빈 값이면 전체)
+	Tag      string // 지정하면 해당 태그가 달린 게시글만 조회 (정규화된 태그)
+	Sort     string // SortNewest, SortOldest, SortUpdated (기본값: SortNewest)
 	Page     int32
 	PageSize int32
+	Cursor   *string // 이전 응답의 NextCursor. 지정하면 Page는 무시됩니다.
+	// WithContent가 true이면 목록에도 본문(Content)을 포함합니다.
+	// 검색 색인처럼 모든 게시글의 본문이 필요한 작업이 게시글마다 상세 조회하지 않도록 사용합니다.
+	WithContent bool
 }
 
 type GetPostsOutput struct {
 	Posts      []model.Post
-	TotalCount int64
+	TotalCount int64  // 커서 모드에서는 계산하지 않으므로 0입니다.
+	NextCursor string // 다음 페이지가 없으면 빈 문자열
 }
 
 func (r *PostRepository) GetPosts(ctx context.Context, input *GetPostsInput) (*GetPostsOutput, error) {
@@ -50,161 +86,246 @@ func (r *PostRepository) GetPosts(ctx context.Context, input *GetPostsInput) (*G
 	if input.PageSize <= 0 {
 		input.PageSize = PageSize
 	}
+	if input.Sort == "" {
+		input.Sort = SortNewest
+	}
+
+	category := ""
+	if input.Category != nil {
+		category = *input.Category
+	}
+
+	// 커서가 있으면 시작 키를 복원하여 해당 위치부터 바로 조회
+	scope := postListScope(category, input)
+	var startKey map[string]types.AttributeValue
+	if input.Cursor != nil && *input.Cursor != "" {
+		key, err := r.cursors.Decode(*input.Cursor, scope)
+		if err != nil {
+			return nil, err
+		}
+		startKey = stringMapToKey(key)
+	}
 
-	// 카테고리 파라미터에 따라 처리 방식 결정
-	if input.Category != nil && *input.Category != "" {
-		// 카테고리가 있는 경우 인덱스를 사용한 Query 수행
-		return r.getPostsByCategory(ctx, *input.Category, input.Page, input.PageSize)
+	// 카테고리와 정렬 방식에 따라 사용할 인덱스 결정
+	query := postListQuery{
+		status:      input.Status,
+		tag:         input.Tag,
+		forward:     input.Sort == SortOldest,
+		scope:       scope,
+		page:        input.Page,
+		pageSize:    input.PageSize,
+		startKey:    startKey,
+		withContent: input.WithContent,
+	}
+	if category != "" {
+		// 카테고리가 있는 경우 카테고리 인덱스를 사용
+		query.indexName = "category-index"
+		if input.Sort == SortUpdated {
+			query.indexName = "category-updated-index"
+		}
+		query.keyCondition = expression.Key("category").Equal(expression.Value(category))
 	} else {
-		// 카테고리가 없는 경우 Scan 작업으로 모든 게시글 조회
-		return r.getAllPosts(ctx, input.Page, input.PageSize)
+		// 카테고리가 없는 경우 고정 파티션 피드 인덱스를 사용
+		query.indexName = "feed-index"
+		if input.Sort == SortUpdated {
+			query.indexName = "feed-updated-index"
+		}
+		query.keyCondition = expression.Key("feedKey").Equal(expression.Value(FeedPartitionKey))
 	}
+
+	return r.queryPosts(ctx, query)
 }
 
-// 특정 카테고리의 게시글을 조회하는 함수
-func (r *PostRepository) getPostsByCategory(ctx context.Context, category string, page int32, pageSize int32) (*GetPostsOutput, error) {
-	// 카테고리 인덱스 기반 표현식 생성
-	expr, err := buildPostListExpression(category)
-	if err != nil {
-		return nil, err
-	}
+// postListQuery는 인덱스 기반 게시글 목록 조회 조건입니다.
+type postListQuery struct {
+	indexName    string
+	keyCondition expression.KeyConditionBuilder
+	status       string
+	tag          string
+	forward      bool
+	scope        string
+	page         int32
+	pageSize     int32
+	startKey     map[string]types.AttributeValue
+	withContent  bool
+}
 
-	// Content 필드를 제외한 프로젝션 표현식 생성
-	projectionExp := "postId, title, createdAt, updatedAt, summary, category"
+// queryPosts는 인덱스를 조회하여 게시글 목록을 반환합니다.
+// startKey가 있으면 커서 모드로 동작하여 총 개수 조회와 오프셋 처리를 생략합니다.
+func (r *PostRepository) queryPosts(ctx context.Context, query postListQuery) (*GetPostsOutput, error) {
+	// Content 필드를 제외한 프로젝션 표현식 생성 (withContent이면 포함)
+	projection := expression.NamesList(
+		expression.Name("postId"), expression.Name("title"), expression.Name("createdAt"),
+		expression.Name("updatedAt"), expression.Name("summary"), expression.Name("category"),
+		expression.Name("status"), expression.Name("publishAt"), expression.Name("version"),
+		expression.Name("tags"), expression.Name("authorId"),
+	)
+	if query.withContent {
+		projection = projection.AddNames(expression.Name("content"))
+	}
 
-	// 총 개수 조회
-	countResult, err := r.client.Query(ctx, &dynamodb.QueryInput{
-		TableName:                 aws.String(PostTableName),
-		IndexName:                 aws.String("category-index"),
-		KeyConditionExpression:    expr.KeyCondition(),
-		Select:                    types.SelectCount,
-		ExpressionAttributeNames:  expr.Names(),
-		ExpressionAttributeValues: expr.Values(),
-	})
+	builder := expression.NewBuilder().WithKeyCondition(query.keyCondition).WithProjection(projection)
+	if filter, ok := query.filter(); ok {
+		builder = builder.WithFilter(filter)
+	}
+	expr, err := builder.Build()
 	if err != nil {
 		return nil, err
 	}
 
-	// 페이지네이션 계산
-	offset := (page - 1) * pageSize
-
 	// 게시글 조회 쿼리
 	queryInput := &dynamodb.QueryInput{
 		TableName:                 aws.String(PostTableName),
-		IndexName:                 aws.String("category-index"),
+		IndexName:                 aws.String(query.indexName),
 		KeyConditionExpression:    expr.KeyCondition(),
+		FilterExpression:          expr.Filter(),
 		ExpressionAttributeNames:  expr.Names(),
 		ExpressionAttributeValues: expr.Values(),
-		ProjectionExpression:      aws.String(projectionExp), // Content 필드 제외
-		Limit:                     aws.Int32(pageSize),
-		ScanIndexForward:          aws.Bool(false), // 최신순 정렬
+		ProjectionExpression:      expr.Projection(),
+		ScanIndexForward:          aws.Bool(query.forward),
+		ExclusiveStartKey:         query.startKey,
 	}
 
-	// 오프셋 처리
-	if offset > 0 {
-		var lastEvaluatedKey map[string]types.AttributeValue
-		for i := int32(0); i < offset/pageSize; i++ {
-			tempResult, err := r.client.Query(ctx, queryInput)
+	var totalCount int64
+	if query.startKey == nil {
+		// 총 개수 조회 (프로젝션은 SelectCount와 함께 쓸 수 없으므로 별도 표현식 사용)
+		countBuilder := expression.NewBuilder().WithKeyCondition(query.keyCondition)
+		if filter, ok := query.filter(); ok {
+			countBuilder = countBuilder.WithFilter(filter)
+		}
+		countExpr, err := countBuilder.Build()
+		if err != nil {
+			return nil, err
+		}
+
+		countResult, err := r.client.Query(ctx, &dynamodb.QueryInput{
+			TableName:                 aws.String(PostTableName),
+			IndexName:                 aws.String(query.indexName),
+			KeyConditionExpression:    countExpr.KeyCondition(),
+			FilterExpression:          countExpr.Filter(),
+			Select:                    types.SelectCount,
+			ExpressionAttributeNames:  countExpr.Names(),
+			ExpressionAttributeValues: countExpr.Values(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		totalCount = int64(countResult.Count)
+
+		// 오프셋 처리 (페이지 번호 모드 호환용)
+		for i := int32(1); i < query.page; i++ {
+			_, lastKey, err := r.queryPage(ctx, queryInput, query.pageSize)
 			if err != nil {
 				return nil, err
 			}
-			if tempResult.LastEvaluatedKey == nil {
-				break
+			if lastKey == nil {
+				return &GetPostsOutput{Posts: []model.Post{}, TotalCount: totalCount}, nil
 			}
-			lastEvaluatedKey = tempResult.LastEvaluatedKey
-		}
-		if lastEvaluatedKey != nil {
-			queryInput.ExclusiveStartKey = lastEvaluatedKey
+			queryInput.ExclusiveStartKey = lastKey
 		}
 	}
 
 	// 결과 조회
-	result, err := r.client.Query(ctx, queryInput)
-	if err != nil {
-		return nil, err
-	}
-
-	// 결과 변환
-	posts := make([]model.Post, 0)
-	err = attributevalue.UnmarshalListOfMaps(result.Items, &posts)
+	items, lastKey, err := r.queryPage(ctx, queryInput, query.pageSize)
 	if err != nil {
 		return nil, err
 	}
 
-	return &GetPostsOutput{
-		Posts:      posts,
-		TotalCount: int64(countResult.Count),
-	}, nil
+	return r.buildPostsOutput(items, lastKey, query.scope, totalCount)
 }
 
-// 모든 게시글을 조회하는 함수 (카테고리 필터 없음)
-func (r *PostRepository) getAllPosts(ctx context.Context, page int32, pageSize int32) (*GetPostsOutput, error) {
-	// Content 필드를 제외한 프로젝션 표현식 생성
-	projectionExp := "postId, title, createdAt, updatedAt, summary, category"
+// queryPage는 pageSize개의 항목이 모이거나 결과가 끝날 때까지 Query를 반복합니다.
+// 필터 표현식은 Limit 적용 이후에 평가되므로 한 번의 Query로는 페이지가 덜 찰 수 있습니다.
+func (r *PostRepository) queryPage(ctx context.Context, input *dynamodb.QueryInput, pageSize int32) ([]map[string]types.AttributeValue, map[string]types.AttributeValue, error) {
+	pageInput := *input
+	items := make([]map[string]types.AttributeValue, 0, pageSize)
+	for {
+		// 남은 개수만큼만 평가하므로 페이지 크기를 넘지 않습니다
+		pageInput.Limit = aws.Int32(pageSize - int32(len(items)))
+
+		result, err := r.client.Query(ctx, &pageInput)
+		if err != nil {
+			return nil, nil, err
+		}
+		items = append(items, result.Items...)
 
-	// 총 개수 조회를 위한 Scan
-	countResult, err := r.client.Scan(ctx, &dynamodb.ScanInput{
-		TableName: aws.String(PostTableName),
-		Select:    types.SelectCount,
-	})
-	if err != nil {
-		return nil, err
+		if result.LastEvaluatedKey == nil || int32(len(items)) >= pageSize {
+			return items, result.LastEvaluatedKey, nil
+		}
+		pageInput.ExclusiveStartKey = result.LastEvaluatedKey
 	}
+}
 
-	// 페이지네이션 계산
-	offset := (page - 1) * pageSize
+// filter는 상태와 태그 조건을 합친 필터 표현식을 생성합니다. 조건이 없으면 false를 반환합니다.
+func (q postListQuery) filter() (expression.ConditionBuilder, bool) {
+	var conditions []expression.ConditionBuilder
+	if q.status != "" {
+		conditions = append(conditions, postStatusFilter(q.status))
+	}
+	if q.tag != "" {
+		conditions = append(conditions, expression.Contains(expression.Name("tags"), q.tag))
+	}
 
-	// 게시글 조회를 위한 Scan
-	scanInput := &dynamodb.ScanInput{
-		TableName:            aws.String(PostTableName),
-		ProjectionExpression: aws.String(projectionExp), // Content 필드 제외
-		Limit:                aws.Int32(pageSize),
+	switch len(conditions) {
+	case 0:
+		return expression.ConditionBuilder{}, false
+	case 1:
+		return conditions[0], true
+	default:
+		return expression.And(conditions[0], conditions[1]), true
 	}
+}
 
-	// 오프셋 처리
-	if offset > 0 {
-		var lastEvaluatedKey map[string]types.AttributeValue
-		for i := int32(0); i < offset/pageSize; i++ {
-			tempResult, err := r.client.Scan(ctx, scanInput)
-			if err != nil {
-				return nil, err
-			}
-			if tempResult.LastEvaluatedKey == nil {
-				break
-			}
-			lastEvaluatedKey = tempResult.LastEvaluatedKey
-		}
-		if lastEvaluatedKey != nil {
-			scanInput.ExclusiveStartKey = lastEvaluatedKey
-		}
+// postStatusFilter는 상태 필터 조건을 생성합니다.
+// 공개 상태 조회 시 상태 필드가 없는 기존 게시글도 포함합니다.
+func postStatusFilter(status string) expression.ConditionBuilder {
+	filter := expression.Name("status").Equal(expression.Value(status))
+	if status == model.PostStatusPublished {
+		filter = filter.Or(expression.AttributeNotExists(expression.Name("status")))
 	}
+	return filter
+}
 
-	// 결과 조회
-	result, err := r.client.Scan(ctx, scanInput)
+// buildPostsOutput은 조회 결과를 변환하고 다음 페이지 커서를 생성합니다.
+func (r *PostRepository) buildPostsOutput(items []map[string]types.AttributeValue, lastKey map[string]types.AttributeValue, scope string, totalCount int64) (*GetPostsOutput, error) {
+	// 결과 변환
+	posts := make([]model.Post, 0)
+	err := attributevalue.UnmarshalListOfMaps(items, &posts)
 	if err != nil {
 		return nil, err
 	}
 
-	// 결과 변환
-	posts := make([]model.Post, 0)
-	err = attributevalue.UnmarshalListOfMaps(result.Items, &posts)
+	nextCursor, err := r.cursors.Encode(scope, keyToStringMap(lastKey))
 	if err != nil {
 		return nil, err
 	}
 
 	return &GetPostsOutput{
 		Posts:      posts,
-		TotalCount: int64(countResult.Count),
+		TotalCount: totalCount,
+		NextCursor: nextCursor,
 	}, nil
 }
 
-func buildPostListExpression(category string) (expression.Expression, error) {
-	// 카테고리별 인덱스를 사용한 쿼리 조건 생성
-	keyCondition := expression.Key("category").Equal(expression.Value(category))
+// keyToStringMap은 DynamoDB 키를 커서에 담을 수 있는 문자열 맵으로 변환합니다.
+// 게시글 테이블과 인덱스의 키 속성은 모두 문자열(S) 타입입니다.
+func keyToStringMap(key map[string]types.AttributeValue) map[string]string {
+	values := make(map[string]string, len(key))
+	for name, attr := range key {
+		if s, ok := attr.(*types.AttributeValueMemberS); ok {
+			values[name] = s.Value
+		}
+	}
+	return values
+}
 
-	return expression.NewBuilder().
-		WithKeyCondition(keyCondition).
-		Build()
+// stringMapToKey는 커서에서 복원한 문자열 맵을 DynamoDB 키로 변환합니다.
+func stringMapToKey(values map[string]string) map[string]types.AttributeValue {
+	key := make(map[string]types.AttributeValue, len(values))
+	for name, value := range values {
+		key[name] = &types.AttributeValueMemberS{Value: value}
+	}
+	return key
 }
 
 func (r *PostRepository) GetPostByID(ctx context.Context, postID string) (*model.Post, error) {
@@ -223,6 +344,13 @@ func (r *PostRepository) GetPostByID(ctx context.Context, postID string) (*model
 }
 
 func (r *PostRepository) CreatePost(ctx context.Context, post *model.Post) error {
+	// 시간순 피드 인덱스에 포함되도록 고정 파티션 키 설정
+	post.FeedKey = FeedPartitionKey
+	if post.Status == "" {
+		post.Status = model.PostStatusPublished
+	}
+	post.Version = 1
+
 	item, err := attributevalue.MarshalMap(post)
 	if err != nil {
 		return err
@@ -236,40 +364,103 @@ func (r *PostRepository) CreatePost(ctx context.Context, post *model.Post) error
 	return err
 }
 
-func (r *PostRepository) UpdatePost(ctx context.Context, post *model.Post) error {
-	// 먼저 게시글이 존재하는지 확인
-	existingPost, err := r.GetPostByID(ctx, post.PostID)
-	if err != nil {
-		return err
-	}
-	if existingPost == nil {
-		return &PostNotFoundError{PostID: post.PostID}
-	}
-
+// UpdatePost는 게시글 내용을 수정하고 버전을 1 증가시킵니다.
+// expectedVersion을 지정하면 저장된 버전이 같을 때만 수정하며, 다르면 PostVersionConflictError를 반환합니다.
+// 성공하면 post.Version에 새 버전을 채웁니다.
+func (r *PostRepository) UpdatePost(ctx context.Context, post *model.Post, expectedVersion *int64) error {
 	// 업데이트 표현식 생성
 	update := expression.Set(expression.Name("title"), expression.Value(post.Title)).
 		Set(expression.Name("content"), expression.Value(post.Content)).
 		Set(expression.Name("summary"), expression.Value(post.Summary)).
 		Set(expression.Name("category"), expression.Value(post.Category)).
-		Set(expression.Name("updatedAt"), expression.Value(post.UpdatedAt))
+		Set(expression.Name("updatedAt"), expression.Value(post.UpdatedAt)).
+		Set(expression.Name("feedKey"), expression.Value(FeedPartitionKey)).
+		Set(expression.Name("version"), nextPostVersion())
+	if post.Tags != nil {
+		update = update.Set(expression.Name("tags"), expression.Value(post.Tags))
+	}
+
+	// 존재 확인과 버전 비교를 조건식으로 처리하여 조회와 수정 사이의 경쟁을 방지
+	condition := expression.AttributeExists(expression.Name("postId"))
+	if expectedVersion != nil {
+		condition = condition.And(postVersionCondition(*expectedVersion))
+	}
 
-	expr, err := expression.NewBuilder().WithUpdate(update).Build()
+	expr, err := expression.NewBuilder().WithUpdate(update).WithCondition(condition).Build()
 	if err != nil {
 		return err
 	}
 
-	_, err = r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+	result, err := r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
 		TableName: aws.String(PostTableName),
 		Key: map[string]types.AttributeValue{
 			"postId": &types.AttributeValueMemberS{Value: post.PostID},
 		},
+		ConditionExpression:       expr.Condition(),
 		ExpressionAttributeNames:  expr.Names(),
 		ExpressionAttributeValues: expr.Values(),
 		UpdateExpression:          expr.Update(),
 		ReturnValues:              types.ReturnValueUpdatedNew,
 	})
 
-	return err
+	var conditionErr *types.ConditionalCheckFailedException
+	if errors.As(err, &conditionErr) {
+		return r.conditionFailure(ctx, post.PostID, expectedVersion)
+	}
+	if err != nil {
+		return err
+	}
+
+	var updated struct {
+		Version int64 `dynamodbav:"version"`
+	}
+	if err := attributevalue.UnmarshalMap(result.Attributes, &updated); err != nil {
+		return err
+	}
+	post.Version = updated.Version
+
+	return nil
+}
+
+// nextPostVersion은 버전을 1 증가시키는 표현식입니다. 버전 도입 이전 게시글은 0에서 시작합니다.
+func nextPostVersion() expression.SetValueBuilder {
+	return expression.Plus(
+		expression.IfNotExists(expression.Name("version"), expression.Value(0)),
+		expression.Value(1),
+	)
+}
+
+// postVersionCondition은 저장된 버전이 expected와 같은지 확인하는 조건식입니다.
+// 버전 속성이 없는 기존 게시글은 버전 0으로 간주합니다.
+func postVersionCondition(expected int64) expression.ConditionBuilder {
+	condition := expression.Name("version").Equal(expression.Value(expected))
+	if expected == 0 {
+		condition = condition.Or(expression.AttributeNotExists(expression.Name("version")))
+	}
+	return condition
+}
+
+// conditionFailure는 조건부 쓰기 실패 원인을 게시글 존재 여부로 구분합니다.
+func (r *PostRepository) conditionFailure(ctx context.Context, postID string, expectedVersion *int64) error {
+	existing, err := r.GetPostByID(ctx, postID)
+	if err != nil {
+		return err
+	}
+	if existing == nil || expectedVersion == nil {
+		return &PostNotFoundError{PostID: postID}
+	}
+	return &PostVersionConflictError{PostID: postID, ExpectedVersion: *expectedVersion, CurrentVersion: existing.Version}
+}
+
+// PostVersionConflictError는 게시글이 다른 곳에서 먼저 수정되어 기대한 버전과 다를 때 발생하는 오류입니다.
+type PostVersionConflictError struct {
+	PostID          string
+	ExpectedVersion int64
+	CurrentVersion  int64
+}
+
+func (e *PostVersionConflictError) Error() string {
+	return fmt.Sprintf("게시글 버전 충돌: %s (기대 버전 %d, 현재 버전 %d)", e.PostID, e.ExpectedVersion, e.CurrentVersion)
 }
 
 // PostNotFoundError는 게시글을 찾을 수 없을 때 발생하는 오류입니다.
@@ -315,3 +506,94 @@ func (r *PostRepository) DeletePost(ctx context.Context, postID string) error {
 
 	return err
 }
+
+// BackfillPostDefaults는 feedKey 또는 status가 없는 기존 게시글에 기본값을 채워
+// 시간순 피드 인덱스와 상태 필터에 포함되도록 합니다. 갱신한 게시글 수를 반환합니다.
+func (r *PostRepository) BackfillPostDefaults(ctx context.Context) (int, error) {
+	filter := expression.AttributeNotExists(expression.Name("feedKey")).
+		Or(expression.AttributeNotExists(expression.Name("status")))
+	expr, err := expression.NewBuilder().
+		WithFilter(filter).
+		WithProjection(expression.NamesList(expression.Name("postId"))).
+		Build()
+	if err != nil {
+		return 0, err
+	}
+
+	update, err := expression.NewBuilder().
+		WithUpdate(expression.
+			Set(expression.Name("feedKey"), expression.Value(FeedPartitionKey)).
+			Set(expression.Name("status"), expression.IfNotExists(expression.Name("status"), expression.Value(model.PostStatusPublished)))).
+		Build()
+	if err != nil {
+		return 0, err
+	}
+
+	updated := 0
+	paginator := dynamodb.NewScanPaginator(r.client, &dynamodb.ScanInput{
+		TableName:                 aws.String(PostTableName),
+		FilterExpression:          expr.Filter(),
+		ProjectionExpression:      expr.Projection(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return updated, err
+		}
+
+		for _, item := range page.Items {
+			_, err := r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+				TableName:                 aws.String(PostTableName),
+				Key:                       map[string]types.AttributeValue{"postId": item["postId"]},
+				UpdateExpression:          update.Update(),
+				ExpressionAttributeNames:  update.Names(),
+				ExpressionAttributeValues: update.Values(),
+			})
+			if err != nil {
+				return updated, err
+			}
+			updated++
+		}
+	}
+
+	return updated, nil
+}
+
+// UpdatePostStatus는 게시글의 공개 상태와 발행 시간을 변경합니다.
+// publishAt이 nil이면 기존 발행 시간을 제거합니다.
+func (r *PostRepository) UpdatePostStatus(ctx context.Context, postID string, status string, publishAt *time.Time) error {
+	update := expression.Set(expression.Name("status"), expression.Value(status)).
+		Set(expression.Name("version"), nextPostVersion())
+	if publishAt != nil {
+		update = update.Set(expression.Name("publishAt"), expression.Value(*publishAt))
+	} else {
+		update = update.Remove(expression.Name("publishAt"))
+	}
+
+	expr, err := expression.NewBuilder().
+		WithUpdate(update).
+		WithCondition(expression.AttributeExists(expression.Name("postId"))).
+		Build()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(PostTableName),
+		Key: map[string]types.AttributeValue{
+			"postId": &types.AttributeValueMemberS{Value: postID},
+		},
+		ConditionExpression:       expr.Condition(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		UpdateExpression:          expr.Update(),
+	})
+
+	var conditionErr *types.ConditionalCheckFailedException
+	if errors.As(err, &conditionErr) {
+		return &PostNotFoundError{PostID: postID}
+	}
+	return err
+}
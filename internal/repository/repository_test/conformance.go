@@ -0,0 +1,1186 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+	"bumsiku/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 이 파일은 저장소 백엔드가 공통으로 만족해야 하는 동작을 정의합니다.
+// 새 백엔드를 추가하면 *_repository_test.go에서 각 Run*Conformance 함수를 호출합니다.
+
+var baseTime = time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+// seedPosts는 작성 시간이 1시간씩 차이 나는 게시글을 생성합니다.
+// 짝수 번째는 tech, 홀수 번째는 life 카테고리이며 수정 시간은 작성 순서의 역순입니다.
+func seedPosts(t *testing.T, repo repository.PostRepositoryInterface, count int) []model.Post {
+	t.Helper()
+
+	posts := make([]model.Post, 0, count)
+	for i := 0; i < count; i++ {
+		category := "tech"
+		if i%2 == 1 {
+			category = "life"
+		}
+		post := model.Post{
+			PostID:    fmt.Sprintf("post%02d", i),
+			Title:     fmt.Sprintf("게시글 %d", i),
+			Content:   fmt.Sprintf("내용 %d", i),
+			Summary:   fmt.Sprintf("요약 %d", i),
+			Category:  category,
+			AuthorID:  "admin",
+			CreatedAt: baseTime.Add(time.Duration(i) * time.Hour),
+			UpdatedAt: baseTime.Add(time.Duration(count-i) * 24 * time.Hour),
+		}
+		require.NoError(t, repo.CreatePost(context.Background(), &post))
+		posts = append(posts, post)
+	}
+	return posts
+}
+
+func postIDs(posts []model.Post) []string {
+	ids := make([]string, 0, len(posts))
+	for _, post := range posts {
+		ids = append(ids, post.PostID)
+	}
+	return ids
+}
+
+// RunPostRepositoryConformance는 게시글 저장소 공통 동작을 검증합니다.
+func RunPostRepositoryConformance(t *testing.T, newRepo func(t *testing.T) repository.PostRepositoryInterface) {
+	ctx := context.Background()
+
+	// [GIVEN] 게시글을 저장한 경우
+	// [WHEN] ID로 조회
+	// [THEN] 저장한 내용 그대로 반환 확인
+	t.Run("CreateAndGetByID", func(t *testing.T) {
+		repo := newRepo(t)
+		posts := seedPosts(t, repo, 1)
+
+		post, err := repo.GetPostByID(ctx, posts[0].PostID)
+		require.NoError(t, err)
+		require.NotNil(t, post)
+		assert.Equal(t, posts[0].Title, post.Title)
+		assert.Equal(t, posts[0].Content, post.Content)
+		assert.Equal(t, posts[0].Summary, post.Summary)
+		assert.Equal(t, posts[0].Category, post.Category)
+		assert.Equal(t, posts[0].AuthorID, post.AuthorID)
+		assert.True(t, posts[0].CreatedAt.Equal(post.CreatedAt))
+		assert.True(t, posts[0].UpdatedAt.Equal(post.UpdatedAt))
+	})
+
+	// [GIVEN] 존재하지 않는 게시글 ID
+	// [WHEN] ID로 조회
+	// [THEN] 오류 없이 nil 반환 확인
+	t.Run("GetByIDMissing", func(t *testing.T) {
+		repo := newRepo(t)
+
+		post, err := repo.GetPostByID(ctx, "missing")
+		assert.NoError(t, err)
+		assert.Nil(t, post)
+	})
+
+	// [GIVEN] 여러 게시글이 있는 경우
+	// [WHEN] 정렬 방식 없이 목록 조회
+	// [THEN] 최신순으로 반환되고 본문은 제외됨을 확인
+	t.Run("ListNewestFirst", func(t *testing.T) {
+		repo := newRepo(t)
+		seedPosts(t, repo, 3)
+
+		output, err := repo.GetPosts(ctx, &repository.GetPostsInput{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"post02", "post01", "post00"}, postIDs(output.Posts))
+		assert.Equal(t, int64(3), output.TotalCount)
+		assert.Empty(t, output.NextCursor)
+		for _, post := range output.Posts {
+			assert.Empty(t, post.Content)
+		}
+	})
+
+	// [GIVEN] 여러 게시글이 있는 경우
+	// [WHEN] WithContent로 커서를 따라 목록 조회
+	// [THEN] 모든 페이지의 게시글에 본문이 포함됨을 확인
+	t.Run("ListWithContent", func(t *testing.T) {
+		repo := newRepo(t)
+		posts := seedPosts(t, repo, 3)
+
+		input := &repository.GetPostsInput{Sort: repository.SortOldest, PageSize: 2, WithContent: true}
+		first, err := repo.GetPosts(ctx, input)
+		require.NoError(t, err)
+		require.NotEmpty(t, first.NextCursor)
+		input.Cursor = &first.NextCursor
+		second, err := repo.GetPosts(ctx, input)
+		require.NoError(t, err)
+
+		listed := append(first.Posts, second.Posts...)
+		require.Len(t, listed, 3)
+		for i, post := range listed {
+			assert.Equal(t, posts[i].Content, post.Content)
+		}
+	})
+
+	// [GIVEN] 여러 게시글이 있는 경우
+	// [WHEN] oldest, updated 정렬로 목록 조회
+	// [THEN] 각각 작성일 오름차순, 수정일 내림차순으로 반환 확인
+	t.Run("ListSorted", func(t *testing.T) {
+		repo := newRepo(t)
+		seedPosts(t, repo, 3)
+
+		oldest, err := repo.GetPosts(ctx, &repository.GetPostsInput{Sort: repository.SortOldest})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"post00", "post01", "post02"}, postIDs(oldest.Posts))
+
+		updated, err := repo.GetPosts(ctx, &repository.GetPostsInput{Sort: repository.SortUpdated})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"post00", "post01", "post02"}, postIDs(updated.Posts))
+	})
+
+	// [GIVEN] 여러 카테고리의 게시글이 있는 경우
+	// [WHEN] 카테고리를 지정하여 목록 조회
+	// [THEN] 해당 카테고리 게시글만 반환 확인
+	t.Run("ListByCategory", func(t *testing.T) {
+		repo := newRepo(t)
+		seedPosts(t, repo, 5)
+		category := "tech"
+
+		output, err := repo.GetPosts(ctx, &repository.GetPostsInput{Category: &category})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"post04", "post02", "post00"}, postIDs(output.Posts))
+		assert.Equal(t, int64(3), output.TotalCount)
+	})
+
+	// [GIVEN] 한 페이지보다 많은 게시글이 있는 경우
+	// [WHEN] 페이지 번호로 목록 조회
+	// [THEN] 해당 페이지의 게시글과 전체 개수 반환 확인
+	t.Run("PageMode", func(t *testing.T) {
+		repo := newRepo(t)
+		seedPosts(t, repo, 5)
+
+		output, err := repo.GetPosts(ctx, &repository.GetPostsInput{Page: 2, PageSize: 2})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"post02", "post01"}, postIDs(output.Posts))
+		assert.Equal(t, int64(5), output.TotalCount)
+
+		output, err = repo.GetPosts(ctx, &repository.GetPostsInput{Page: 4, PageSize: 2})
+		require.NoError(t, err)
+		assert.Empty(t, output.Posts)
+
+		// 오프셋이 int32 범위를 넘는 페이지도 빈 목록
+		output, err = repo.GetPosts(ctx, &repository.GetPostsInput{Page: 65537, PageSize: 32768})
+		require.NoError(t, err)
+		assert.Empty(t, output.Posts)
+		assert.Equal(t, int64(5), output.TotalCount)
+	})
+
+	// [GIVEN] 한 페이지보다 많은 게시글이 있는 경우
+	// [WHEN] nextCursor를 따라가며 목록 조회
+	// [THEN] 모든 게시글을 중복 없이 순서대로 반환 확인
+	t.Run("CursorWalk", func(t *testing.T) {
+		repo := newRepo(t)
+		seedPosts(t, repo, 5)
+
+		for _, sortBy := range []string{repository.SortNewest, repository.SortOldest, repository.SortUpdated} {
+			first, err := repo.GetPosts(ctx, &repository.GetPostsInput{Sort: sortBy, PageSize: 5})
+			require.NoError(t, err)
+
+			var walked []string
+			var cursor *string
+			for i := 0; i < 5; i++ {
+				output, err := repo.GetPosts(ctx, &repository.GetPostsInput{Sort: sortBy, PageSize: 2, Cursor: cursor})
+				require.NoError(t, err)
+				if cursor != nil {
+					assert.Zero(t, output.TotalCount)
+				}
+				walked = append(walked, postIDs(output.Posts)...)
+				if output.NextCursor == "" {
+					break
+				}
+				next := output.NextCursor
+				cursor = &next
+			}
+			assert.Equal(t, postIDs(first.Posts), walked, sortBy)
+		}
+	})
+
+	// [GIVEN] 변조되었거나 다른 조건에서 발급된 커서
+	// [WHEN] 목록 조회
+	// [THEN] InvalidCursorError 반환 확인
+	t.Run("InvalidCursor", func(t *testing.T) {
+		repo := newRepo(t)
+		seedPosts(t, repo, 3)
+
+		tampered := "tampered.signature"
+		_, err := repo.GetPosts(ctx, &repository.GetPostsInput{Cursor: &tampered})
+		assert.IsType(t, &repository.InvalidCursorError{}, err)
+
+		output, err := repo.GetPosts(ctx, &repository.GetPostsInput{PageSize: 1})
+		require.NoError(t, err)
+		require.NotEmpty(t, output.NextCursor)
+		category := "tech"
+		_, err = repo.GetPosts(ctx, &repository.GetPostsInput{Category: &category, Cursor: &output.NextCursor})
+		assert.IsType(t, &repository.InvalidCursorError{}, err)
+	})
+
+	// [GIVEN] 게시글이 있는 경우
+	// [WHEN] 게시글 수정
+	// [THEN] 제목, 본문, 요약, 카테고리, 수정 시간이 갱신되고 작성 시간은 유지됨을 확인
+	t.Run("Update", func(t *testing.T) {
+		repo := newRepo(t)
+		posts := seedPosts(t, repo, 1)
+		updatedAt := baseTime.Add(48 * time.Hour)
+
+		err := repo.UpdatePost(ctx, &model.Post{
+			PostID:    posts[0].PostID,
+			Title:     "수정된 제목",
+			Content:   "수정된 내용",
+			Summary:   "수정된 요약",
+			Category:  "life",
+			UpdatedAt: updatedAt,
+		}, nil)
+		require.NoError(t, err)
+
+		post, err := repo.GetPostByID(ctx, posts[0].PostID)
+		require.NoError(t, err)
+		assert.Equal(t, "수정된 제목", post.Title)
+		assert.Equal(t, "수정된 내용", post.Content)
+		assert.Equal(t, "수정된 요약", post.Summary)
+		assert.Equal(t, "life", post.Category)
+		assert.True(t, updatedAt.Equal(post.UpdatedAt))
+		assert.True(t, posts[0].CreatedAt.Equal(post.CreatedAt))
+	})
+
+	// [GIVEN] 게시글이 있는 경우
+	// [WHEN] 기대 버전을 지정하여 수정
+	// [THEN] 버전이 같으면 1 증가하고, 다르면 PostVersionConflictError와 함께 내용이 유지됨을 확인
+	t.Run("UpdateWithVersion", func(t *testing.T) {
+		repo := newRepo(t)
+		posts := seedPosts(t, repo, 1)
+		assert.Equal(t, int64(1), posts[0].Version)
+
+		expected := int64(1)
+		first := &model.Post{PostID: posts[0].PostID, Title: "첫 수정", Content: "내용", Summary: "요약", Category: "tech", UpdatedAt: baseTime}
+		require.NoError(t, repo.UpdatePost(ctx, first, &expected))
+		assert.Equal(t, int64(2), first.Version)
+
+		// 같은 버전으로 다시 수정하면 충돌
+		stale := &model.Post{PostID: posts[0].PostID, Title: "늦은 수정", Content: "내용", Summary: "요약", Category: "tech", UpdatedAt: baseTime}
+		err := repo.UpdatePost(ctx, stale, &expected)
+		var conflict *repository.PostVersionConflictError
+		require.ErrorAs(t, err, &conflict)
+		assert.Equal(t, int64(1), conflict.ExpectedVersion)
+		assert.Equal(t, int64(2), conflict.CurrentVersion)
+
+		post, err := repo.GetPostByID(ctx, posts[0].PostID)
+		require.NoError(t, err)
+		assert.Equal(t, "첫 수정", post.Title)
+		assert.Equal(t, int64(2), post.Version)
+
+		// 상태 변경도 버전을 증가시킴
+		require.NoError(t, repo.UpdatePostStatus(ctx, posts[0].PostID, model.PostStatusArchived, nil))
+		post, err = repo.GetPostByID(ctx, posts[0].PostID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), post.Version)
+	})
+
+	// [GIVEN] 존재하지 않는 게시글
+	// [WHEN] 수정 또는 삭제
+	// [THEN] PostNotFoundError 반환 확인
+	t.Run("NotFound", func(t *testing.T) {
+		repo := newRepo(t)
+
+		err := repo.UpdatePost(ctx, &model.Post{PostID: "missing", UpdatedAt: baseTime}, nil)
+		assert.IsType(t, &repository.PostNotFoundError{}, err)
+
+		version := int64(1)
+		err = repo.UpdatePost(ctx, &model.Post{PostID: "missing", UpdatedAt: baseTime}, &version)
+		assert.IsType(t, &repository.PostNotFoundError{}, err)
+
+		err = repo.DeletePost(ctx, "missing")
+		assert.IsType(t, &repository.PostNotFoundError{}, err)
+
+		err = repo.UpdatePostStatus(ctx, "missing", model.PostStatusDraft, nil)
+		assert.IsType(t, &repository.PostNotFoundError{}, err)
+	})
+
+	// [GIVEN] 상태를 지정하지 않고 게시글을 저장한 경우
+	// [WHEN] ID로 조회
+	// [THEN] published 상태로 저장되었는지 확인
+	t.Run("DefaultStatus", func(t *testing.T) {
+		repo := newRepo(t)
+		posts := seedPosts(t, repo, 1)
+
+		post, err := repo.GetPostByID(ctx, posts[0].PostID)
+		require.NoError(t, err)
+		require.NotNil(t, post)
+		assert.Equal(t, model.PostStatusPublished, post.Status)
+		assert.Nil(t, post.PublishAt)
+	})
+
+	// [GIVEN] 상태가 다른 게시글들이 있는 경우
+	// [WHEN] 상태를 변경하고 상태 필터로 목록 조회
+	// [THEN] 해당 상태의 게시글만 반환되고 발행 시간이 저장되었는지 확인
+	t.Run("UpdateStatusAndFilter", func(t *testing.T) {
+		repo := newRepo(t)
+		posts := seedPosts(t, repo, 4)
+		publishAt := baseTime.Add(48 * time.Hour)
+
+		require.NoError(t, repo.UpdatePostStatus(ctx, posts[1].PostID, model.PostStatusScheduled, &publishAt))
+		require.NoError(t, repo.UpdatePostStatus(ctx, posts[2].PostID, model.PostStatusDraft, nil))
+
+		scheduled, err := repo.GetPosts(ctx, &repository.GetPostsInput{Status: model.PostStatusScheduled})
+		require.NoError(t, err)
+		assert.Equal(t, []string{posts[1].PostID}, postIDs(scheduled.Posts))
+		assert.Equal(t, int64(1), scheduled.TotalCount)
+		require.NotNil(t, scheduled.Posts[0].PublishAt)
+		assert.True(t, publishAt.Equal(*scheduled.Posts[0].PublishAt))
+
+		published, err := repo.GetPosts(ctx, &repository.GetPostsInput{Status: model.PostStatusPublished})
+		require.NoError(t, err)
+		assert.Equal(t, []string{posts[3].PostID, posts[0].PostID}, postIDs(published.Posts))
+
+		all, err := repo.GetPosts(ctx, &repository.GetPostsInput{})
+		require.NoError(t, err)
+		assert.Len(t, all.Posts, 4)
+
+		// 초안으로 되돌리면 발행 시간 제거
+		require.NoError(t, repo.UpdatePostStatus(ctx, posts[1].PostID, model.PostStatusDraft, nil))
+		post, err := repo.GetPostByID(ctx, posts[1].PostID)
+		require.NoError(t, err)
+		assert.Equal(t, model.PostStatusDraft, post.Status)
+		assert.Nil(t, post.PublishAt)
+	})
+
+	// [GIVEN] 게시글이 있는 경우
+	// [WHEN] 게시글 삭제
+	// [THEN] 더 이상 조회되지 않음을 확인
+	t.Run("Delete", func(t *testing.T) {
+		repo := newRepo(t)
+		posts := seedPosts(t, repo, 2)
+
+		require.NoError(t, repo.DeletePost(ctx, posts[0].PostID))
+
+		post, err := repo.GetPostByID(ctx, posts[0].PostID)
+		assert.NoError(t, err)
+		assert.Nil(t, post)
+
+		output, err := repo.GetPosts(ctx, &repository.GetPostsInput{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{posts[1].PostID}, postIDs(output.Posts))
+	})
+
+	// [GIVEN] 태그가 달린 게시글들이 있는 경우
+	// [WHEN] ID로 조회하고 태그 필터로 목록 조회
+	// [THEN] 태그가 순서대로 저장되고 해당 태그가 달린 게시글만 반환됨을 확인
+	t.Run("TagsAndFilter", func(t *testing.T) {
+		repo := newRepo(t)
+		for i, tags := range [][]string{{"go", "db"}, {"db"}, nil, {"go"}} {
+			post := model.Post{
+				PostID:    fmt.Sprintf("post%02d", i),
+				Title:     "제목",
+				Content:   "내용",
+				Summary:   "요약",
+				Category:  "tech",
+				Tags:      tags,
+				CreatedAt: baseTime.Add(time.Duration(i) * time.Hour),
+				UpdatedAt: baseTime,
+			}
+			require.NoError(t, repo.CreatePost(ctx, &post))
+		}
+
+		post, err := repo.GetPostByID(ctx, "post00")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"go", "db"}, post.Tags)
+
+		untagged, err := repo.GetPostByID(ctx, "post02")
+		require.NoError(t, err)
+		assert.Empty(t, untagged.Tags)
+
+		output, err := repo.GetPosts(ctx, &repository.GetPostsInput{Tag: "go"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"post03", "post00"}, postIDs(output.Posts))
+		assert.Equal(t, int64(2), output.TotalCount)
+		assert.Equal(t, []string{"go"}, output.Posts[0].Tags)
+
+		// 태그 필터 커서는 같은 태그 조회에서만 사용 가능
+		first, err := repo.GetPosts(ctx, &repository.GetPostsInput{Tag: "db", PageSize: 1})
+		require.NoError(t, err)
+		require.NotEmpty(t, first.NextCursor)
+		second, err := repo.GetPosts(ctx, &repository.GetPostsInput{Tag: "db", PageSize: 1, Cursor: &first.NextCursor})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"post00"}, postIDs(second.Posts))
+
+		_, err = repo.GetPosts(ctx, &repository.GetPostsInput{Tag: "go", PageSize: 1, Cursor: &first.NextCursor})
+		assert.IsType(t, &repository.InvalidCursorError{}, err)
+	})
+
+	// [GIVEN] 태그가 달린 게시글
+	// [WHEN] 태그를 생략하거나 빈 목록으로 수정
+	// [THEN] 생략하면 기존 태그가 유지되고 빈 목록이면 모두 제거됨을 확인
+	t.Run("UpdateTags", func(t *testing.T) {
+		repo := newRepo(t)
+		post := model.Post{PostID: "post1", Title: "제목", Content: "내용", Summary: "요약", Category: "tech", Tags: []string{"go"}, CreatedAt: baseTime, UpdatedAt: baseTime}
+		require.NoError(t, repo.CreatePost(ctx, &post))
+
+		require.NoError(t, repo.UpdatePost(ctx, &model.Post{PostID: "post1", Title: "수정", Content: "내용", Summary: "요약", Category: "tech", UpdatedAt: baseTime}, nil))
+		stored, err := repo.GetPostByID(ctx, "post1")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"go"}, stored.Tags)
+
+		require.NoError(t, repo.UpdatePost(ctx, &model.Post{PostID: "post1", Title: "수정", Content: "내용", Summary: "요약", Category: "tech", Tags: []string{"db", "go"}, UpdatedAt: baseTime}, nil))
+		stored, err = repo.GetPostByID(ctx, "post1")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"db", "go"}, stored.Tags)
+
+		require.NoError(t, repo.UpdatePost(ctx, &model.Post{PostID: "post1", Title: "수정", Content: "내용", Summary: "요약", Category: "tech", Tags: []string{}, UpdatedAt: baseTime}, nil))
+		stored, err = repo.GetPostByID(ctx, "post1")
+		require.NoError(t, err)
+		assert.Empty(t, stored.Tags)
+	})
+}
+
+// RunCommentRepositoryConformance는 댓글 저장소 공통 동작을 검증합니다.
+func RunCommentRepositoryConformance(t *testing.T, newRepo func(t *testing.T) repository.CommentRepositoryInterface) {
+	ctx := context.Background()
+
+	createComments := func(t *testing.T, repo repository.CommentRepositoryInterface) []*model.Comment {
+		t.Helper()
+		var created []*model.Comment
+		for i, postID := range []string{"post1", "post1", "post2"} {
+			comment, err := repo.CreateComment(ctx, &model.Comment{
+				PostID:   postID,
+				Nickname: fmt.Sprintf("사용자%d", i),
+				Content:  fmt.Sprintf("댓글 %d", i),
+			})
+			require.NoError(t, err)
+			created = append(created, comment)
+		}
+		return created
+	}
+
+	// [GIVEN] 댓글을 생성한 경우
+	// [WHEN] 게시글 ID로 댓글 조회
+	// [THEN] ID와 작성 시간이 채워진 댓글이 등록순으로 반환 확인
+	t.Run("CreateAndListByPost", func(t *testing.T) {
+		repo := newRepo(t)
+		created := createComments(t, repo)
+
+		assert.NotEmpty(t, created[0].CommentID)
+		assert.False(t, created[0].CreatedAt.IsZero())
+
+		postID := "post1"
+		comments, err := repo.GetComments(ctx, &repository.GetCommentsInput{PostID: &postID})
+		require.NoError(t, err)
+		require.Len(t, comments, 2)
+		assert.Equal(t, created[0].CommentID, comments[0].CommentID)
+		assert.Equal(t, created[1].CommentID, comments[1].CommentID)
+		assert.Equal(t, "사용자0", comments[0].Nickname)
+		assert.Equal(t, "댓글 0", comments[0].Content)
+
+		all, err := repo.GetComments(ctx, &repository.GetCommentsInput{})
+		require.NoError(t, err)
+		assert.Len(t, all, 3)
+	})
+
+	// [GIVEN] 댓글이 있는 경우
+	// [WHEN] 댓글 ID로 삭제
+	// [THEN] 해당 댓글만 삭제되고, 없는 댓글은 CommentNotFoundError 반환 확인
+	t.Run("DeleteComment", func(t *testing.T) {
+		repo := newRepo(t)
+		created := createComments(t, repo)
+
+		require.NoError(t, repo.DeleteComment(ctx, created[0].CommentID))
+
+		all, err := repo.GetComments(ctx, &repository.GetCommentsInput{})
+		require.NoError(t, err)
+		assert.Len(t, all, 2)
+
+		err = repo.DeleteComment(ctx, created[0].CommentID)
+		assert.IsType(t, &repository.CommentNotFoundError{}, err)
+	})
+
+	// [GIVEN] 여러 게시글의 댓글이 있는 경우
+	// [WHEN] 게시글 ID로 일괄 삭제
+	// [THEN] 해당 게시글의 댓글만 삭제됨을 확인
+	t.Run("DeleteCommentsByPostID", func(t *testing.T) {
+		repo := newRepo(t)
+		createComments(t, repo)
+
+		require.NoError(t, repo.DeleteCommentsByPostID(ctx, "post1"))
+
+		all, err := repo.GetComments(ctx, &repository.GetCommentsInput{})
+		require.NoError(t, err)
+		require.Len(t, all, 1)
+		assert.Equal(t, "post2", all[0].PostID)
+
+		// 댓글이 없는 게시글도 오류 없이 처리
+		assert.NoError(t, repo.DeleteCommentsByPostID(ctx, "post1"))
+	})
+
+	// root ← reply ← nested 답글 구조 생성
+	createThread := func(t *testing.T, repo repository.CommentRepositoryInterface) (root, reply, nested *model.Comment) {
+		t.Helper()
+		var err error
+		root, err = repo.CreateComment(ctx, &model.Comment{PostID: "post1", Nickname: "작성자", Content: "원댓글"})
+		require.NoError(t, err)
+		reply, err = repo.CreateComment(ctx, &model.Comment{PostID: "post1", ParentCommentID: root.CommentID, Depth: 1, Nickname: "답글러", Content: "답글"})
+		require.NoError(t, err)
+		nested, err = repo.CreateComment(ctx, &model.Comment{PostID: "post1", ParentCommentID: reply.CommentID, Depth: 2, Nickname: "답글러2", Content: "답글의 답글"})
+		require.NoError(t, err)
+		return root, reply, nested
+	}
+
+	getPostComments := func(t *testing.T, repo repository.CommentRepositoryInterface) map[string]model.Comment {
+		t.Helper()
+		postID := "post1"
+		comments, err := repo.GetComments(ctx, &repository.GetCommentsInput{PostID: &postID})
+		require.NoError(t, err)
+		byID := make(map[string]model.Comment, len(comments))
+		for _, comment := range comments {
+			byID[comment.CommentID] = comment
+		}
+		return byID
+	}
+
+	// [GIVEN] 답글을 생성한 경우
+	// [WHEN] 댓글 조회
+	// [THEN] 부모 댓글 ID와 중첩 단계가 저장됨 확인
+	t.Run("Replies", func(t *testing.T) {
+		repo := newRepo(t)
+		root, reply, _ := createThread(t, repo)
+
+		comments := getPostComments(t, repo)
+		require.Len(t, comments, 3)
+		assert.Equal(t, "", comments[root.CommentID].ParentCommentID)
+		assert.Equal(t, 0, comments[root.CommentID].Depth)
+		assert.Equal(t, root.CommentID, comments[reply.CommentID].ParentCommentID)
+		assert.Equal(t, 1, comments[reply.CommentID].Depth)
+		assert.False(t, comments[reply.CommentID].Deleted)
+	})
+
+	// [GIVEN] 답글이 있는 댓글
+	// [WHEN] 댓글 삭제
+	// [THEN] 답글은 유지되고 댓글은 작성자와 내용이 지워진 삭제 표시 상태 확인
+	t.Run("DeleteCommentWithRepliesTombstones", func(t *testing.T) {
+		repo := newRepo(t)
+		root, reply, _ := createThread(t, repo)
+
+		require.NoError(t, repo.DeleteComment(ctx, root.CommentID))
+
+		comments := getPostComments(t, repo)
+		require.Len(t, comments, 3)
+		assert.True(t, comments[root.CommentID].Deleted)
+		assert.Empty(t, comments[root.CommentID].Nickname)
+		assert.Empty(t, comments[root.CommentID].Content)
+		assert.Equal(t, "답글", comments[reply.CommentID].Content)
+
+		// 삭제 표시된 댓글을 다시 삭제해도 답글이 있으면 변경 없음
+		require.NoError(t, repo.DeleteComment(ctx, root.CommentID))
+		assert.Len(t, getPostComments(t, repo), 3)
+	})
+
+	// [GIVEN] 삭제 표시된 상위 댓글 아래 마지막 답글
+	// [WHEN] 마지막 답글 삭제
+	// [THEN] 답글이 없어진 삭제 표시 상위 댓글도 함께 삭제됨 확인
+	t.Run("DeleteLastReplyRemovesTombstones", func(t *testing.T) {
+		repo := newRepo(t)
+		root, reply, nested := createThread(t, repo)
+		other, err := repo.CreateComment(ctx, &model.Comment{PostID: "post1", ParentCommentID: root.CommentID, Depth: 1, Nickname: "다른", Content: "다른 답글"})
+		require.NoError(t, err)
+
+		require.NoError(t, repo.DeleteComment(ctx, root.CommentID))
+		require.NoError(t, repo.DeleteComment(ctx, reply.CommentID))
+		require.NoError(t, repo.DeleteComment(ctx, nested.CommentID))
+
+		// reply는 삭제되고, root는 다른 답글이 남아 있어 유지
+		comments := getPostComments(t, repo)
+		require.Len(t, comments, 2)
+		assert.True(t, comments[root.CommentID].Deleted)
+		assert.Contains(t, comments, other.CommentID)
+
+		require.NoError(t, repo.DeleteComment(ctx, other.CommentID))
+		assert.Empty(t, getPostComments(t, repo))
+	})
+
+	// [GIVEN] 상태 없이 생성한 댓글과 검토 대기 댓글
+	// [WHEN] 상태별 조회
+	// [THEN] 상태가 없으면 승인으로 저장되고 상태 필터가 적용됨 확인
+	t.Run("StatusFilter", func(t *testing.T) {
+		repo := newRepo(t)
+		approved, err := repo.CreateComment(ctx, &model.Comment{PostID: "post1", Nickname: "a", Content: "승인"})
+		require.NoError(t, err)
+		assert.Equal(t, model.CommentStatusApproved, approved.Status)
+		pending, err := repo.CreateComment(ctx, &model.Comment{PostID: "post1", Nickname: "b", Content: "대기", Status: model.CommentStatusPending})
+		require.NoError(t, err)
+		_, err = repo.CreateComment(ctx, &model.Comment{PostID: "post2", Nickname: "c", Content: "다른 게시글 대기", Status: model.CommentStatusPending})
+		require.NoError(t, err)
+
+		comments, err := repo.GetComments(ctx, &repository.GetCommentsInput{Status: model.CommentStatusPending})
+		require.NoError(t, err)
+		assert.Len(t, comments, 2)
+
+		postID := "post1"
+		comments, err = repo.GetComments(ctx, &repository.GetCommentsInput{PostID: &postID, Status: model.CommentStatusPending})
+		require.NoError(t, err)
+		require.Len(t, comments, 1)
+		assert.Equal(t, pending.CommentID, comments[0].CommentID)
+		assert.Equal(t, model.CommentStatusPending, comments[0].Status)
+	})
+
+	// [GIVEN] 검토 대기 댓글
+	// [WHEN] 없는 ID와 중복 ID를 포함해 상태 일괄 변경
+	// [THEN] 존재하는 댓글만 한 번씩 변경되어 검토한 댓글로 표시되고, 변경하지 않은 댓글은 그대로인지 확인
+	t.Run("SetCommentStatus", func(t *testing.T) {
+		repo := newRepo(t)
+		first, err := repo.CreateComment(ctx, &model.Comment{PostID: "post1", Nickname: "a", Content: "1", Status: model.CommentStatusPending})
+		require.NoError(t, err)
+		_, err = repo.CreateComment(ctx, &model.Comment{PostID: "post1", Nickname: "c", Content: "3", Status: model.CommentStatusApproved})
+		require.NoError(t, err)
+		second, err := repo.CreateComment(ctx, &model.Comment{PostID: "post2", Nickname: "b", Content: "2", Status: model.CommentStatusPending})
+		require.NoError(t, err)
+
+		updated, err := repo.SetCommentStatus(ctx, []string{first.CommentID, "unknown", second.CommentID, first.CommentID}, model.CommentStatusSpam)
+		require.NoError(t, err)
+		assert.Equal(t, []string{first.CommentID, second.CommentID}, updated)
+
+		comments, err := repo.GetComments(ctx, &repository.GetCommentsInput{Status: model.CommentStatusSpam})
+		require.NoError(t, err)
+		assert.Len(t, comments, 2)
+		for _, comment := range comments {
+			assert.True(t, comment.Moderated)
+		}
+
+		pending, err := repo.GetComments(ctx, &repository.GetCommentsInput{Status: model.CommentStatusPending})
+		require.NoError(t, err)
+		assert.Empty(t, pending)
+
+		approved, err := repo.GetComments(ctx, &repository.GetCommentsInput{Status: model.CommentStatusApproved})
+		require.NoError(t, err)
+		require.Len(t, approved, 1)
+		assert.False(t, approved[0].Moderated)
+	})
+}
+
+// RunCategoryRepositoryConformance는 카테고리 저장소 공통 동작을 검증합니다.
+func RunCategoryRepositoryConformance(t *testing.T, newRepo func(t *testing.T) repository.CategoryRepositoryInterface) {
+	ctx := context.Background()
+
+	// [GIVEN] 여러 카테고리를 등록한 경우
+	// [WHEN] 카테고리 목록 조회
+	// [THEN] Order 순으로 정렬되고 생성 시간이 채워짐을 확인
+	t.Run("UpsertAndList", func(t *testing.T) {
+		repo := newRepo(t)
+		require.NoError(t, repo.UpsertCategory(ctx, model.Category{Category: "life", Order: 2}))
+		require.NoError(t, repo.UpsertCategory(ctx, model.Category{Category: "tech", Order: 1}))
+
+		categories, err := repo.GetCategories(ctx)
+		require.NoError(t, err)
+		require.Len(t, categories, 2)
+		assert.Equal(t, "tech", categories[0].Category)
+		assert.Equal(t, "life", categories[1].Category)
+		assert.False(t, categories[0].CreatedAt.IsZero())
+	})
+
+	// [GIVEN] 이미 존재하는 카테고리
+	// [WHEN] 같은 이름으로 다시 등록
+	// [THEN] 새 항목이 추가되지 않고 순서만 갱신됨을 확인
+	t.Run("UpsertExisting", func(t *testing.T) {
+		repo := newRepo(t)
+		require.NoError(t, repo.UpsertCategory(ctx, model.Category{Category: "tech", Order: 1}))
+		require.NoError(t, repo.UpsertCategory(ctx, model.Category{Category: "life", Order: 2}))
+		require.NoError(t, repo.UpsertCategory(ctx, model.Category{Category: "tech", Order: 3}))
+
+		categories, err := repo.GetCategories(ctx)
+		require.NoError(t, err)
+		require.Len(t, categories, 2)
+		assert.Equal(t, "life", categories[0].Category)
+		assert.Equal(t, "tech", categories[1].Category)
+		assert.Equal(t, 3, categories[1].Order)
+	})
+}
+
+// RunRevisionRepositoryConformance는 게시글 리비전 저장소 공통 동작을 검증합니다.
+func RunRevisionRepositoryConformance(t *testing.T, newRepo func(t *testing.T) repository.RevisionRepositoryInterface) {
+	ctx := context.Background()
+
+	newRevision := func(postID, content, editor string, offset time.Duration) *model.PostRevision {
+		return &model.PostRevision{
+			PostID:    postID,
+			Title:     "제목 " + content,
+			Content:   content,
+			Summary:   "요약 " + content,
+			Category:  "tech",
+			Editor:    editor,
+			CreatedAt: baseTime.Add(offset),
+		}
+	}
+
+	// [GIVEN] 같은 게시글에 리비전을 여러 번 저장한 경우
+	// [WHEN] 리비전 목록 조회
+	// [THEN] 번호가 1부터 할당되고 최신순으로 본문 없이 반환됨을 확인
+	t.Run("CreateAndList", func(t *testing.T) {
+		repo := newRepo(t)
+
+		first := newRevision("post1", "v1", "admin", 0)
+		require.NoError(t, repo.CreateRevision(ctx, first))
+		assert.Equal(t, 1, first.Revision)
+
+		second := newRevision("post1", "v2", "editor", time.Hour)
+		second.RestoredFrom = 1
+		require.NoError(t, repo.CreateRevision(ctx, second))
+		assert.Equal(t, 2, second.Revision)
+
+		other := newRevision("post2", "other", "admin", 0)
+		require.NoError(t, repo.CreateRevision(ctx, other))
+		assert.Equal(t, 1, other.Revision)
+
+		revisions, err := repo.GetRevisions(ctx, "post1")
+		require.NoError(t, err)
+		require.Len(t, revisions, 2)
+		assert.Equal(t, 2, revisions[0].Revision)
+		assert.Equal(t, 1, revisions[1].Revision)
+		assert.Equal(t, "editor", revisions[0].Editor)
+		assert.Equal(t, 1, revisions[0].RestoredFrom)
+		assert.Equal(t, "제목 v2", revisions[0].Title)
+		assert.Empty(t, revisions[0].Content)
+		assert.True(t, baseTime.Add(time.Hour).Equal(revisions[0].CreatedAt))
+	})
+
+	// [GIVEN] 저장된 리비전
+	// [WHEN] 번호로 단건 조회
+	// [THEN] 전체 스냅샷이 반환되고 없는 번호는 nil임을 확인
+	t.Run("GetRevision", func(t *testing.T) {
+		repo := newRepo(t)
+		require.NoError(t, repo.CreateRevision(ctx, newRevision("post1", "v1", "admin", 0)))
+
+		revision, err := repo.GetRevision(ctx, "post1", 1)
+		require.NoError(t, err)
+		require.NotNil(t, revision)
+		assert.Equal(t, "v1", revision.Content)
+		assert.Equal(t, "요약 v1", revision.Summary)
+		assert.Equal(t, "tech", revision.Category)
+		assert.Equal(t, "admin", revision.Editor)
+
+		missing, err := repo.GetRevision(ctx, "post1", 2)
+		assert.NoError(t, err)
+		assert.Nil(t, missing)
+
+		missing, err = repo.GetRevision(ctx, "post2", 1)
+		assert.NoError(t, err)
+		assert.Nil(t, missing)
+	})
+
+	// [GIVEN] 여러 게시글의 리비전
+	// [WHEN] 한 게시글의 리비전 삭제
+	// [THEN] 해당 게시글 리비전만 삭제됨을 확인
+	t.Run("DeleteByPostID", func(t *testing.T) {
+		repo := newRepo(t)
+		require.NoError(t, repo.CreateRevision(ctx, newRevision("post1", "v1", "admin", 0)))
+		require.NoError(t, repo.CreateRevision(ctx, newRevision("post2", "v1", "admin", 0)))
+
+		require.NoError(t, repo.DeleteRevisionsByPostID(ctx, "post1"))
+
+		revisions, err := repo.GetRevisions(ctx, "post1")
+		require.NoError(t, err)
+		assert.Empty(t, revisions)
+
+		revisions, err = repo.GetRevisions(ctx, "post2")
+		require.NoError(t, err)
+		assert.Len(t, revisions, 1)
+	})
+}
+
+// RunTagRepositoryConformance는 태그 색인 저장소 공통 동작을 검증합니다.
+func RunTagRepositoryConformance(t *testing.T, newRepo func(t *testing.T) repository.TagRepositoryInterface) {
+	ctx := context.Background()
+
+	// [GIVEN] 발행된 게시글과 초안에 태그를 색인한 경우
+	// [WHEN] 태그 목록과 태그별 게시글 조회
+	// [THEN] 발행된 게시글 수 내림차순으로 집계되고, 게시글 ID는 상태와 관계없이 반환됨을 확인
+	t.Run("SetAndList", func(t *testing.T) {
+		repo := newRepo(t)
+		require.NoError(t, repo.SetPostTags(ctx, "post1", []string{"go", "db"}, true))
+		require.NoError(t, repo.SetPostTags(ctx, "post2", []string{"go"}, true))
+		require.NoError(t, repo.SetPostTags(ctx, "post3", []string{"go", "draft"}, false))
+		require.NoError(t, repo.SetPostTags(ctx, "post4", []string{"api"}, true))
+
+		tags, err := repo.GetTags(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []model.Tag{{Tag: "go", Count: 2}, {Tag: "api", Count: 1}, {Tag: "db", Count: 1}}, tags)
+
+		postIDs, err := repo.GetPostIDsByTag(ctx, "go")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"post1", "post2", "post3"}, postIDs)
+
+		missing, err := repo.GetPostIDsByTag(ctx, "missing")
+		require.NoError(t, err)
+		assert.Empty(t, missing)
+	})
+
+	// [GIVEN] 태그가 색인된 게시글
+	// [WHEN] 다른 태그와 공개 여부로 다시 색인
+	// [THEN] 빠진 태그는 제거되고 공개 여부가 갱신됨을 확인
+	t.Run("Replace", func(t *testing.T) {
+		repo := newRepo(t)
+		require.NoError(t, repo.SetPostTags(ctx, "post1", []string{"go", "db"}, false))
+		require.NoError(t, repo.SetPostTags(ctx, "post1", []string{"go", "api"}, true))
+
+		tags, err := repo.GetTags(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []model.Tag{{Tag: "api", Count: 1}, {Tag: "go", Count: 1}}, tags)
+
+		postIDs, err := repo.GetPostIDsByTag(ctx, "db")
+		require.NoError(t, err)
+		assert.Empty(t, postIDs)
+	})
+
+	// [GIVEN] 여러 게시글의 태그 색인
+	// [WHEN] 한 게시글의 색인 삭제
+	// [THEN] 해당 게시글만 제거되고 색인이 없는 게시글도 오류 없이 처리됨을 확인
+	t.Run("DeletePostTags", func(t *testing.T) {
+		repo := newRepo(t)
+		require.NoError(t, repo.SetPostTags(ctx, "post1", []string{"go"}, true))
+		require.NoError(t, repo.SetPostTags(ctx, "post2", []string{"go"}, true))
+
+		require.NoError(t, repo.DeletePostTags(ctx, "post1"))
+		assert.NoError(t, repo.DeletePostTags(ctx, "missing"))
+
+		postIDs, err := repo.GetPostIDsByTag(ctx, "go")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"post2"}, postIDs)
+	})
+}
+
+// RunTaggedPostRepositoryConformance는 게시글 쓰기가 태그 색인에 반영되는지 검증합니다.
+func RunTaggedPostRepositoryConformance(t *testing.T, newRepos func(t *testing.T) (repository.PostRepositoryInterface, repository.TagRepositoryInterface)) {
+	ctx := context.Background()
+
+	// [GIVEN] 태그 색인으로 감싼 게시글 저장소
+	// [WHEN] 게시글 생성, 태그 수정, 상태 변경, 삭제
+	// [THEN] 태그 목록과 게시글 수가 각 변경을 따라가는지 확인
+	t.Run("KeepsIndexInSync", func(t *testing.T) {
+		postRepo, tagRepo := newRepos(t)
+		logger := utils.NewLogger(utils.NewMemorySink())
+		defer logger.Close(ctx)
+		repo := repository.NewTaggedPostRepository(postRepo, tagRepo, logger)
+
+		post := model.Post{PostID: "post1", Title: "제목", Content: "내용", Summary: "요약", Category: "tech", Tags: []string{"go", "db"}, CreatedAt: baseTime, UpdatedAt: baseTime}
+		require.NoError(t, repo.CreatePost(ctx, &post))
+		tags, err := tagRepo.GetTags(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []model.Tag{{Tag: "db", Count: 1}, {Tag: "go", Count: 1}}, tags)
+
+		// 태그를 생략한 수정은 색인 유지
+		require.NoError(t, repo.UpdatePost(ctx, &model.Post{PostID: "post1", Title: "수정", Content: "내용", Summary: "요약", Category: "tech", UpdatedAt: baseTime}, nil))
+		tags, err = tagRepo.GetTags(ctx)
+		require.NoError(t, err)
+		assert.Len(t, tags, 2)
+
+		require.NoError(t, repo.UpdatePost(ctx, &model.Post{PostID: "post1", Title: "수정", Content: "내용", Summary: "요약", Category: "tech", Tags: []string{"go"}, UpdatedAt: baseTime}, nil))
+		tags, err = tagRepo.GetTags(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []model.Tag{{Tag: "go", Count: 1}}, tags)
+
+		// 초안으로 바꾸면 집계에서 제외되지만 태그 조회는 가능
+		require.NoError(t, repo.UpdatePostStatus(ctx, "post1", model.PostStatusDraft, nil))
+		tags, err = tagRepo.GetTags(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, tags)
+		postIDs, err := tagRepo.GetPostIDsByTag(ctx, "go")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"post1"}, postIDs)
+
+		require.NoError(t, repo.DeletePost(ctx, "post1"))
+		postIDs, err = tagRepo.GetPostIDsByTag(ctx, "go")
+		require.NoError(t, err)
+		assert.Empty(t, postIDs)
+	})
+}
+
+// RunUserRepositoryConformance는 관리자 계정 저장소 공통 동작을 검증합니다.
+func RunUserRepositoryConformance(t *testing.T, newRepo func(t *testing.T) repository.UserRepositoryInterface) {
+	ctx := context.Background()
+
+	// [GIVEN] 여러 관리자 계정을 저장한 경우
+	// [WHEN] 목록과 사용자 이름으로 조회
+	// [THEN] 사용자 이름순 목록과 저장한 내용, 없는 계정은 nil 반환 확인
+	t.Run("CreateAndGet", func(t *testing.T) {
+		repo := newRepo(t)
+		require.NoError(t, repo.CreateUser(ctx, &model.User{Username: "moderator1", PasswordHash: "hash-m", Role: model.UserRoleModerator}))
+		require.NoError(t, repo.CreateUser(ctx, &model.User{Username: "admin", PasswordHash: "hash-a", Role: model.UserRoleOwner}))
+
+		users, err := repo.GetUsers(ctx)
+		require.NoError(t, err)
+		require.Len(t, users, 2)
+		assert.Equal(t, "admin", users[0].Username)
+		assert.Equal(t, "moderator1", users[1].Username)
+
+		user, err := repo.GetUser(ctx, "moderator1")
+		require.NoError(t, err)
+		require.NotNil(t, user)
+		assert.Equal(t, "hash-m", user.PasswordHash)
+		assert.Equal(t, model.UserRoleModerator, user.Role)
+		assert.False(t, user.CreatedAt.IsZero())
+
+		missing, err := repo.GetUser(ctx, "missing")
+		require.NoError(t, err)
+		assert.Nil(t, missing)
+	})
+
+	// [GIVEN] 이미 존재하는 사용자 이름
+	// [WHEN] 같은 이름으로 계정 생성
+	// [THEN] UserExistsError 반환 확인
+	t.Run("CreateDuplicate", func(t *testing.T) {
+		repo := newRepo(t)
+		require.NoError(t, repo.CreateUser(ctx, &model.User{Username: "admin", PasswordHash: "hash", Role: model.UserRoleOwner}))
+
+		err := repo.CreateUser(ctx, &model.User{Username: "admin", PasswordHash: "other", Role: model.UserRoleEditor})
+		var existsErr *repository.UserExistsError
+		assert.ErrorAs(t, err, &existsErr)
+	})
+
+	// [GIVEN] 저장된 계정
+	// [WHEN] 역할, 비밀번호 해시, 2단계 인증 설정을 수정하고 삭제
+	// [THEN] 변경 내용이 반영되고, 없는 계정은 UserNotFoundError 반환 확인
+	t.Run("UpdateAndDelete", func(t *testing.T) {
+		repo := newRepo(t)
+		require.NoError(t, repo.CreateUser(ctx, &model.User{Username: "editor1", PasswordHash: "hash", Role: model.UserRoleEditor}))
+
+		require.NoError(t, repo.UpdateUser(ctx, &model.User{Username: "editor1", PasswordHash: "new-hash", Role: model.UserRoleModerator, UpdatedAt: baseTime}))
+		user, err := repo.GetUser(ctx, "editor1")
+		require.NoError(t, err)
+		require.NotNil(t, user)
+		assert.Equal(t, "new-hash", user.PasswordHash)
+		assert.Equal(t, model.UserRoleModerator, user.Role)
+		assert.False(t, user.TOTPEnabled)
+		assert.Empty(t, user.RecoveryCodes)
+
+		// 2단계 인증 설정
+		user.TOTPEnabled = true
+		user.TOTPSecret = "SECRET"
+		user.TOTPLastStep = 42
+		user.RecoveryCodes = []string{"code-hash-1", "code-hash-2"}
+		require.NoError(t, repo.UpdateUser(ctx, user))
+		user, err = repo.GetUser(ctx, "editor1")
+		require.NoError(t, err)
+		require.NotNil(t, user)
+		assert.True(t, user.TOTPEnabled)
+		assert.Equal(t, "SECRET", user.TOTPSecret)
+		assert.Equal(t, int64(42), user.TOTPLastStep)
+		assert.Equal(t, []string{"code-hash-1", "code-hash-2"}, user.RecoveryCodes)
+
+		var notFoundErr *repository.UserNotFoundError
+		assert.ErrorAs(t, repo.UpdateUser(ctx, &model.User{Username: "missing", Role: model.UserRoleEditor}), &notFoundErr)
+
+		require.NoError(t, repo.DeleteUser(ctx, "editor1"))
+		user, err = repo.GetUser(ctx, "editor1")
+		require.NoError(t, err)
+		assert.Nil(t, user)
+		assert.ErrorAs(t, repo.DeleteUser(ctx, "editor1"), &notFoundErr)
+	})
+}
+
+// RunAPITokenRepositoryConformance는 API 토큰 저장소 공통 동작을 검증합니다.
+func RunAPITokenRepositoryConformance(t *testing.T, newRepo func(t *testing.T) repository.APITokenRepositoryInterface) {
+	ctx := context.Background()
+
+	newToken := func(tokenID, username string, offset time.Duration) *model.APIToken {
+		return &model.APIToken{
+			TokenID:   tokenID,
+			Username:  username,
+			Name:      "토큰 " + tokenID,
+			Scopes:    []string{model.APITokenScopePostsWrite, model.APITokenScopeImagesWrite},
+			TokenHash: "hash-" + tokenID,
+			CreatedAt: baseTime.Add(offset),
+			ExpiresAt: baseTime.Add(offset + 90*24*time.Hour),
+		}
+	}
+
+	// [GIVEN] 여러 계정이 발급한 토큰
+	// [WHEN] 계정별 목록과 토큰 ID로 조회
+	// [THEN] 해당 계정의 토큰만 발급 시간순으로 반환하고, 없는 토큰은 nil 반환 확인
+	t.Run("CreateAndGet", func(t *testing.T) {
+		repo := newRepo(t)
+		require.NoError(t, repo.CreateToken(ctx, newToken("token2", "editor1", time.Hour)))
+		require.NoError(t, repo.CreateToken(ctx, newToken("token1", "editor1", 0)))
+		require.NoError(t, repo.CreateToken(ctx, newToken("token3", "admin", 0)))
+
+		tokens, err := repo.GetTokens(ctx, "editor1")
+		require.NoError(t, err)
+		require.Len(t, tokens, 2)
+		assert.Equal(t, "token1", tokens[0].TokenID)
+		assert.Equal(t, "token2", tokens[1].TokenID)
+
+		token, err := repo.GetToken(ctx, "token2")
+		require.NoError(t, err)
+		require.NotNil(t, token)
+		assert.Equal(t, "editor1", token.Username)
+		assert.Equal(t, "hash-token2", token.TokenHash)
+		assert.Equal(t, []string{model.APITokenScopePostsWrite, model.APITokenScopeImagesWrite}, token.Scopes)
+		assert.True(t, baseTime.Add(time.Hour+90*24*time.Hour).Equal(token.ExpiresAt))
+		assert.Nil(t, token.LastUsedAt)
+
+		missing, err := repo.GetToken(ctx, "missing")
+		require.NoError(t, err)
+		assert.Nil(t, missing)
+
+		empty, err := repo.GetTokens(ctx, "nobody")
+		require.NoError(t, err)
+		assert.Empty(t, empty)
+	})
+
+	// [GIVEN] 발급한 토큰
+	// [WHEN] 사용 시간 기록 후 삭제
+	// [THEN] 마지막 사용 시간이 저장되고, 없는 토큰은 APITokenNotFoundError 반환 확인
+	t.Run("TouchAndDelete", func(t *testing.T) {
+		repo := newRepo(t)
+		require.NoError(t, repo.CreateToken(ctx, newToken("token1", "editor1", 0)))
+
+		usedAt := baseTime.Add(2 * time.Hour)
+		require.NoError(t, repo.TouchToken(ctx, "token1", usedAt))
+		token, err := repo.GetToken(ctx, "token1")
+		require.NoError(t, err)
+		require.NotNil(t, token.LastUsedAt)
+		assert.True(t, usedAt.Equal(*token.LastUsedAt))
+
+		var notFoundErr *repository.APITokenNotFoundError
+		assert.ErrorAs(t, repo.TouchToken(ctx, "missing", usedAt), &notFoundErr)
+
+		require.NoError(t, repo.DeleteToken(ctx, "token1"))
+		token, err = repo.GetToken(ctx, "token1")
+		require.NoError(t, err)
+		assert.Nil(t, token)
+		assert.ErrorAs(t, repo.DeleteToken(ctx, "token1"), &notFoundErr)
+	})
+
+	// [GIVEN] 여러 계정이 발급한 토큰
+	// [WHEN] 한 계정의 토큰 일괄 삭제
+	// [THEN] 해당 계정의 토큰만 삭제됨을 확인
+	t.Run("DeleteTokensByUsername", func(t *testing.T) {
+		repo := newRepo(t)
+		require.NoError(t, repo.CreateToken(ctx, newToken("token1", "editor1", 0)))
+		require.NoError(t, repo.CreateToken(ctx, newToken("token2", "editor1", time.Hour)))
+		require.NoError(t, repo.CreateToken(ctx, newToken("token3", "admin", 0)))
+
+		require.NoError(t, repo.DeleteTokensByUsername(ctx, "editor1"))
+		assert.NoError(t, repo.DeleteTokensByUsername(ctx, "nobody"))
+
+		tokens, err := repo.GetTokens(ctx, "editor1")
+		require.NoError(t, err)
+		assert.Empty(t, tokens)
+		token, err := repo.GetToken(ctx, "token3")
+		require.NoError(t, err)
+		assert.NotNil(t, token)
+	})
+}
+
+func RunSessionRepositoryConformance(t *testing.T, newRepo func(t *testing.T) repository.SessionRepositoryInterface) {
+	ctx := context.Background()
+
+	newSession := func(sessionID, username string, offset time.Duration) *model.Session {
+		return &model.Session{
+			SessionID:  sessionID,
+			Username:   username,
+			IP:         "203.0.113.10",
+			UserAgent:  "Mozilla/5.0",
+			LoginTime:  baseTime,
+			LastSeenAt: baseTime.Add(offset),
+			ExpiresAt:  baseTime.Add(offset + 2*time.Hour),
+			Data:       []byte("data-" + sessionID),
+		}
+	}
+
+	// [GIVEN] 여러 계정의 세션
+	// [WHEN] 계정별 목록과 세션 ID로 조회, 같은 ID로 다시 저장
+	// [THEN] 해당 계정의 세션만 최근 사용순으로 반환하고, 다시 저장하면 덮어쓰며, 없는 세션은 nil 반환 확인
+	t.Run("SaveAndGet", func(t *testing.T) {
+		repo := newRepo(t)
+		require.NoError(t, repo.SaveSession(ctx, newSession("session1", "editor1", 0)))
+		require.NoError(t, repo.SaveSession(ctx, newSession("session2", "editor1", time.Hour)))
+		require.NoError(t, repo.SaveSession(ctx, newSession("session3", "admin", 0)))
+
+		sessions, err := repo.GetSessions(ctx, "editor1")
+		require.NoError(t, err)
+		require.Len(t, sessions, 2)
+		assert.Equal(t, "session2", sessions[0].SessionID)
+		assert.Equal(t, "session1", sessions[1].SessionID)
+
+		session, err := repo.GetSession(ctx, "session2")
+		require.NoError(t, err)
+		require.NotNil(t, session)
+		assert.Equal(t, "editor1", session.Username)
+		assert.Equal(t, "203.0.113.10", session.IP)
+		assert.Equal(t, "Mozilla/5.0", session.UserAgent)
+		assert.True(t, baseTime.Equal(session.LoginTime))
+		assert.True(t, baseTime.Add(3*time.Hour).Equal(session.ExpiresAt))
+		assert.Equal(t, []byte("data-session2"), session.Data)
+
+		updated := newSession("session2", "editor1", time.Hour)
+		updated.Data = []byte("updated")
+		require.NoError(t, repo.SaveSession(ctx, updated))
+		session, err = repo.GetSession(ctx, "session2")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("updated"), session.Data)
+
+		missing, err := repo.GetSession(ctx, "missing")
+		require.NoError(t, err)
+		assert.Nil(t, missing)
+
+		empty, err := repo.GetSessions(ctx, "nobody")
+		require.NoError(t, err)
+		assert.Empty(t, empty)
+	})
+
+	// [GIVEN] 저장된 세션
+	// [WHEN] 사용 시간 갱신 후 삭제
+	// [THEN] 마지막 사용 시간과 만료 시간이 갱신되고, 없는 세션은 SessionNotFoundError 반환 확인
+	t.Run("TouchAndDelete", func(t *testing.T) {
+		repo := newRepo(t)
+		require.NoError(t, repo.SaveSession(ctx, newSession("session1", "editor1", 0)))
+
+		seenAt := baseTime.Add(30 * time.Minute)
+		require.NoError(t, repo.TouchSession(ctx, "session1", seenAt, seenAt.Add(2*time.Hour)))
+		session, err := repo.GetSession(ctx, "session1")
+		require.NoError(t, err)
+		assert.True(t, seenAt.Equal(session.LastSeenAt))
+		assert.True(t, seenAt.Add(2*time.Hour).Equal(session.ExpiresAt))
+		assert.Equal(t, []byte("data-session1"), session.Data)
+
+		var notFoundErr *repository.SessionNotFoundError
+		assert.ErrorAs(t, repo.TouchSession(ctx, "missing", seenAt, seenAt), &notFoundErr)
+
+		require.NoError(t, repo.DeleteSession(ctx, "session1"))
+		session, err = repo.GetSession(ctx, "session1")
+		require.NoError(t, err)
+		assert.Nil(t, session)
+		assert.ErrorAs(t, repo.DeleteSession(ctx, "session1"), &notFoundErr)
+	})
+
+	// [GIVEN] 만료된 세션과 유효한 세션
+	// [WHEN] 만료된 세션 일괄 삭제
+	// [THEN] 만료 시간이 지난 세션만 삭제되고 삭제 건수 반환 확인
+	t.Run("DeleteExpiredSessions", func(t *testing.T) {
+		repo := newRepo(t)
+		require.NoError(t, repo.SaveSession(ctx, newSession("session1", "editor1", 0)))
+		require.NoError(t, repo.SaveSession(ctx, newSession("session2", "editor1", time.Hour)))
+		require.NoError(t, repo.SaveSession(ctx, newSession("session3", "admin", 2*time.Hour)))
+
+		deleted, err := repo.DeleteExpiredSessions(ctx, baseTime.Add(3*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 2, deleted)
+
+		sessions, err := repo.GetSessions(ctx, "editor1")
+		require.NoError(t, err)
+		assert.Empty(t, sessions)
+		session, err := repo.GetSession(ctx, "session3")
+		require.NoError(t, err)
+		assert.NotNil(t, session)
+	})
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+
+	"bumsiku/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// [GIVEN] 서명 키가 다른 커서 서명기와 서명 키를 설정하지 않은 서명기
+// [WHEN] 다른 키로 서명한 커서와 빈 키로 위조한 커서를 검증
+// [THEN] 같은 키로 서명한 커서만 통과하고 나머지는 InvalidCursorError 반환 확인
+func TestCursorSigner(t *testing.T) {
+	signer := repository.NewCursorSigner([]byte("secret-a"))
+	cursor, err := signer.Encode("scope", map[string]string{"offset": "10"})
+	require.NoError(t, err)
+
+	key, err := signer.Decode(cursor, "scope")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"offset": "10"}, key)
+
+	_, err = repository.NewCursorSigner([]byte("secret-b")).Decode(cursor, "scope")
+	assert.IsType(t, &repository.InvalidCursorError{}, err)
+
+	// 서명 키가 없어도 빈 키로 서명한 커서는 통과하지 못함
+	encoded := base64.RawURLEncoding.EncodeToString([]byte(`{"s":"scope","k":{"offset":"0"}}`))
+	mac := hmac.New(sha256.New, nil)
+	mac.Write([]byte(encoded))
+	forged := encoded + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+	_, err = repository.NewCursorSigner(nil).Decode(forged, "scope")
+	assert.IsType(t, &repository.InvalidCursorError{}, err)
+}
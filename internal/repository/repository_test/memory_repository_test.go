@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"testing"
+
+	"bumsiku/internal/repository"
+)
+
+func TestMemoryPostRepository(t *testing.T) {
+	RunPostRepositoryConformance(t, func(t *testing.T) repository.PostRepositoryInterface {
+		return repository.NewMemoryPostRepository(repository.NewCursorSigner(nil))
+	})
+}
+
+func TestMemoryCommentRepository(t *testing.T) {
+	RunCommentRepositoryConformance(t, func(t *testing.T) repository.CommentRepositoryInterface {
+		return repository.NewMemoryCommentRepository()
+	})
+}
+
+func TestMemoryCategoryRepository(t *testing.T) {
+	RunCategoryRepositoryConformance(t, func(t *testing.T) repository.CategoryRepositoryInterface {
+		return repository.NewMemoryCategoryRepository()
+	})
+}
+
+func TestMemoryRevisionRepository(t *testing.T) {
+	RunRevisionRepositoryConformance(t, func(t *testing.T) repository.RevisionRepositoryInterface {
+		return repository.NewMemoryRevisionRepository()
+	})
+}
+
+func TestMemoryTagRepository(t *testing.T) {
+	RunTagRepositoryConformance(t, func(t *testing.T) repository.TagRepositoryInterface {
+		return repository.NewMemoryTagRepository()
+	})
+}
+
+func TestMemoryTaggedPostRepository(t *testing.T) {
+	RunTaggedPostRepositoryConformance(t, func(t *testing.T) (repository.PostRepositoryInterface, repository.TagRepositoryInterface) {
+		return repository.NewMemoryPostRepository(repository.NewCursorSigner(nil)), repository.NewMemoryTagRepository()
+	})
+}
+
+func TestMemoryUserRepository(t *testing.T) {
+	RunUserRepositoryConformance(t, func(t *testing.T) repository.UserRepositoryInterface {
+		return repository.NewMemoryUserRepository()
+	})
+}
+
+func TestMemoryAPITokenRepository(t *testing.T) {
+	RunAPITokenRepositoryConformance(t, func(t *testing.T) repository.APITokenRepositoryInterface {
+		return repository.NewMemoryAPITokenRepository()
+	})
+}
+
+func TestMemorySessionRepository(t *testing.T) {
+	RunSessionRepositoryConformance(t, func(t *testing.T) repository.SessionRepositoryInterface {
+		return repository.NewMemorySessionRepository()
+	})
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"database/sql"
+	"testing"
+
+	"bumsiku/internal/repository"
+
+	"github.com/stretchr/testify/require"
+)
+
+// openTestSQLite는 테스트마다 독립된 메모리 SQLite 데이터베이스를 엽니다.
+func openTestSQLite(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := repository.OpenSQLite(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestSQLitePostRepository(t *testing.T) {
+	RunPostRepositoryConformance(t, func(t *testing.T) repository.PostRepositoryInterface {
+		return repository.NewSQLitePostRepository(openTestSQLite(t), repository.NewCursorSigner(nil))
+	})
+}
+
+func TestSQLiteCommentRepository(t *testing.T) {
+	RunCommentRepositoryConformance(t, func(t *testing.T) repository.CommentRepositoryInterface {
+		return repository.NewSQLiteCommentRepository(openTestSQLite(t))
+	})
+}
+
+func TestSQLiteCategoryRepository(t *testing.T) {
+	RunCategoryRepositoryConformance(t, func(t *testing.T) repository.CategoryRepositoryInterface {
+		return repository.NewSQLiteCategoryRepository(openTestSQLite(t))
+	})
+}
+
+func TestSQLiteRevisionRepository(t *testing.T) {
+	RunRevisionRepositoryConformance(t, func(t *testing.T) repository.RevisionRepositoryInterface {
+		return repository.NewSQLiteRevisionRepository(openTestSQLite(t))
+	})
+}
+
+func TestSQLiteTagRepository(t *testing.T) {
+	RunTagRepositoryConformance(t, func(t *testing.T) repository.TagRepositoryInterface {
+		return repository.NewSQLiteTagRepository(openTestSQLite(t))
+	})
+}
+
+func TestSQLiteTaggedPostRepository(t *testing.T) {
+	RunTaggedPostRepositoryConformance(t, func(t *testing.T) (repository.PostRepositoryInterface, repository.TagRepositoryInterface) {
+		db := openTestSQLite(t)
+		return repository.NewSQLitePostRepository(db, repository.NewCursorSigner(nil)), repository.NewSQLiteTagRepository(db)
+	})
+}
+
+func TestSQLiteUserRepository(t *testing.T) {
+	RunUserRepositoryConformance(t, func(t *testing.T) repository.UserRepositoryInterface {
+		return repository.NewSQLiteUserRepository(openTestSQLite(t))
+	})
+}
+
+func TestSQLiteAPITokenRepository(t *testing.T) {
+	RunAPITokenRepositoryConformance(t, func(t *testing.T) repository.APITokenRepositoryInterface {
+		return repository.NewSQLiteAPITokenRepository(openTestSQLite(t))
+	})
+}
+
+func TestSQLiteSessionRepository(t *testing.T) {
+	RunSessionRepositoryConformance(t, func(t *testing.T) repository.SessionRepositoryInterface {
+		return repository.NewSQLiteSessionRepository(openTestSQLite(t))
+	})
+}
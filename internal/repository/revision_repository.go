@@ -0,0 +1,217 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"bumsiku/internal/model"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+const RevisionTableName = "blog_post_revisions"
+
+// revisionWriteAttempts는 리비전 번호 충돌 시 재시도 횟수입니다.
+const revisionWriteAttempts = 3
+
+type RevisionRepositoryInterface interface {
+	// CreateRevision은 다음 리비전 번호를 할당하여 리비전을 저장합니다.
+	CreateRevision(ctx context.Context, revision *model.PostRevision) error
+	// GetRevisions는 게시글의 리비전을 최신순으로 반환합니다. Content 필드는 제외합니다.
+	GetRevisions(ctx context.Context, postID string) ([]model.PostRevision, error)
+	// GetRevision은 특정 리비전을 반환합니다. 없으면 nil을 반환합니다.
+	GetRevision(ctx context.Context, postID string, revision int) (*model.PostRevision, error)
+	DeleteRevisionsByPostID(ctx context.Context, postID string) error
+}
+
+type RevisionRepository struct {
+	client *dynamodb.Client
+}
+
+func NewRevisionRepository(client *dynamodb.Client) *RevisionRepository {
+	return &RevisionRepository{client: client}
+}
+
+func (r *RevisionRepository) CreateRevision(ctx context.Context, revision *model.PostRevision) error {
+	// 동시 저장으로 같은 번호가 할당되면 조건부 쓰기가 실패하므로 번호를 다시 계산
+	for attempt := 0; attempt < revisionWriteAttempts; attempt++ {
+		latest, err := r.latestRevision(ctx, revision.PostID)
+		if err != nil {
+			return err
+		}
+		revision.Revision = latest + 1
+
+		item, err := attributevalue.MarshalMap(revision)
+		if err != nil {
+			return err
+		}
+
+		expr, err := expression.NewBuilder().
+			WithCondition(expression.AttributeNotExists(expression.Name("revision"))).
+			Build()
+		if err != nil {
+			return err
+		}
+
+		_, err = r.client.PutItem(ctx, &dynamodb.PutItemInput{
+			TableName:                aws.String(RevisionTableName),
+			Item:                     item,
+			ConditionExpression:      expr.Condition(),
+			ExpressionAttributeNames: expr.Names(),
+		})
+		if err == nil {
+			return nil
+		}
+
+		var conditionErr *types.ConditionalCheckFailedException
+		if !errors.As(err, &conditionErr) {
+			return err
+		}
+	}
+
+	return errors.New("리비전 번호 할당 실패: " + revision.PostID)
+}
+
+// latestRevision은 게시글의 마지막 리비전 번호를 반환합니다. 리비전이 없으면 0입니다.
+func (r *RevisionRepository) latestRevision(ctx context.Context, postID string) (int, error) {
+	keyCondition := expression.Key("postId").Equal(expression.Value(postID))
+	projection := expression.NamesList(expression.Name("revision"))
+	expr, err := expression.NewBuilder().WithKeyCondition(keyCondition).WithProjection(projection).Build()
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := r.client.Query(ctx, &dynamodb.QueryInput{
+		TableName:                 aws.String(RevisionTableName),
+		KeyConditionExpression:    expr.KeyCondition(),
+		ProjectionExpression:      expr.Projection(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		ScanIndexForward:          aws.Bool(false),
+		Limit:                     aws.Int32(1),
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(result.Items) == 0 {
+		return 0, nil
+	}
+
+	var latest model.PostRevision
+	if err := attributevalue.UnmarshalMap(result.Items[0], &latest); err != nil {
+		return 0, err
+	}
+	return latest.Revision, nil
+}
+
+func (r *RevisionRepository) GetRevisions(ctx context.Context, postID string) ([]model.PostRevision, error) {
+	keyCondition := expression.Key("postId").Equal(expression.Value(postID))
+	projection := expression.NamesList(
+		expression.Name("postId"),
+		expression.Name("revision"),
+		expression.Name("title"),
+		expression.Name("summary"),
+		expression.Name("category"),
+		expression.Name("editor"),
+		expression.Name("restoredFrom"),
+		expression.Name("createdAt"),
+	)
+	expr, err := expression.NewBuilder().WithKeyCondition(keyCondition).WithProjection(projection).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]model.PostRevision, 0)
+	var startKey map[string]types.AttributeValue
+	for {
+		result, err := r.client.Query(ctx, &dynamodb.QueryInput{
+			TableName:                 aws.String(RevisionTableName),
+			KeyConditionExpression:    expr.KeyCondition(),
+			ProjectionExpression:      expr.Projection(),
+			ExpressionAttributeNames:  expr.Names(),
+			ExpressionAttributeValues: expr.Values(),
+			ScanIndexForward:          aws.Bool(false), // 최신순 정렬
+			ExclusiveStartKey:         startKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var page []model.PostRevision
+		if err := attributevalue.UnmarshalListOfMaps(result.Items, &page); err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, page...)
+
+		if len(result.LastEvaluatedKey) == 0 {
+			break
+		}
+		startKey = result.LastEvaluatedKey
+	}
+
+	return revisions, nil
+}
+
+func (r *RevisionRepository) GetRevision(ctx context.Context, postID string, revision int) (*model.PostRevision, error) {
+	result, err := r.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(RevisionTableName),
+		Key:       revisionKey(postID, revision),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+
+	var rev model.PostRevision
+	if err := attributevalue.UnmarshalMap(result.Item, &rev); err != nil {
+		return nil, err
+	}
+	return &rev, nil
+}
+
+func (r *RevisionRepository) DeleteRevisionsByPostID(ctx context.Context, postID string) error {
+	revisions, err := r.GetRevisions(ctx, postID)
+	if err != nil {
+		return err
+	}
+
+	var writeRequests []types.WriteRequest
+	for _, rev := range revisions {
+		writeRequests = append(writeRequests, types.WriteRequest{
+			DeleteRequest: &types.DeleteRequest{Key: revisionKey(rev.PostID, rev.Revision)},
+		})
+	}
+
+	// BatchWriteItem은 한 번에 최대 25개 항목만 처리할 수 있으므로 나누어 처리
+	for i := 0; i < len(writeRequests); i += 25 {
+		end := i + 25
+		if end > len(writeRequests) {
+			end = len(writeRequests)
+		}
+
+		_, err := r.client.BatchWriteItem(ctx, &dynamodb.BatchWriteItemInput{
+			RequestItems: map[string][]types.WriteRequest{
+				RevisionTableName: writeRequests[i:end],
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func revisionKey(postID string, revision int) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"postId":   &types.AttributeValueMemberS{Value: postID},
+		"revision": &types.AttributeValueMemberN{Value: strconv.Itoa(revision)},
+	}
+}
@@ -0,0 +1,238 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"time"
+
+	"bumsiku/internal/model"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// SessionTableName은 로그인 세션 테이블입니다. Partition Key로 sessionId를 사용합니다.
+// 만료 시간(Unix 초)을 ttl 속성에 함께 저장하므로, 테이블의 TTL 속성을 ttl로 지정하면 만료된 세션이 자동으로 삭제됩니다.
+const SessionTableName = "blog_sessions"
+
+type SessionRepositoryInterface interface {
+	// GetSession은 세션을 조회합니다. 없으면 nil을 반환하며, 만료 여부는 호출하는 쪽에서 확인합니다.
+	GetSession(ctx context.Context, sessionID string) (*model.Session, error)
+	// SaveSession은 세션을 저장합니다. 같은 ID의 세션이 있으면 덮어씁니다.
+	SaveSession(ctx context.Context, session *model.Session) error
+	// TouchSession은 세션의 마지막 사용 시간과 만료 시간을 갱신합니다. 세션이 없으면 SessionNotFoundError를 반환합니다.
+	TouchSession(ctx context.Context, sessionID string, lastSeenAt, expiresAt time.Time) error
+	// DeleteSession은 세션을 삭제합니다. 세션이 없으면 SessionNotFoundError를 반환합니다.
+	DeleteSession(ctx context.Context, sessionID string) error
+	// GetSessions는 계정의 세션을 최근 사용순으로 반환합니다. 만료된 세션도 포함합니다.
+	GetSessions(ctx context.Context, username string) ([]model.Session, error)
+	// DeleteExpiredSessions는 now 기준으로 만료된 세션을 삭제하고 삭제한 건수를 반환합니다.
+	DeleteExpiredSessions(ctx context.Context, now time.Time) (int, error)
+}
+
+type SessionRepository struct {
+	client *dynamodb.Client
+}
+
+func NewSessionRepository(client *dynamodb.Client) *SessionRepository {
+	return &SessionRepository{client: client}
+}
+
+func (r *SessionRepository) GetSession(ctx context.Context, sessionID string) (*model.Session, error) {
+	result, err := r.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(SessionTableName),
+		Key:       sessionKey(sessionID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+
+	var session model.Session
+	if err := attributevalue.UnmarshalMap(result.Item, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+func (r *SessionRepository) SaveSession(ctx context.Context, session *model.Session) error {
+	item, err := attributevalue.MarshalMap(session)
+	if err != nil {
+		return err
+	}
+	item["ttl"] = sessionTTL(session.ExpiresAt)
+
+	_, err = r.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(SessionTableName),
+		Item:      item,
+	})
+	return err
+}
+
+func (r *SessionRepository) TouchSession(ctx context.Context, sessionID string, lastSeenAt, expiresAt time.Time) error {
+	expr, err := expression.NewBuilder().
+		WithUpdate(expression.
+			Set(expression.Name("lastSeenAt"), expression.Value(lastSeenAt)).
+			Set(expression.Name("expiresAt"), expression.Value(expiresAt)).
+			Set(expression.Name("ttl"), expression.Value(expiresAt.Unix()))).
+		WithCondition(expression.AttributeExists(expression.Name("sessionId"))).
+		Build()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:                 aws.String(SessionTableName),
+		Key:                       sessionKey(sessionID),
+		ConditionExpression:       expr.Condition(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		UpdateExpression:          expr.Update(),
+	})
+
+	var conditionErr *types.ConditionalCheckFailedException
+	if errors.As(err, &conditionErr) {
+		return &SessionNotFoundError{SessionID: sessionID}
+	}
+	return err
+}
+
+func (r *SessionRepository) DeleteSession(ctx context.Context, sessionID string) error {
+	expr, err := expression.NewBuilder().
+		WithCondition(expression.AttributeExists(expression.Name("sessionId"))).
+		Build()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName:                aws.String(SessionTableName),
+		Key:                      sessionKey(sessionID),
+		ConditionExpression:      expr.Condition(),
+		ExpressionAttributeNames: expr.Names(),
+	})
+
+	var conditionErr *types.ConditionalCheckFailedException
+	if errors.As(err, &conditionErr) {
+		return &SessionNotFoundError{SessionID: sessionID}
+	}
+	return err
+}
+
+// GetSessions는 세션 수가 적으므로 username 조건으로 테이블을 스캔합니다.
+func (r *SessionRepository) GetSessions(ctx context.Context, username string) ([]model.Session, error) {
+	filter := expression.Name("username").Equal(expression.Value(username))
+	sessions, err := r.scanSessions(ctx, &filter)
+	if err != nil {
+		return nil, err
+	}
+
+	sortSessions(sessions)
+	return sessions, nil
+}
+
+// DeleteExpiredSessions는 TTL 삭제가 지연되거나 TTL을 설정하지 않은 테이블을 위해 만료된 세션을 직접 삭제합니다.
+// 시간은 문자열로 저장되어 조건식으로 비교할 수 없으므로 전체를 스캔해 확인합니다.
+func (r *SessionRepository) DeleteExpiredSessions(ctx context.Context, now time.Time) (int, error) {
+	sessions, err := r.scanSessions(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var writeRequests []types.WriteRequest
+	for _, session := range sessions {
+		if session.IsExpired(now) {
+			writeRequests = append(writeRequests, types.WriteRequest{
+				DeleteRequest: &types.DeleteRequest{Key: sessionKey(session.SessionID)},
+			})
+		}
+	}
+
+	// BatchWriteItem은 한 번에 최대 25개 항목만 처리할 수 있으므로 나누어 처리
+	deleted := 0
+	for i := 0; i < len(writeRequests); i += 25 {
+		end := i + 25
+		if end > len(writeRequests) {
+			end = len(writeRequests)
+		}
+
+		_, err := r.client.BatchWriteItem(ctx, &dynamodb.BatchWriteItemInput{
+			RequestItems: map[string][]types.WriteRequest{
+				SessionTableName: writeRequests[i:end],
+			},
+		})
+		if err != nil {
+			return deleted, err
+		}
+		deleted += end - i
+	}
+
+	return deleted, nil
+}
+
+func (r *SessionRepository) scanSessions(ctx context.Context, filter *expression.ConditionBuilder) ([]model.Session, error) {
+	input := &dynamodb.ScanInput{
+		TableName: aws.String(SessionTableName),
+	}
+	if filter != nil {
+		expr, err := expression.NewBuilder().WithFilter(*filter).Build()
+		if err != nil {
+			return nil, err
+		}
+		input.FilterExpression = expr.Filter()
+		input.ExpressionAttributeNames = expr.Names()
+		input.ExpressionAttributeValues = expr.Values()
+	}
+
+	sessions := make([]model.Session, 0)
+	paginator := dynamodb.NewScanPaginator(r.client, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		var items []model.Session
+		if err := attributevalue.UnmarshalListOfMaps(page.Items, &items); err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, items...)
+	}
+	return sessions, nil
+}
+
+func sessionKey(sessionID string) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"sessionId": &types.AttributeValueMemberS{Value: sessionID},
+	}
+}
+
+// sessionTTL은 DynamoDB TTL 속성에 저장할 만료 시간(Unix 초)입니다.
+func sessionTTL(expiresAt time.Time) types.AttributeValue {
+	return &types.AttributeValueMemberN{Value: strconv.FormatInt(expiresAt.Unix(), 10)}
+}
+
+func sortSessions(sessions []model.Session) {
+	sort.Slice(sessions, func(i, j int) bool {
+		if sessions[i].LastSeenAt.Equal(sessions[j].LastSeenAt) {
+			return sessions[i].SessionID < sessions[j].SessionID
+		}
+		return sessions[i].LastSeenAt.After(sessions[j].LastSeenAt)
+	})
+}
+
+// SessionNotFoundError는 세션을 찾을 수 없을 때 발생하는 오류입니다.
+type SessionNotFoundError struct {
+	SessionID string
+}
+
+func (e *SessionNotFoundError) Error() string {
+	return "세션을 찾을 수 없음: " + e.SessionID
+}
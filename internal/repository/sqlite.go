@@ -0,0 +1,202 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	_ "modernc.org/sqlite" // 순수 Go SQLite 드라이버 (CGO 불필요)
+)
+
+// sqliteMigrations는 SQLite 저장소의 스키마 변경 이력입니다.
+// 각 항목의 순번이 PRAGMA user_version에 기록되며, 적용되지 않은 항목만 순서대로 실행합니다.
+// 기존 항목은 수정하지 말고 새 변경은 항상 끝에 추가합니다.
+// 시간 값은 정렬과 커서 비교를 위해 UnixNano 정수로 저장합니다.
+var sqliteMigrations = [][]string{
+	// 1: 게시글, 댓글, 카테고리
+	{
+		`CREATE TABLE IF NOT EXISTS posts (
+			post_id    TEXT PRIMARY KEY,
+			title      TEXT NOT NULL,
+			content    TEXT NOT NULL,
+			summary    TEXT NOT NULL,
+			category   TEXT NOT NULL,
+			created_at INTEGER NOT NULL,
+			updated_at INTEGER NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS posts_category_created ON posts (category, created_at)`,
+		`CREATE INDEX IF NOT EXISTS posts_created ON posts (created_at)`,
+		`CREATE INDEX IF NOT EXISTS posts_updated ON posts (updated_at)`,
+		`CREATE TABLE IF NOT EXISTS comments (
+			comment_id TEXT PRIMARY KEY,
+			post_id    TEXT NOT NULL,
+			nickname   TEXT NOT NULL,
+			content    TEXT NOT NULL,
+			created_at INTEGER NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS comments_post ON comments (post_id, created_at)`,
+		`CREATE TABLE IF NOT EXISTS categories (
+			category   TEXT PRIMARY KEY,
+			sort_order INTEGER NOT NULL,
+			created_at INTEGER NOT NULL
+		)`,
+	},
+	// 2: 게시글 공개 상태와 발행 시간
+	{
+		`ALTER TABLE posts ADD COLUMN status TEXT NOT NULL DEFAULT 'published'`,
+		`ALTER TABLE posts ADD COLUMN publish_at INTEGER`,
+		`CREATE INDEX IF NOT EXISTS posts_status ON posts (status)`,
+	},
+	// 3: 게시글 리비전
+	{
+		`CREATE TABLE IF NOT EXISTS post_revisions (
+			post_id       TEXT NOT NULL,
+			revision      INTEGER NOT NULL,
+			title         TEXT NOT NULL,
+			content       TEXT NOT NULL,
+			summary       TEXT NOT NULL,
+			category      TEXT NOT NULL,
+			editor        TEXT NOT NULL,
+			restored_from INTEGER NOT NULL DEFAULT 0,
+			created_at    INTEGER NOT NULL,
+			PRIMARY KEY (post_id, revision)
+		)`,
+	},
+	// 4: 게시글 버전 (낙관적 동시성 제어)
+	{
+		`ALTER TABLE posts ADD COLUMN version INTEGER NOT NULL DEFAULT 0`,
+	},
+	// 5: 게시글 태그 (posts.tags는 JSON 배열) 와 태그 색인
+	{
+		`ALTER TABLE posts ADD COLUMN tags TEXT NOT NULL DEFAULT '[]'`,
+		`CREATE TABLE IF NOT EXISTS post_tags (
+			tag       TEXT NOT NULL,
+			post_id   TEXT NOT NULL,
+			published INTEGER NOT NULL,
+			PRIMARY KEY (tag, post_id)
+		)`,
+		`CREATE INDEX IF NOT EXISTS post_tags_post ON post_tags (post_id)`,
+	},
+	// 6: 댓글 답글 (부모 댓글, 중첩 단계, 삭제 표시)
+	{
+		`ALTER TABLE comments ADD COLUMN parent_comment_id TEXT NOT NULL DEFAULT ''`,
+		`ALTER TABLE comments ADD COLUMN depth INTEGER NOT NULL DEFAULT 0`,
+		`ALTER TABLE comments ADD COLUMN deleted INTEGER NOT NULL DEFAULT 0`,
+	},
+	// 7: 댓글 검토 상태 (기존 댓글은 승인)
+	{
+		`ALTER TABLE comments ADD COLUMN status TEXT NOT NULL DEFAULT 'approved'`,
+		`CREATE INDEX IF NOT EXISTS comments_status ON comments (status, created_at)`,
+	},
+	// 8: 관리자 계정과 게시글 작성자
+	{
+		`CREATE TABLE IF NOT EXISTS users (
+			username      TEXT PRIMARY KEY,
+			password_hash TEXT NOT NULL,
+			role          TEXT NOT NULL,
+			created_at    INTEGER NOT NULL,
+			updated_at    INTEGER NOT NULL
+		)`,
+		`ALTER TABLE posts ADD COLUMN author_id TEXT NOT NULL DEFAULT ''`,
+	},
+	// 9: 관리자 2단계 인증 (TOTP)
+	{
+		`ALTER TABLE users ADD COLUMN totp_enabled INTEGER NOT NULL DEFAULT 0`,
+		`ALTER TABLE users ADD COLUMN totp_secret TEXT NOT NULL DEFAULT ''`,
+		`ALTER TABLE users ADD COLUMN totp_last_step INTEGER NOT NULL DEFAULT 0`,
+		`ALTER TABLE users ADD COLUMN recovery_codes TEXT NOT NULL DEFAULT '[]'`,
+	},
+	// 10: API 토큰
+	{
+		`CREATE TABLE IF NOT EXISTS api_tokens (
+			token_id     TEXT PRIMARY KEY,
+			username     TEXT NOT NULL,
+			name         TEXT NOT NULL,
+			scopes       TEXT NOT NULL DEFAULT '[]',
+			token_hash   TEXT NOT NULL,
+			created_at   INTEGER NOT NULL,
+			expires_at   INTEGER NOT NULL,
+			last_used_at INTEGER
+		)`,
+		`CREATE INDEX IF NOT EXISTS api_tokens_username ON api_tokens (username, created_at)`,
+	},
+	// 11: 서버 저장 로그인 세션
+	{
+		`CREATE TABLE IF NOT EXISTS sessions (
+			session_id   TEXT PRIMARY KEY,
+			username     TEXT NOT NULL DEFAULT '',
+			ip           TEXT NOT NULL DEFAULT '',
+			user_agent   TEXT NOT NULL DEFAULT '',
+			login_time   INTEGER NOT NULL,
+			last_seen_at INTEGER NOT NULL,
+			expires_at   INTEGER NOT NULL,
+			data         BLOB NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS sessions_username ON sessions (username, last_seen_at)`,
+		`CREATE INDEX IF NOT EXISTS sessions_expires_at ON sessions (expires_at)`,
+	},
+	// 12: 관리자가 검토한 댓글 표시 (기존 댓글은 누가 상태를 정했는지 알 수 없으므로 미검토)
+	{
+		`ALTER TABLE comments ADD COLUMN moderated INTEGER NOT NULL DEFAULT 0`,
+	},
+}
+
+// OpenSQLite는 SQLite 데이터베이스를 열고 스키마를 생성합니다.
+// path에 ":memory:"를 지정하면 프로세스 메모리에 데이터베이스를 생성합니다.
+func OpenSQLite(path string) (*sql.DB, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, err
+	}
+
+	// SQLite는 동시 쓰기를 지원하지 않고 ":memory:"는 연결마다 별도 DB가 되므로 단일 연결 사용
+	db.SetMaxOpenConns(1)
+
+	if err := migrateSQLite(db); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return db, nil
+}
+
+// migrateSQLite는 적용되지 않은 스키마 변경을 버전별 트랜잭션으로 실행합니다.
+func migrateSQLite(db *sql.DB) error {
+	var version int
+	if err := db.QueryRow("PRAGMA user_version").Scan(&version); err != nil {
+		return err
+	}
+
+	for i := version; i < len(sqliteMigrations); i++ {
+		tx, err := db.Begin()
+		if err != nil {
+			return err
+		}
+		for _, stmt := range sqliteMigrations[i] {
+			if _, err := tx.Exec(stmt); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("SQLite 마이그레이션 %d 실패: %w", i+1, err)
+			}
+		}
+		// PRAGMA는 바인딩 파라미터를 지원하지 않으므로 정수를 직접 포맷
+		if _, err := tx.Exec(fmt.Sprintf("PRAGMA user_version = %d", i+1)); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// toUnixNano는 시간을 SQLite 저장용 정수로 변환합니다.
+func toUnixNano(t time.Time) int64 {
+	return t.UnixNano()
+}
+
+// fromUnixNano는 SQLite에 저장된 정수를 UTC 시간으로 복원합니다.
+func fromUnixNano(n int64) time.Time {
+	return time.Unix(0, n).UTC()
+}
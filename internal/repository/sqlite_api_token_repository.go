@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"bumsiku/internal/model"
+)
+
+// SQLiteAPITokenRepository는 SQLite에 API 토큰을 저장하는 저장소입니다.
+type SQLiteAPITokenRepository struct {
+	db *sql.DB
+}
+
+func NewSQLiteAPITokenRepository(db *sql.DB) *SQLiteAPITokenRepository {
+	return &SQLiteAPITokenRepository{db: db}
+}
+
+const apiTokenColumns = "token_id, username, name, scopes, token_hash, created_at, expires_at, last_used_at"
+
+func (r *SQLiteAPITokenRepository) GetTokens(ctx context.Context, username string) ([]model.APIToken, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+apiTokenColumns+" FROM api_tokens WHERE username = ? ORDER BY created_at ASC, token_id ASC", username,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tokens := make([]model.APIToken, 0)
+	for rows.Next() {
+		token, err := scanAPIToken(rows)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, *token)
+	}
+
+	return tokens, rows.Err()
+}
+
+func (r *SQLiteAPITokenRepository) GetToken(ctx context.Context, tokenID string) (*model.APIToken, error) {
+	token, err := scanAPIToken(r.db.QueryRowContext(ctx, "SELECT "+apiTokenColumns+" FROM api_tokens WHERE token_id = ?", tokenID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return token, err
+}
+
+func (r *SQLiteAPITokenRepository) CreateToken(ctx context.Context, token *model.APIToken) error {
+	if token.CreatedAt.IsZero() {
+		token.CreatedAt = time.Now()
+	}
+	scopes, err := encodeTags(token.Scopes)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx,
+		"INSERT INTO api_tokens ("+apiTokenColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+		token.TokenID, token.Username, token.Name, scopes, token.TokenHash,
+		toUnixNano(token.CreatedAt), toUnixNano(token.ExpiresAt), nullableUnixNano(token.LastUsedAt),
+	)
+	return err
+}
+
+func (r *SQLiteAPITokenRepository) DeleteToken(ctx context.Context, tokenID string) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM api_tokens WHERE token_id = ?", tokenID)
+	if err != nil {
+		return err
+	}
+	return apiTokenAffected(result, tokenID)
+}
+
+func (r *SQLiteAPITokenRepository) DeleteTokensByUsername(ctx context.Context, username string) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM api_tokens WHERE username = ?", username)
+	return err
+}
+
+func (r *SQLiteAPITokenRepository) TouchToken(ctx context.Context, tokenID string, usedAt time.Time) error {
+	result, err := r.db.ExecContext(ctx, "UPDATE api_tokens SET last_used_at = ? WHERE token_id = ?", toUnixNano(usedAt), tokenID)
+	if err != nil {
+		return err
+	}
+	return apiTokenAffected(result, tokenID)
+}
+
+// apiTokenAffected는 변경된 행이 없으면 APITokenNotFoundError를 반환합니다.
+func apiTokenAffected(result sql.Result, tokenID string) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return &APITokenNotFoundError{TokenID: tokenID}
+	}
+	return nil
+}
+
+func scanAPIToken(row interface{ Scan(...interface{}) error }) (*model.APIToken, error) {
+	var token model.APIToken
+	var scopes string
+	var createdAt, expiresAt int64
+	var lastUsedAt sql.NullInt64
+	if err := row.Scan(&token.TokenID, &token.Username, &token.Name, &scopes, &token.TokenHash, &createdAt, &expiresAt, &lastUsedAt); err != nil {
+		return nil, err
+	}
+
+	decoded, err := decodeTags(scopes)
+	if err != nil {
+		return nil, err
+	}
+	token.Scopes = decoded
+	token.CreatedAt = fromUnixNano(createdAt)
+	token.ExpiresAt = fromUnixNano(expiresAt)
+	if lastUsedAt.Valid {
+		t := fromUnixNano(lastUsedAt.Int64)
+		token.LastUsedAt = &t
+	}
+	return &token, nil
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"bumsiku/internal/model"
+)
+
+// SQLiteCategoryRepository는 SQLite에 카테고리를 저장하는 저장소입니다.
+type SQLiteCategoryRepository struct {
+	db *sql.DB
+}
+
+func NewSQLiteCategoryRepository(db *sql.DB) *SQLiteCategoryRepository {
+	return &SQLiteCategoryRepository{db: db}
+}
+
+func (r *SQLiteCategoryRepository) GetCategories(ctx context.Context) ([]model.Category, error) {
+	// Order 필드 기준으로 정렬하여 조회
+	rows, err := r.db.QueryContext(ctx, "SELECT category, sort_order, created_at FROM categories ORDER BY sort_order ASC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	categories := make([]model.Category, 0)
+	for rows.Next() {
+		var category model.Category
+		var createdAt int64
+		if err := rows.Scan(&category.Category, &category.Order, &createdAt); err != nil {
+			return nil, err
+		}
+		category.CreatedAt = fromUnixNano(createdAt)
+		categories = append(categories, category)
+	}
+
+	return categories, rows.Err()
+}
+
+// UpsertCategory는 카테고리를 생성하거나 업데이트합니다
+func (r *SQLiteCategoryRepository) UpsertCategory(ctx context.Context, category model.Category) error {
+	// CreatedAt이 설정되지 않은 경우에만 현재 시간으로 설정
+	if category.CreatedAt.IsZero() {
+		category.CreatedAt = time.Now()
+	}
+
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO categories (category, sort_order, created_at) VALUES (?, ?, ?)
+		ON CONFLICT (category) DO UPDATE SET sort_order = excluded.sort_order, created_at = excluded.created_at`,
+		category.Category, category.Order, toUnixNano(category.CreatedAt),
+	)
+	return err
+}
@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"bumsiku/internal/model"
+
+	"github.com/google/uuid"
+)
+
+// SQLiteCommentRepository는 SQLite에 댓글을 저장하는 저장소입니다.
+type SQLiteCommentRepository struct {
+	db *sql.DB
+}
+
+func NewSQLiteCommentRepository(db *sql.DB) *SQLiteCommentRepository {
+	return &SQLiteCommentRepository{db: db}
+}
+
+func (r *SQLiteCommentRepository) GetComments(ctx context.Context, input *GetCommentsInput) ([]model.Comment, error) {
+	query := "SELECT " + commentColumns + " FROM comments WHERE 1 = 1"
+	var args []interface{}
+	if input != nil && input.PostID != nil && *input.PostID != "" {
+		// 특정 게시글의 댓글만 조회
+		query += " AND post_id = ?"
+		args = append(args, *input.PostID)
+	}
+	if input != nil && input.Status != "" {
+		query += " AND status = ?"
+		args = append(args, input.Status)
+	}
+	query += " ORDER BY created_at ASC, comment_id ASC" // 등록순 정렬
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanComments(rows)
+}
+
+// commentColumns는 scanComments가 읽는 열 순서입니다.
+const commentColumns = "comment_id, post_id, parent_comment_id, depth, nickname, content, deleted, status, moderated, created_at"
+
+// scanComments는 commentColumns 순서로 조회한 댓글을 변환합니다.
+func scanComments(rows *sql.Rows) ([]model.Comment, error) {
+	comments := make([]model.Comment, 0)
+	for rows.Next() {
+		var comment model.Comment
+		var createdAt int64
+		if err := rows.Scan(&comment.CommentID, &comment.PostID, &comment.ParentCommentID, &comment.Depth,
+			&comment.Nickname, &comment.Content, &comment.Deleted, &comment.Status, &comment.Moderated, &createdAt); err != nil {
+			return nil, err
+		}
+		comment.CreatedAt = fromUnixNano(createdAt)
+		comments = append(comments, comment)
+	}
+
+	return comments, rows.Err()
+}
+
+// CreateComment는 댓글을 생성합니다
+func (r *SQLiteCommentRepository) CreateComment(ctx context.Context, comment *model.Comment) (*model.Comment, error) {
+	comment.CommentID = uuid.New().String()
+	comment.CreatedAt = time.Now()
+	if comment.Status == "" {
+		comment.Status = model.CommentStatusApproved
+	}
+
+	_, err := r.db.ExecContext(ctx,
+		"INSERT INTO comments ("+commentColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		comment.CommentID, comment.PostID, comment.ParentCommentID, comment.Depth, comment.Nickname, comment.Content, comment.Deleted, comment.Status, comment.Moderated, toUnixNano(comment.CreatedAt),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+func (r *SQLiteCommentRepository) DeleteCommentsByPostID(ctx context.Context, postID string) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM comments WHERE post_id = ?", postID)
+	return err
+}
+
+// DeleteComment는 특정 댓글을 삭제합니다. 답글이 있으면 삭제 표시만 합니다.
+func (r *SQLiteCommentRepository) DeleteComment(ctx context.Context, commentID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// 같은 게시글의 댓글로 답글 여부 판단
+	rows, err := tx.QueryContext(ctx,
+		"SELECT "+commentColumns+" FROM comments WHERE post_id = (SELECT post_id FROM comments WHERE comment_id = ?)", commentID)
+	if err != nil {
+		return err
+	}
+	postComments, err := scanComments(rows)
+	rows.Close()
+	if err != nil {
+		return err
+	}
+
+	var target *model.Comment
+	for i := range postComments {
+		if postComments[i].CommentID == commentID {
+			target = &postComments[i]
+			break
+		}
+	}
+	if target == nil {
+		return &CommentNotFoundError{CommentID: commentID}
+	}
+
+	plan := planCommentDeletion(postComments, *target)
+	if plan.tombstone != nil {
+		if _, err := tx.ExecContext(ctx,
+			"UPDATE comments SET nickname = '', content = '', deleted = 1 WHERE comment_id = ?", commentID,
+		); err != nil {
+			return err
+		}
+	}
+	for _, comment := range plan.remove {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM comments WHERE comment_id = ?", comment.CommentID); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// SetCommentStatus는 댓글들의 검토 상태를 변경합니다.
+func (r *SQLiteCommentRepository) SetCommentStatus(ctx context.Context, commentIDs []string, status string) ([]string, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	updated := make([]string, 0, len(commentIDs))
+	seen := make(map[string]bool, len(commentIDs))
+	for _, commentID := range commentIDs {
+		if seen[commentID] {
+			continue
+		}
+		seen[commentID] = true
+
+		result, err := tx.ExecContext(ctx, "UPDATE comments SET status = ?, moderated = 1 WHERE comment_id = ?", status, commentID)
+		if err != nil {
+			return nil, err
+		}
+		if affected, err := result.RowsAffected(); err != nil {
+			return nil, err
+		} else if affected > 0 {
+			updated = append(updated, commentID)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
@@ -0,0 +1,320 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"bumsiku/internal/model"
+)
+
+// SQLitePostRepository는 SQLite에 게시글을 저장하는 저장소입니다.
+type SQLitePostRepository struct {
+	db      *sql.DB
+	cursors *CursorSigner // 페이지 커서 서명
+}
+
+func NewSQLitePostRepository(db *sql.DB, cursors *CursorSigner) *SQLitePostRepository {
+	return &SQLitePostRepository{db: db, cursors: cursors}
+}
+
+func (r *SQLitePostRepository) GetPosts(ctx context.Context, input *GetPostsInput) (*GetPostsOutput, error) {
+	// 페이지네이션 계산
+	if input.Page <= 0 {
+		input.Page = 1
+	}
+	if input.PageSize <= 0 {
+		input.PageSize = PageSize
+	}
+	if input.Sort == "" {
+		input.Sort = SortNewest
+	}
+
+	category := ""
+	if input.Category != nil {
+		category = *input.Category
+	}
+
+	// 정렬 방식에 따른 정렬 컬럼과 방향 결정
+	sortColumn, descending := "created_at", true
+	switch input.Sort {
+	case SortOldest:
+		descending = false
+	case SortUpdated:
+		sortColumn = "updated_at"
+	}
+	direction, comparator := "ASC", ">"
+	if descending {
+		direction, comparator = "DESC", "<"
+	}
+
+	var where []string
+	var args []interface{}
+	if category != "" {
+		where = append(where, "category = ?")
+		args = append(args, category)
+	}
+	if input.Status != "" {
+		where = append(where, "status = ?")
+		args = append(args, input.Status)
+	}
+	if input.Tag != "" {
+		where = append(where, "EXISTS (SELECT 1 FROM json_each(posts.tags) WHERE json_each.value = ?)")
+		args = append(args, input.Tag)
+	}
+
+	output := &GetPostsOutput{Posts: []model.Post{}}
+	scope := postListScope(category, input)
+	offset := int64(0)
+
+	if input.Cursor != nil && *input.Cursor != "" {
+		// 커서 모드: 마지막으로 반환한 (정렬값, postId) 다음부터 조회
+		key, err := r.cursors.Decode(*input.Cursor, scope)
+		if err != nil {
+			return nil, err
+		}
+		sortValue, err := strconv.ParseInt(key["t"], 10, 64)
+		if err != nil || key["id"] == "" {
+			return nil, &InvalidCursorError{Cursor: *input.Cursor}
+		}
+		where = append(where, "("+sortColumn+" "+comparator+" ? OR ("+sortColumn+" = ? AND post_id "+comparator+" ?))")
+		args = append(args, sortValue, sortValue, key["id"])
+	} else {
+		// 페이지 번호 모드: 총 개수 조회 후 오프셋 적용
+		countQuery := "SELECT COUNT(*) FROM posts" + whereClause(where)
+		if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&output.TotalCount); err != nil {
+			return nil, err
+		}
+		offset = int64(input.Page-1) * int64(input.PageSize)
+	}
+
+	// 다음 페이지 존재 여부 확인을 위해 한 건 더 조회 (WithContent가 아니면 Content 필드 제외)
+	contentColumn := "''"
+	if input.WithContent {
+		contentColumn = "content"
+	}
+	query := "SELECT post_id, title, " + contentColumn + ", summary, category, tags, status, publish_at, version, author_id, created_at, updated_at FROM posts" +
+		whereClause(where) +
+		" ORDER BY " + sortColumn + " " + direction + ", post_id " + direction +
+		" LIMIT ? OFFSET ?"
+	args = append(args, input.PageSize+1, offset)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var post model.Post
+		var tags string
+		var publishAt sql.NullInt64
+		var createdAt, updatedAt int64
+		if err := rows.Scan(&post.PostID, &post.Title, &post.Content, &post.Summary, &post.Category, &tags, &post.Status, &publishAt, &post.Version, &post.AuthorID, &createdAt, &updatedAt); err != nil {
+			return nil, err
+		}
+		if post.Tags, err = decodeTags(tags); err != nil {
+			return nil, err
+		}
+		fillPostTimes(&post, publishAt, createdAt, updatedAt)
+		output.Posts = append(output.Posts, post)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// 다음 페이지가 남아 있으면 마지막 게시글 기준으로 커서 발급
+	if len(output.Posts) > int(input.PageSize) {
+		output.Posts = output.Posts[:input.PageSize]
+		last := output.Posts[len(output.Posts)-1]
+		sortValue := toUnixNano(last.CreatedAt)
+		if sortColumn == "updated_at" {
+			sortValue = toUnixNano(last.UpdatedAt)
+		}
+		output.NextCursor, err = r.cursors.Encode(scope, map[string]string{
+			"t":  strconv.FormatInt(sortValue, 10),
+			"id": last.PostID,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+// whereClause는 조건 목록을 WHERE 절로 변환합니다.
+func whereClause(conditions []string) string {
+	if len(conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(conditions, " AND ")
+}
+
+func (r *SQLitePostRepository) GetPostByID(ctx context.Context, postID string) (*model.Post, error) {
+	var post model.Post
+	var tags string
+	var publishAt sql.NullInt64
+	var createdAt, updatedAt int64
+	err := r.db.QueryRowContext(ctx,
+		"SELECT post_id, title, content, summary, category, tags, status, publish_at, version, author_id, created_at, updated_at FROM posts WHERE post_id = ?",
+		postID,
+	).Scan(&post.PostID, &post.Title, &post.Content, &post.Summary, &post.Category, &tags, &post.Status, &publishAt, &post.Version, &post.AuthorID, &createdAt, &updatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if post.Tags, err = decodeTags(tags); err != nil {
+		return nil, err
+	}
+
+	fillPostTimes(&post, publishAt, createdAt, updatedAt)
+	return &post, nil
+}
+
+// fillPostTimes는 SQLite에서 읽은 시간 값을 게시글에 채웁니다.
+func fillPostTimes(post *model.Post, publishAt sql.NullInt64, createdAt, updatedAt int64) {
+	post.CreatedAt = fromUnixNano(createdAt)
+	post.UpdatedAt = fromUnixNano(updatedAt)
+	post.PublishAt = nil
+	if publishAt.Valid {
+		t := fromUnixNano(publishAt.Int64)
+		post.PublishAt = &t
+	}
+	post.FeedKey = FeedPartitionKey
+}
+
+// encodeTags는 태그 목록을 SQLite 저장용 JSON 배열로 변환합니다.
+func encodeTags(tags []string) (string, error) {
+	if tags == nil {
+		tags = []string{}
+	}
+	encoded, err := json.Marshal(tags)
+	return string(encoded), err
+}
+
+// decodeTags는 SQLite에 저장된 JSON 배열을 태그 목록으로 복원합니다. 태그가 없으면 nil입니다.
+func decodeTags(encoded string) ([]string, error) {
+	var tags []string
+	if err := json.Unmarshal([]byte(encoded), &tags); err != nil {
+		return nil, err
+	}
+	if len(tags) == 0 {
+		return nil, nil
+	}
+	return tags, nil
+}
+
+// nullableUnixNano는 선택적 시간 값을 SQLite 저장용 값으로 변환합니다.
+func nullableUnixNano(t *time.Time) sql.NullInt64 {
+	if t == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: toUnixNano(*t), Valid: true}
+}
+
+func (r *SQLitePostRepository) CreatePost(ctx context.Context, post *model.Post) error {
+	post.FeedKey = FeedPartitionKey
+	if post.Status == "" {
+		post.Status = model.PostStatusPublished
+	}
+	post.Version = 1
+
+	tags, err := encodeTags(post.Tags)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx,
+		"INSERT INTO posts (post_id, title, content, summary, category, tags, status, publish_at, version, author_id, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		post.PostID, post.Title, post.Content, post.Summary, post.Category, tags, post.Status, nullableUnixNano(post.PublishAt),
+		post.Version, post.AuthorID, toUnixNano(post.CreatedAt), toUnixNano(post.UpdatedAt),
+	)
+	return err
+}
+
+func (r *SQLitePostRepository) UpdatePost(ctx context.Context, post *model.Post, expectedVersion *int64) error {
+	// 태그가 nil이면 NULL을 전달하여 기존 태그 유지
+	var tags sql.NullString
+	if post.Tags != nil {
+		encoded, err := encodeTags(post.Tags)
+		if err != nil {
+			return err
+		}
+		tags = sql.NullString{String: encoded, Valid: true}
+	}
+
+	query := "UPDATE posts SET title = ?, content = ?, summary = ?, category = ?, tags = COALESCE(?, tags), updated_at = ?, version = version + 1 WHERE post_id = ?"
+	args := []interface{}{post.Title, post.Content, post.Summary, post.Category, tags, toUnixNano(post.UpdatedAt), post.PostID}
+	if expectedVersion != nil {
+		query += " AND version = ?"
+		args = append(args, *expectedVersion)
+	}
+
+	var version int64
+	err := r.db.QueryRowContext(ctx, query+" RETURNING version", args...).Scan(&version)
+	if errors.Is(err, sql.ErrNoRows) {
+		if expectedVersion == nil {
+			return &PostNotFoundError{PostID: post.PostID}
+		}
+		return r.versionConflict(ctx, post.PostID, *expectedVersion)
+	}
+	if err != nil {
+		return err
+	}
+
+	post.Version = version
+	return nil
+}
+
+// versionConflict는 버전 조건으로 수정된 행이 없을 때 원인을 게시글 존재 여부로 구분합니다.
+func (r *SQLitePostRepository) versionConflict(ctx context.Context, postID string, expectedVersion int64) error {
+	var current int64
+	err := r.db.QueryRowContext(ctx, "SELECT version FROM posts WHERE post_id = ?", postID).Scan(&current)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &PostNotFoundError{PostID: postID}
+	}
+	if err != nil {
+		return err
+	}
+	return &PostVersionConflictError{PostID: postID, ExpectedVersion: expectedVersion, CurrentVersion: current}
+}
+
+func (r *SQLitePostRepository) DeletePost(ctx context.Context, postID string) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM posts WHERE post_id = ?", postID)
+	if err != nil {
+		return err
+	}
+
+	return requireAffected(result, &PostNotFoundError{PostID: postID})
+}
+
+func (r *SQLitePostRepository) UpdatePostStatus(ctx context.Context, postID string, status string, publishAt *time.Time) error {
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE posts SET status = ?, publish_at = ?, version = version + 1 WHERE post_id = ?",
+		status, nullableUnixNano(publishAt), postID,
+	)
+	if err != nil {
+		return err
+	}
+
+	return requireAffected(result, &PostNotFoundError{PostID: postID})
+}
+
+// requireAffected는 변경된 행이 없으면 notFound 오류를 반환합니다.
+func requireAffected(result sql.Result, notFound error) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return notFound
+	}
+	return nil
+}
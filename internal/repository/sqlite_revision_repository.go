@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"bumsiku/internal/model"
+)
+
+// SQLiteRevisionRepository는 SQLite에 게시글 리비전을 저장하는 저장소입니다.
+type SQLiteRevisionRepository struct {
+	db *sql.DB
+}
+
+func NewSQLiteRevisionRepository(db *sql.DB) *SQLiteRevisionRepository {
+	return &SQLiteRevisionRepository{db: db}
+}
+
+func (r *SQLiteRevisionRepository) CreateRevision(ctx context.Context, revision *model.PostRevision) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var latest int
+	err = tx.QueryRowContext(ctx,
+		"SELECT COALESCE(MAX(revision), 0) FROM post_revisions WHERE post_id = ?",
+		revision.PostID,
+	).Scan(&latest)
+	if err != nil {
+		return err
+	}
+	revision.Revision = latest + 1
+
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO post_revisions (post_id, revision, title, content, summary, category, editor, restored_from, created_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		revision.PostID, revision.Revision, revision.Title, revision.Content, revision.Summary, revision.Category,
+		revision.Editor, revision.RestoredFrom, toUnixNano(revision.CreatedAt),
+	)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *SQLiteRevisionRepository) GetRevisions(ctx context.Context, postID string) ([]model.PostRevision, error) {
+	// 목록 조회에서는 Content 필드 제외
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT post_id, revision, title, summary, category, editor, restored_from, created_at FROM post_revisions WHERE post_id = ? ORDER BY revision DESC",
+		postID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	revisions := make([]model.PostRevision, 0)
+	for rows.Next() {
+		var rev model.PostRevision
+		var createdAt int64
+		if err := rows.Scan(&rev.PostID, &rev.Revision, &rev.Title, &rev.Summary, &rev.Category, &rev.Editor, &rev.RestoredFrom, &createdAt); err != nil {
+			return nil, err
+		}
+		rev.CreatedAt = fromUnixNano(createdAt)
+		revisions = append(revisions, rev)
+	}
+	return revisions, rows.Err()
+}
+
+func (r *SQLiteRevisionRepository) GetRevision(ctx context.Context, postID string, revision int) (*model.PostRevision, error) {
+	var rev model.PostRevision
+	var createdAt int64
+	err := r.db.QueryRowContext(ctx,
+		"SELECT post_id, revision, title, content, summary, category, editor, restored_from, created_at FROM post_revisions WHERE post_id = ? AND revision = ?",
+		postID, revision,
+	).Scan(&rev.PostID, &rev.Revision, &rev.Title, &rev.Content, &rev.Summary, &rev.Category, &rev.Editor, &rev.RestoredFrom, &createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rev.CreatedAt = fromUnixNano(createdAt)
+	return &rev, nil
+}
+
+func (r *SQLiteRevisionRepository) DeleteRevisionsByPostID(ctx context.Context, postID string) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM post_revisions WHERE post_id = ?", postID)
+	return err
+}
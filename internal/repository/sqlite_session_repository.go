@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"bumsiku/internal/model"
+)
+
+// SQLiteSessionRepository는 SQLite에 로그인 세션을 저장하는 저장소입니다.
+type SQLiteSessionRepository struct {
+	db *sql.DB
+}
+
+func NewSQLiteSessionRepository(db *sql.DB) *SQLiteSessionRepository {
+	return &SQLiteSessionRepository{db: db}
+}
+
+const sessionColumns = "session_id, username, ip, user_agent, login_time, last_seen_at, expires_at, data"
+
+func (r *SQLiteSessionRepository) GetSession(ctx context.Context, sessionID string) (*model.Session, error) {
+	session, err := scanSession(r.db.QueryRowContext(ctx, "SELECT "+sessionColumns+" FROM sessions WHERE session_id = ?", sessionID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return session, err
+}
+
+func (r *SQLiteSessionRepository) SaveSession(ctx context.Context, session *model.Session) error {
+	data := session.Data
+	if data == nil {
+		data = []byte{}
+	}
+
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO sessions (`+sessionColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (session_id) DO UPDATE SET
+			username = excluded.username, ip = excluded.ip, user_agent = excluded.user_agent,
+			login_time = excluded.login_time, last_seen_at = excluded.last_seen_at,
+			expires_at = excluded.expires_at, data = excluded.data`,
+		session.SessionID, session.Username, session.IP, session.UserAgent,
+		toUnixNano(session.LoginTime), toUnixNano(session.LastSeenAt), toUnixNano(session.ExpiresAt), data,
+	)
+	return err
+}
+
+func (r *SQLiteSessionRepository) TouchSession(ctx context.Context, sessionID string, lastSeenAt, expiresAt time.Time) error {
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE sessions SET last_seen_at = ?, expires_at = ? WHERE session_id = ?",
+		toUnixNano(lastSeenAt), toUnixNano(expiresAt), sessionID,
+	)
+	if err != nil {
+		return err
+	}
+	return sessionAffected(result, sessionID)
+}
+
+func (r *SQLiteSessionRepository) DeleteSession(ctx context.Context, sessionID string) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM sessions WHERE session_id = ?", sessionID)
+	if err != nil {
+		return err
+	}
+	return sessionAffected(result, sessionID)
+}
+
+func (r *SQLiteSessionRepository) GetSessions(ctx context.Context, username string) ([]model.Session, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+sessionColumns+" FROM sessions WHERE username = ? ORDER BY last_seen_at DESC, session_id ASC", username,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions := make([]model.Session, 0)
+	for rows.Next() {
+		session, err := scanSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, *session)
+	}
+
+	return sessions, rows.Err()
+}
+
+func (r *SQLiteSessionRepository) DeleteExpiredSessions(ctx context.Context, now time.Time) (int, error) {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM sessions WHERE expires_at <= ?", toUnixNano(now))
+	if err != nil {
+		return 0, err
+	}
+	deleted, err := result.RowsAffected()
+	return int(deleted), err
+}
+
+// sessionAffected는 변경된 행이 없으면 SessionNotFoundError를 반환합니다.
+func sessionAffected(result sql.Result, sessionID string) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return &SessionNotFoundError{SessionID: sessionID}
+	}
+	return nil
+}
+
+func scanSession(row interface{ Scan(...interface{}) error }) (*model.Session, error) {
+	var session model.Session
+	var loginTime, lastSeenAt, expiresAt int64
+	if err := row.Scan(&session.SessionID, &session.Username, &session.IP, &session.UserAgent, &loginTime, &lastSeenAt, &expiresAt, &session.Data); err != nil {
+		return nil, err
+	}
+
+	session.LoginTime = fromUnixNano(loginTime)
+	session.LastSeenAt = fromUnixNano(lastSeenAt)
+	session.ExpiresAt = fromUnixNano(expiresAt)
+	return &session, nil
+}
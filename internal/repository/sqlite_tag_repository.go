@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"bumsiku/internal/model"
+)
+
+// SQLiteTagRepository는 SQLite에 태그 색인을 저장하는 저장소입니다.
+type SQLiteTagRepository struct {
+	db *sql.DB
+}
+
+func NewSQLiteTagRepository(db *sql.DB) *SQLiteTagRepository {
+	return &SQLiteTagRepository{db: db}
+}
+
+func (r *SQLiteTagRepository) GetTags(ctx context.Context) ([]model.Tag, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT tag, COUNT(*) FROM post_tags WHERE published = 1 GROUP BY tag ORDER BY COUNT(*) DESC, tag ASC",
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := make([]model.Tag, 0)
+	for rows.Next() {
+		var tag model.Tag
+		if err := rows.Scan(&tag.Tag, &tag.Count); err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+
+	return tags, rows.Err()
+}
+
+func (r *SQLiteTagRepository) GetPostIDsByTag(ctx context.Context, tag string) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT post_id FROM post_tags WHERE tag = ? ORDER BY post_id ASC", tag)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	postIDs := make([]string, 0)
+	for rows.Next() {
+		var postID string
+		if err := rows.Scan(&postID); err != nil {
+			return nil, err
+		}
+		postIDs = append(postIDs, postID)
+	}
+
+	return postIDs, rows.Err()
+}
+
+func (r *SQLiteTagRepository) SetPostTags(ctx context.Context, postID string, tags []string, published bool) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM post_tags WHERE post_id = ?", postID); err != nil {
+		return err
+	}
+	for _, tag := range tags {
+		if _, err := tx.ExecContext(ctx,
+			"INSERT INTO post_tags (tag, post_id, published) VALUES (?, ?, ?)",
+			tag, postID, published,
+		); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *SQLiteTagRepository) DeletePostTags(ctx context.Context, postID string) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM post_tags WHERE post_id = ?", postID)
+	return err
+}
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"bumsiku/internal/model"
+)
+
+// SQLiteUserRepository는 SQLite에 관리자 계정을 저장하는 저장소입니다.
+type SQLiteUserRepository struct {
+	db *sql.DB
+}
+
+func NewSQLiteUserRepository(db *sql.DB) *SQLiteUserRepository {
+	return &SQLiteUserRepository{db: db}
+}
+
+const userColumns = "username, password_hash, role, totp_enabled, totp_secret, totp_last_step, recovery_codes, created_at, updated_at"
+
+func (r *SQLiteUserRepository) GetUsers(ctx context.Context) ([]model.User, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT "+userColumns+" FROM users ORDER BY username ASC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := make([]model.User, 0)
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, *user)
+	}
+
+	return users, rows.Err()
+}
+
+func (r *SQLiteUserRepository) GetUser(ctx context.Context, username string) (*model.User, error) {
+	user, err := scanUser(r.db.QueryRowContext(ctx, "SELECT "+userColumns+" FROM users WHERE username = ?", username))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return user, err
+}
+
+func (r *SQLiteUserRepository) CreateUser(ctx context.Context, user *model.User) error {
+	setUserTimes(user, time.Now())
+	recoveryCodes, err := encodeTags(user.RecoveryCodes)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx,
+		"INSERT INTO users ("+userColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		user.Username, user.PasswordHash, user.Role, user.TOTPEnabled, user.TOTPSecret, user.TOTPLastStep, recoveryCodes,
+		toUnixNano(user.CreatedAt), toUnixNano(user.UpdatedAt),
+	)
+	// 드라이버별 오류 타입 대신 메시지로 기본 키 충돌 확인
+	if err != nil && strings.Contains(err.Error(), "UNIQUE constraint failed") {
+		return &UserExistsError{Username: user.Username}
+	}
+	return err
+}
+
+func (r *SQLiteUserRepository) UpdateUser(ctx context.Context, user *model.User) error {
+	if user.UpdatedAt.IsZero() {
+		user.UpdatedAt = time.Now()
+	}
+	recoveryCodes, err := encodeTags(user.RecoveryCodes)
+	if err != nil {
+		return err
+	}
+
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE users SET role = ?, password_hash = ?, totp_enabled = ?, totp_secret = ?, totp_last_step = ?, recovery_codes = ?, updated_at = ? WHERE username = ?",
+		user.Role, user.PasswordHash, user.TOTPEnabled, user.TOTPSecret, user.TOTPLastStep, recoveryCodes, toUnixNano(user.UpdatedAt), user.Username,
+	)
+	if err != nil {
+		return err
+	}
+	return userAffected(result, user.Username)
+}
+
+func (r *SQLiteUserRepository) DeleteUser(ctx context.Context, username string) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM users WHERE username = ?", username)
+	if err != nil {
+		return err
+	}
+	return userAffected(result, username)
+}
+
+// userAffected는 변경된 행이 없으면 UserNotFoundError를 반환합니다.
+func userAffected(result sql.Result, username string) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return &UserNotFoundError{Username: username}
+	}
+	return nil
+}
+
+func scanUser(row interface{ Scan(...interface{}) error }) (*model.User, error) {
+	var user model.User
+	var recoveryCodes string
+	var createdAt, updatedAt int64
+	if err := row.Scan(&user.Username, &user.PasswordHash, &user.Role, &user.TOTPEnabled, &user.TOTPSecret, &user.TOTPLastStep,
+		&recoveryCodes, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+	codes, err := decodeTags(recoveryCodes)
+	if err != nil {
+		return nil, err
+	}
+	user.RecoveryCodes = codes
+	user.CreatedAt = fromUnixNano(createdAt)
+	user.UpdatedAt = fromUnixNano(updatedAt)
+	return &user, nil
+}
@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"context"
+	"sort"
+
+	"bumsiku/internal/model"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// TagTableName은 태그 색인 테이블입니다. Partition Key로 tag, Sort Key로 postId를 사용합니다.
+// GSI: post-index(postId, tag)
+const TagTableName = "blog_post_tags"
+
+// TagRepositoryInterface는 태그와 게시글의 다대다 관계를 보관하는 태그 색인입니다.
+// 게시글의 태그 자체는 model.Post.Tags에 저장되며, 색인은 태그별 조회와 집계에 사용합니다.
+type TagRepositoryInterface interface {
+	// GetTags는 발행된 게시글에 달린 태그를 게시글 수가 많은 순으로 반환합니다. 수가 같으면 태그 이름순입니다.
+	GetTags(ctx context.Context) ([]model.Tag, error)
+	// GetPostIDsByTag는 공개 상태와 관계없이 태그가 달린 게시글 ID를 오름차순으로 반환합니다.
+	GetPostIDsByTag(ctx context.Context, tag string) ([]string, error)
+	// SetPostTags는 게시글의 색인 항목을 tags로 교체합니다.
+	SetPostTags(ctx context.Context, postID string, tags []string, published bool) error
+	DeletePostTags(ctx context.Context, postID string) error
+}
+
+// postTagItem은 태그 색인 테이블의 항목입니다.
+type postTagItem struct {
+	Tag       string `dynamodbav:"tag"`
+	PostID    string `dynamodbav:"postId"`
+	Published bool   `dynamodbav:"published"`
+}
+
+type TagRepository struct {
+	client *dynamodb.Client
+}
+
+func NewTagRepository(client *dynamodb.Client) *TagRepository {
+	return &TagRepository{client: client}
+}
+
+func (r *TagRepository) GetTags(ctx context.Context) ([]model.Tag, error) {
+	expr, err := expression.NewBuilder().
+		WithFilter(expression.Name("published").Equal(expression.Value(true))).
+		WithProjection(expression.NamesList(expression.Name("tag"))).
+		Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// 태그 수는 많지 않으므로 전체 스캔 후 집계
+	counts := make(map[string]int)
+	paginator := dynamodb.NewScanPaginator(r.client, &dynamodb.ScanInput{
+		TableName:                 aws.String(TagTableName),
+		FilterExpression:          expr.Filter(),
+		ProjectionExpression:      expr.Projection(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		var items []postTagItem
+		if err := attributevalue.UnmarshalListOfMaps(page.Items, &items); err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			counts[item.Tag]++
+		}
+	}
+
+	return sortTagCounts(counts), nil
+}
+
+// sortTagCounts는 태그별 게시글 수를 게시글 수 내림차순, 태그 이름 오름차순으로 정렬합니다.
+func sortTagCounts(counts map[string]int) []model.Tag {
+	tags := make([]model.Tag, 0, len(counts))
+	for tag, count := range counts {
+		tags = append(tags, model.Tag{Tag: tag, Count: count})
+	}
+
+	sort.Slice(tags, func(i, j int) bool {
+		if tags[i].Count != tags[j].Count {
+			return tags[i].Count > tags[j].Count
+		}
+		return tags[i].Tag < tags[j].Tag
+	})
+
+	return tags
+}
+
+func (r *TagRepository) GetPostIDsByTag(ctx context.Context, tag string) ([]string, error) {
+	expr, err := expression.NewBuilder().
+		WithKeyCondition(expression.Key("tag").Equal(expression.Value(tag))).
+		WithProjection(expression.NamesList(expression.Name("postId"))).
+		Build()
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := r.queryAll(ctx, &dynamodb.QueryInput{
+		TableName:                 aws.String(TagTableName),
+		KeyConditionExpression:    expr.KeyCondition(),
+		ProjectionExpression:      expr.Projection(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Sort Key 순서로 반환되므로 postId 오름차순
+	postIDs := make([]string, 0, len(items))
+	for _, item := range items {
+		postIDs = append(postIDs, item.PostID)
+	}
+	return postIDs, nil
+}
+
+// SetPostTags는 기존 색인 항목 중 빠진 태그는 삭제하고, 나머지는 공개 여부와 함께 다시 저장합니다.
+func (r *TagRepository) SetPostTags(ctx context.Context, postID string, tags []string, published bool) error {
+	existing, err := r.postTags(ctx, postID)
+	if err != nil {
+		return err
+	}
+
+	keep := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		keep[tag] = true
+	}
+
+	for _, item := range existing {
+		if keep[item.Tag] {
+			continue
+		}
+		_, err := r.client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+			TableName: aws.String(TagTableName),
+			Key: map[string]types.AttributeValue{
+				"tag":    &types.AttributeValueMemberS{Value: item.Tag},
+				"postId": &types.AttributeValueMemberS{Value: postID},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, tag := range tags {
+		item, err := attributevalue.MarshalMap(postTagItem{Tag: tag, PostID: postID, Published: published})
+		if err != nil {
+			return err
+		}
+		_, err = r.client.PutItem(ctx, &dynamodb.PutItemInput{
+			TableName: aws.String(TagTableName),
+			Item:      item,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *TagRepository) DeletePostTags(ctx context.Context, postID string) error {
+	return r.SetPostTags(ctx, postID, nil, false)
+}
+
+// postTags는 post-index로 게시글의 색인 항목을 조회합니다.
+func (r *TagRepository) postTags(ctx context.Context, postID string) ([]postTagItem, error) {
+	expr, err := expression.NewBuilder().
+		WithKeyCondition(expression.Key("postId").Equal(expression.Value(postID))).
+		Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.queryAll(ctx, &dynamodb.QueryInput{
+		TableName:                 aws.String(TagTableName),
+		IndexName:                 aws.String("post-index"),
+		KeyConditionExpression:    expr.KeyCondition(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+	})
+}
+
+// queryAll은 모든 페이지를 조회하여 색인 항목으로 변환합니다.
+func (r *TagRepository) queryAll(ctx context.Context, input *dynamodb.QueryInput) ([]postTagItem, error) {
+	var items []postTagItem
+	paginator := dynamodb.NewQueryPaginator(r.client, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		var pageItems []postTagItem
+		if err := attributevalue.UnmarshalListOfMaps(page.Items, &pageItems); err != nil {
+			return nil, err
+		}
+		items = append(items, pageItems...)
+	}
+	return items, nil
+}
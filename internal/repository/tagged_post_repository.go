@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"bumsiku/internal/model"
+	"bumsiku/internal/utils"
+)
+
+// TaggedPostRepository는 게시글 저장소를 감싸 쓰기 작업이 성공할 때마다 태그 색인을 갱신합니다.
+// 공개 여부에 따라 태그별 게시글 수가 달라지므로 상태 변경도 색인에 반영합니다.
+type TaggedPostRepository struct {
+	PostRepositoryInterface
+	tagRepo TagRepositoryInterface
+	logger  *utils.Logger
+}
+
+func NewTaggedPostRepository(postRepo PostRepositoryInterface, tagRepo TagRepositoryInterface, logger *utils.Logger) *TaggedPostRepository {
+	return &TaggedPostRepository{PostRepositoryInterface: postRepo, tagRepo: tagRepo, logger: logger}
+}
+
+func (r *TaggedPostRepository) CreatePost(ctx context.Context, post *model.Post) error {
+	if err := r.PostRepositoryInterface.CreatePost(ctx, post); err != nil {
+		return err
+	}
+
+	if len(post.Tags) > 0 {
+		if err := r.tagRepo.SetPostTags(ctx, post.PostID, post.Tags, post.IsPublished()); err != nil {
+			r.logFailure(ctx, "태그 색인 갱신 실패", post.PostID, err)
+		}
+	}
+	return nil
+}
+
+func (r *TaggedPostRepository) UpdatePost(ctx context.Context, post *model.Post, expectedVersion *int64) error {
+	if err := r.PostRepositoryInterface.UpdatePost(ctx, post, expectedVersion); err != nil {
+		return err
+	}
+
+	// 태그를 생략한 수정은 기존 태그를 유지하므로 저장된 게시글로 색인
+	r.reindex(ctx, post.PostID)
+	return nil
+}
+
+func (r *TaggedPostRepository) DeletePost(ctx context.Context, postID string) error {
+	if err := r.PostRepositoryInterface.DeletePost(ctx, postID); err != nil {
+		return err
+	}
+
+	if err := r.tagRepo.DeletePostTags(ctx, postID); err != nil {
+		r.logFailure(ctx, "태그 색인 삭제 실패", postID, err)
+	}
+	return nil
+}
+
+func (r *TaggedPostRepository) UpdatePostStatus(ctx context.Context, postID string, status string, publishAt *time.Time) error {
+	if err := r.PostRepositoryInterface.UpdatePostStatus(ctx, postID, status, publishAt); err != nil {
+		return err
+	}
+
+	r.reindex(ctx, postID)
+	return nil
+}
+
+// reindex는 저장된 게시글을 다시 읽어 태그 색인을 교체합니다.
+func (r *TaggedPostRepository) reindex(ctx context.Context, postID string) {
+	post, err := r.PostRepositoryInterface.GetPostByID(ctx, postID)
+	if err == nil {
+		if post == nil {
+			err = r.tagRepo.DeletePostTags(ctx, postID)
+		} else {
+			err = r.tagRepo.SetPostTags(ctx, postID, post.Tags, post.IsPublished())
+		}
+	}
+	if err != nil {
+		r.logFailure(ctx, "태그 색인 갱신 실패", postID, err)
+	}
+}
+
+// logFailure는 태그 색인 작업 실패를 기록합니다.
+// 게시글은 이미 저장되었으므로 요청은 성공으로 처리하고, 어긋난 색인은 다음 수정 때 바로잡힙니다.
+func (r *TaggedPostRepository) logFailure(ctx context.Context, message, postID string, err error) {
+	r.logger.Warn(ctx, message, map[string]string{
+		"postID": postID,
+		"error":  err.Error(),
+	})
+}
@@ -0,0 +1,216 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"bumsiku/internal/model"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// UserTableName은 관리자 계정 테이블입니다. Partition Key로 username을 사용합니다.
+const UserTableName = "blog_users"
+
+type UserRepositoryInterface interface {
+	// GetUsers는 모든 계정을 사용자 이름순으로 반환합니다.
+	GetUsers(ctx context.Context) ([]model.User, error)
+	// GetUser는 계정을 조회합니다. 없으면 nil을 반환합니다.
+	GetUser(ctx context.Context, username string) (*model.User, error)
+	// CreateUser는 계정을 생성합니다. 같은 사용자 이름이 있으면 UserExistsError를 반환합니다.
+	CreateUser(ctx context.Context, user *model.User) error
+	// UpdateUser는 계정의 역할, 비밀번호 해시, 2단계 인증 설정을 수정합니다. 계정이 없으면 UserNotFoundError를 반환합니다.
+	UpdateUser(ctx context.Context, user *model.User) error
+	// DeleteUser는 계정을 삭제합니다. 계정이 없으면 UserNotFoundError를 반환합니다.
+	DeleteUser(ctx context.Context, username string) error
+}
+
+type UserRepository struct {
+	client *dynamodb.Client
+}
+
+func NewUserRepository(client *dynamodb.Client) *UserRepository {
+	return &UserRepository{client: client}
+}
+
+func (r *UserRepository) GetUsers(ctx context.Context) ([]model.User, error) {
+	users := make([]model.User, 0)
+	paginator := dynamodb.NewScanPaginator(r.client, &dynamodb.ScanInput{
+		TableName: aws.String(UserTableName),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		var items []model.User
+		if err := attributevalue.UnmarshalListOfMaps(page.Items, &items); err != nil {
+			return nil, err
+		}
+		users = append(users, items...)
+	}
+
+	sortUsers(users)
+	return users, nil
+}
+
+func (r *UserRepository) GetUser(ctx context.Context, username string) (*model.User, error) {
+	result, err := r.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(UserTableName),
+		Key:       userKey(username),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Item == nil {
+		return nil, nil
+	}
+
+	var user model.User
+	if err := attributevalue.UnmarshalMap(result.Item, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *UserRepository) CreateUser(ctx context.Context, user *model.User) error {
+	setUserTimes(user, time.Now())
+
+	item, err := attributevalue.MarshalMap(user)
+	if err != nil {
+		return err
+	}
+
+	expr, err := expression.NewBuilder().
+		WithCondition(expression.AttributeNotExists(expression.Name("username"))).
+		Build()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:                aws.String(UserTableName),
+		Item:                     item,
+		ConditionExpression:      expr.Condition(),
+		ExpressionAttributeNames: expr.Names(),
+	})
+
+	var conditionErr *types.ConditionalCheckFailedException
+	if errors.As(err, &conditionErr) {
+		return &UserExistsError{Username: user.Username}
+	}
+	return err
+}
+
+func (r *UserRepository) UpdateUser(ctx context.Context, user *model.User) error {
+	if user.UpdatedAt.IsZero() {
+		user.UpdatedAt = time.Now()
+	}
+
+	update := expression.Set(expression.Name("role"), expression.Value(user.Role)).
+		Set(expression.Name("passwordHash"), expression.Value(user.PasswordHash)).
+		Set(expression.Name("totpEnabled"), expression.Value(user.TOTPEnabled)).
+		Set(expression.Name("totpSecret"), expression.Value(user.TOTPSecret)).
+		Set(expression.Name("totpLastStep"), expression.Value(user.TOTPLastStep)).
+		Set(expression.Name("recoveryCodes"), expression.Value(recoveryCodeList(user.RecoveryCodes))).
+		Set(expression.Name("updatedAt"), expression.Value(user.UpdatedAt))
+	expr, err := expression.NewBuilder().
+		WithUpdate(update).
+		WithCondition(expression.AttributeExists(expression.Name("username"))).
+		Build()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:                 aws.String(UserTableName),
+		Key:                       userKey(user.Username),
+		ConditionExpression:       expr.Condition(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		UpdateExpression:          expr.Update(),
+	})
+
+	var conditionErr *types.ConditionalCheckFailedException
+	if errors.As(err, &conditionErr) {
+		return &UserNotFoundError{Username: user.Username}
+	}
+	return err
+}
+
+func (r *UserRepository) DeleteUser(ctx context.Context, username string) error {
+	expr, err := expression.NewBuilder().
+		WithCondition(expression.AttributeExists(expression.Name("username"))).
+		Build()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName:                aws.String(UserTableName),
+		Key:                      userKey(username),
+		ConditionExpression:      expr.Condition(),
+		ExpressionAttributeNames: expr.Names(),
+	})
+
+	var conditionErr *types.ConditionalCheckFailedException
+	if errors.As(err, &conditionErr) {
+		return &UserNotFoundError{Username: username}
+	}
+	return err
+}
+
+// recoveryCodeList는 복구 코드가 없어도 빈 목록으로 저장되도록 nil을 빈 슬라이스로 바꿉니다.
+func recoveryCodeList(codes []string) []string {
+	if codes == nil {
+		return []string{}
+	}
+	return codes
+}
+
+func userKey(username string) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"username": &types.AttributeValueMemberS{Value: username},
+	}
+}
+
+// setUserTimes는 생성 시간과 수정 시간이 없으면 now로 설정합니다.
+func setUserTimes(user *model.User, now time.Time) {
+	if user.CreatedAt.IsZero() {
+		user.CreatedAt = now
+	}
+	if user.UpdatedAt.IsZero() {
+		user.UpdatedAt = user.CreatedAt
+	}
+}
+
+func sortUsers(users []model.User) {
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].Username < users[j].Username
+	})
+}
+
+// UserExistsError는 같은 사용자 이름의 계정이 이미 있을 때 발생하는 오류입니다.
+type UserExistsError struct {
+	Username string
+}
+
+func (e *UserExistsError) Error() string {
+	return "이미 존재하는 사용자: " + e.Username
+}
+
+// UserNotFoundError는 계정을 찾을 수 없을 때 발생하는 오류입니다.
+type UserNotFoundError struct {
+	Username string
+}
+
+func (e *UserNotFoundError) Error() string {
+	return "사용자를 찾을 수 없음: " + e.Username
+}
@@ -0,0 +1,92 @@
+package scheduler
+
+import (
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+	"context"
+	"log"
+	"time"
+)
+
+// DefaultPublishInterval은 예약 게시글을 확인하는 기본 주기입니다.
+const DefaultPublishInterval = time.Minute
+
+// PublishScheduler는 발행 시간이 지난 예약 게시글을 주기적으로 발행 상태로 전환합니다.
+type PublishScheduler struct {
+	postRepo repository.PostRepositoryInterface
+	interval time.Duration
+}
+
+func NewPublishScheduler(postRepo repository.PostRepositoryInterface, interval time.Duration) *PublishScheduler {
+	if interval <= 0 {
+		interval = DefaultPublishInterval
+	}
+	return &PublishScheduler{postRepo: postRepo, interval: interval}
+}
+
+// Start는 ctx가 취소될 때까지 백그라운드에서 예약 게시글을 확인합니다.
+func (s *PublishScheduler) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			if published, err := s.PublishDue(ctx, time.Now()); err != nil {
+				log.Printf("예약 게시글 발행 실패: %v", err)
+			} else if published > 0 {
+				log.Printf("예약 게시글 발행 완료: %d건", published)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// PublishDue는 now 이전으로 발행 시간이 지정된 예약 게시글을 발행하고 발행한 건수를 반환합니다.
+func (s *PublishScheduler) PublishDue(ctx context.Context, now time.Time) (int, error) {
+	// 목록을 모두 확인한 뒤 상태를 변경해야 커서 위치가 어긋나지 않음
+	var due []model.Post
+	var cursor *string
+	for {
+		result, err := s.postRepo.GetPosts(ctx, &repository.GetPostsInput{
+			Status:   model.PostStatusScheduled,
+			Sort:     repository.SortOldest,
+			PageSize: 100,
+			Cursor:   cursor,
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		for _, post := range result.Posts {
+			if post.PublishAt != nil && !post.PublishAt.After(now) {
+				due = append(due, post)
+			}
+		}
+
+		if result.NextCursor == "" {
+			break
+		}
+		next := result.NextCursor
+		cursor = &next
+	}
+
+	published := 0
+	for _, post := range due {
+		err := s.postRepo.UpdatePostStatus(ctx, post.PostID, model.PostStatusPublished, post.PublishAt)
+		if err != nil {
+			// 처리 도중 삭제된 게시글은 건너뜀
+			if _, ok := err.(*repository.PostNotFoundError); ok {
+				continue
+			}
+			return published, err
+		}
+		published++
+	}
+
+	return published, nil
+}
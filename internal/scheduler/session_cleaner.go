@@ -0,0 +1,46 @@
+package scheduler
+
+import (
+	"bumsiku/internal/repository"
+	"context"
+	"log"
+	"time"
+)
+
+// DefaultSessionCleanupInterval은 만료된 로그인 세션을 삭제하는 기본 주기입니다.
+const DefaultSessionCleanupInterval = 10 * time.Minute
+
+// SessionCleaner는 만료된 로그인 세션을 주기적으로 저장소에서 삭제합니다.
+type SessionCleaner struct {
+	sessionRepo repository.SessionRepositoryInterface
+	interval    time.Duration
+}
+
+func NewSessionCleaner(sessionRepo repository.SessionRepositoryInterface, interval time.Duration) *SessionCleaner {
+	if interval <= 0 {
+		interval = DefaultSessionCleanupInterval
+	}
+	return &SessionCleaner{sessionRepo: sessionRepo, interval: interval}
+}
+
+// Start는 ctx가 취소될 때까지 백그라운드에서 만료된 세션을 삭제합니다.
+func (s *SessionCleaner) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			if deleted, err := s.sessionRepo.DeleteExpiredSessions(ctx, time.Now()); err != nil {
+				log.Printf("만료된 세션 삭제 실패: %v", err)
+			} else if deleted > 0 {
+				log.Printf("만료된 세션 삭제 완료: %d건", deleted)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
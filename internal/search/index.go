@@ -0,0 +1,235 @@
+package search
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+)
+
+// 필드별 가중치 (제목 일치를 본문 일치보다 높게 평가)
+const (
+	titleWeight   = 3.0
+	summaryWeight = 2.0
+	contentWeight = 1.0
+)
+
+// Result는 검색 결과 한 건입니다. 목록 조회와 마찬가지로 Content는 비워서 반환합니다.
+type Result struct {
+	model.Post
+	Score   float64 `json:"score" example:"4.2"`                                // 관련도 점수
+	Snippet string  `json:"snippet" example:"... <mark>검색어</mark>가 포함된 본문 ..."` // 검색어를 <mark>로 강조한 본문 발췌 (HTML 이스케이프됨)
+}
+
+// Index는 게시글 제목, 요약, 본문으로 만든 메모리 역색인입니다.
+// 발행 여부와 관계없이 모든 게시글을 색인하고, 검색 시 발행된 게시글만 반환합니다.
+type Index struct {
+	mu       sync.RWMutex
+	postings map[string]map[string]float64 // 토큰 → postId → 가중치 적용 출현 빈도
+	docs     map[string]document
+}
+
+type document struct {
+	post   model.Post
+	tokens []string // 색인된 고유 토큰 (삭제 시 사용)
+}
+
+func NewIndex() *Index {
+	return &Index{
+		postings: make(map[string]map[string]float64),
+		docs:     make(map[string]document),
+	}
+}
+
+// Add는 게시글을 색인합니다. 이미 색인된 게시글이면 교체합니다.
+func (idx *Index) Add(post model.Post) {
+	weights := make(map[string]float64)
+	for _, field := range []struct {
+		text   string
+		weight float64
+	}{
+		{post.Title, titleWeight},
+		{post.Summary, summaryWeight},
+		{post.Content, contentWeight},
+	} {
+		for _, token := range Tokenize(field.text) {
+			weights[token] += field.weight
+		}
+	}
+
+	tokens := make([]string, 0, len(weights))
+	for token := range weights {
+		tokens = append(tokens, token)
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.removeLocked(post.PostID)
+	for token, weight := range weights {
+		if idx.postings[token] == nil {
+			idx.postings[token] = make(map[string]float64)
+		}
+		idx.postings[token][post.PostID] = weight
+	}
+	idx.docs[post.PostID] = document{post: post, tokens: tokens}
+}
+
+// Remove는 게시글을 색인에서 제거합니다.
+func (idx *Index) Remove(postID string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.removeLocked(postID)
+}
+
+func (idx *Index) removeLocked(postID string) {
+	doc, ok := idx.docs[postID]
+	if !ok {
+		return
+	}
+	for _, token := range doc.tokens {
+		delete(idx.postings[token], postID)
+		if len(idx.postings[token]) == 0 {
+			delete(idx.postings, token)
+		}
+	}
+	delete(idx.docs, postID)
+}
+
+// Search는 검색어의 모든 토큰을 포함하는 발행된 게시글을 관련도순으로 반환합니다.
+// offset, limit으로 페이지를 지정하며, 페이지와 관계없이 전체 일치 건수를 함께 반환합니다.
+// offset이 음수이거나 limit이 0 이하이면 빈 페이지를 반환합니다.
+func (idx *Index) Search(query string, offset, limit int) ([]Result, int) {
+	queryTokens := uniqueTokens(Tokenize(query))
+	if len(queryTokens) == 0 {
+		return []Result{}, 0
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	// 가장 드문 토큰부터 교집합 계산
+	sort.Slice(queryTokens, func(i, j int) bool {
+		return len(idx.postings[queryTokens[i]]) < len(idx.postings[queryTokens[j]])
+	})
+
+	scores := make(map[string]float64)
+	for postID := range idx.postings[queryTokens[0]] {
+		if doc := idx.docs[postID]; doc.post.IsPublished() {
+			scores[postID] = 0
+		}
+	}
+
+	total := float64(len(idx.docs))
+	for _, token := range queryTokens {
+		postings := idx.postings[token]
+		idf := math.Log(1 + total/float64(len(postings)+1))
+		for postID := range scores {
+			weight, ok := postings[postID]
+			if !ok {
+				delete(scores, postID)
+				continue
+			}
+			scores[postID] += idf * (1 + math.Log(weight))
+		}
+	}
+
+	results := make([]Result, 0, len(scores))
+	for postID, score := range scores {
+		results = append(results, Result{Post: idx.docs[postID].post, Score: score})
+	}
+
+	// 점수가 같으면 최신 게시글 우선
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		if !results[i].CreatedAt.Equal(results[j].CreatedAt) {
+			return results[i].CreatedAt.After(results[j].CreatedAt)
+		}
+		return results[i].PostID > results[j].PostID
+	})
+
+	matched := len(results)
+	if offset < 0 || limit <= 0 || offset >= matched {
+		return []Result{}, matched
+	}
+	end := matched
+	if limit < matched-offset {
+		end = offset + limit
+	}
+	results = results[offset:end]
+
+	// 현재 페이지에 대해서만 발췌 생성
+	terms := Terms(query)
+	for i := range results {
+		source := results[i].Content
+		if source == "" {
+			source = results[i].Summary
+		}
+		results[i].Snippet = Snippet(source, terms)
+		results[i].Content = ""
+	}
+
+	return results, matched
+}
+
+// Len은 색인된 게시글 수를 반환합니다.
+func (idx *Index) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	return len(idx.docs)
+}
+
+// Rebuild는 저장소의 모든 게시글로 색인을 다시 만들고 색인한 게시글 수를 반환합니다.
+func (idx *Index) Rebuild(ctx context.Context, postRepo repository.PostRepositoryInterface) (int, error) {
+	var posts []model.Post
+	var cursor *string
+	for {
+		result, err := postRepo.GetPosts(ctx, &repository.GetPostsInput{
+			Sort:        repository.SortOldest,
+			PageSize:    100,
+			Cursor:      cursor,
+			WithContent: true,
+		})
+		if err != nil {
+			return 0, err
+		}
+		posts = append(posts, result.Posts...)
+
+		if result.NextCursor == "" {
+			break
+		}
+		next := result.NextCursor
+		cursor = &next
+	}
+
+	rebuilt := NewIndex()
+	for _, post := range posts {
+		rebuilt.Add(post)
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.postings = rebuilt.postings
+	idx.docs = rebuilt.docs
+	return len(posts), nil
+}
+
+func uniqueTokens(tokens []string) []string {
+	seen := make(map[string]bool, len(tokens))
+	unique := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		if !seen[token] {
+			seen[token] = true
+			unique = append(unique, token)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,78 @@
+package search
+
+import (
+	"context"
+	"time"
+
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+	"bumsiku/internal/utils"
+)
+
+// IndexedPostRepository는 게시글 저장소를 감싸 쓰기 작업이 성공할 때마다 검색 색인을 갱신합니다.
+// 핸들러뿐 아니라 예약 발행 스케줄러의 상태 변경도 색인에 반영됩니다.
+type IndexedPostRepository struct {
+	repository.PostRepositoryInterface
+	index  *Index
+	logger *utils.Logger
+}
+
+func NewIndexedPostRepository(postRepo repository.PostRepositoryInterface, index *Index, logger *utils.Logger) *IndexedPostRepository {
+	return &IndexedPostRepository{PostRepositoryInterface: postRepo, index: index, logger: logger}
+}
+
+func (r *IndexedPostRepository) CreatePost(ctx context.Context, post *model.Post) error {
+	if err := r.PostRepositoryInterface.CreatePost(ctx, post); err != nil {
+		return err
+	}
+
+	r.index.Add(*post)
+	return nil
+}
+
+func (r *IndexedPostRepository) UpdatePost(ctx context.Context, post *model.Post, expectedVersion *int64) error {
+	if err := r.PostRepositoryInterface.UpdatePost(ctx, post, expectedVersion); err != nil {
+		return err
+	}
+
+	// 수정 요청에는 상태 등 일부 필드가 없으므로 저장된 게시글로 색인
+	r.reindex(ctx, post.PostID)
+	return nil
+}
+
+func (r *IndexedPostRepository) DeletePost(ctx context.Context, postID string) error {
+	if err := r.PostRepositoryInterface.DeletePost(ctx, postID); err != nil {
+		return err
+	}
+
+	r.index.Remove(postID)
+	return nil
+}
+
+func (r *IndexedPostRepository) UpdatePostStatus(ctx context.Context, postID string, status string, publishAt *time.Time) error {
+	if err := r.PostRepositoryInterface.UpdatePostStatus(ctx, postID, status, publishAt); err != nil {
+		return err
+	}
+
+	r.reindex(ctx, postID)
+	return nil
+}
+
+// reindex는 저장된 게시글을 다시 읽어 색인합니다.
+// 읽기에 실패하면 이전 내용으로 검색되지만 색인은 서버 시작 시 다시 만들어지므로 경고만 남깁니다.
+func (r *IndexedPostRepository) reindex(ctx context.Context, postID string) {
+	post, err := r.PostRepositoryInterface.GetPostByID(ctx, postID)
+	if err != nil {
+		r.logger.Warn(ctx, "검색 색인 갱신 실패", map[string]string{
+			"postID": postID,
+			"error":  err.Error(),
+		})
+		return
+	}
+	if post == nil {
+		r.index.Remove(postID)
+		return
+	}
+
+	r.index.Add(*post)
+}
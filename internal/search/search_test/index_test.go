@@ -0,0 +1,224 @@
+package search
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+	"bumsiku/internal/search"
+	"bumsiku/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var baseTime = time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+func newPost(id, title, summary, content string, offset time.Duration) model.Post {
+	return model.Post{
+		PostID:    id,
+		Title:     title,
+		Summary:   summary,
+		Content:   content,
+		Category:  "tech",
+		Status:    model.PostStatusPublished,
+		CreatedAt: baseTime.Add(offset),
+		UpdatedAt: baseTime.Add(offset),
+	}
+}
+
+func resultIDs(results []search.Result) []string {
+	ids := make([]string, 0, len(results))
+	for _, result := range results {
+		ids = append(ids, result.PostID)
+	}
+	return ids
+}
+
+// [GIVEN] 조사가 붙은 한글 단어
+// [WHEN] Tokenize 호출
+// [THEN] 조사를 제거한 어간과 2-gram으로 나뉘는지 확인
+func TestTokenize_Hangul(t *testing.T) {
+	assert.Equal(t, []string{"블로그", "블로", "로그"}, search.Tokenize("블로그에서"))
+	assert.Equal(t, []string{"블로그", "블로", "로그"}, search.Tokenize("블로그를"))
+	assert.Equal(t, []string{"검색"}, search.Tokenize("검색"))
+}
+
+// [GIVEN] 영문, 숫자, 한글이 섞인 텍스트
+// [WHEN] Tokenize 호출
+// [THEN] 소문자 단어와 한글 토큰으로 분리되는지 확인
+func TestTokenize_Mixed(t *testing.T) {
+	assert.Equal(t, []string{"go", "언어", "1", "23"}, search.Tokenize("Go언어 1.23"))
+}
+
+// [GIVEN] 제목, 요약, 본문에 검색어가 있는 게시글들
+// [WHEN] 검색
+// [THEN] 제목 일치가 본문 일치보다 먼저 반환되는지 확인
+func TestSearch_RanksTitleFirst(t *testing.T) {
+	index := search.NewIndex()
+	index.Add(newPost("content", "일상 기록", "요약", "오늘은 동시성 이야기를 조금 했다", 2*time.Hour))
+	index.Add(newPost("title", "Go 동시성 패턴", "요약", "채널과 고루틴", 0))
+	index.Add(newPost("none", "다른 글", "요약", "관련 없는 내용", time.Hour))
+
+	results, total := index.Search("동시성", 0, 10)
+	assert.Equal(t, 2, total)
+	assert.Equal(t, []string{"title", "content"}, resultIDs(results))
+	assert.Empty(t, results[0].Content)
+}
+
+// [GIVEN] 조사가 다르게 붙은 단어를 포함한 게시글
+// [WHEN] 다른 조사를 붙인 검색어로 검색
+// [THEN] 같은 게시글이 검색되는지 확인
+func TestSearch_IgnoresParticles(t *testing.T) {
+	index := search.NewIndex()
+	index.Add(newPost("post1", "제목", "요약", "블로그를 새로 만들었습니다", 0))
+
+	results, total := index.Search("블로그에서", 0, 10)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, []string{"post1"}, resultIDs(results))
+}
+
+// [GIVEN] 여러 단어로 된 검색어
+// [WHEN] 검색
+// [THEN] 모든 단어를 포함한 게시글만 반환되는지 확인
+func TestSearch_RequiresAllTerms(t *testing.T) {
+	index := search.NewIndex()
+	index.Add(newPost("both", "Go 테스트", "요약", "내용", 0))
+	index.Add(newPost("one", "Go 입문", "요약", "내용", time.Hour))
+
+	results, _ := index.Search("go 테스트", 0, 10)
+	assert.Equal(t, []string{"both"}, resultIDs(results))
+}
+
+// [GIVEN] 발행되지 않은 게시글과 삭제된 게시글
+// [WHEN] 검색
+// [THEN] 검색 결과에서 제외되는지 확인
+func TestSearch_ExcludesUnpublishedAndRemoved(t *testing.T) {
+	index := search.NewIndex()
+	draft := newPost("draft", "검색 초안", "요약", "내용", 0)
+	draft.Status = model.PostStatusDraft
+	index.Add(draft)
+	index.Add(newPost("removed", "검색 삭제", "요약", "내용", time.Hour))
+	index.Add(newPost("published", "검색 공개", "요약", "내용", 2*time.Hour))
+
+	index.Remove("removed")
+
+	results, total := index.Search("검색", 0, 10)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, []string{"published"}, resultIDs(results))
+}
+
+// [GIVEN] 검색어와 일치하는 게시글이 여러 건
+// [WHEN] offset, limit을 지정하여 검색
+// [THEN] 전체 건수와 해당 페이지만 반환되는지 확인
+func TestSearch_Pagination(t *testing.T) {
+	index := search.NewIndex()
+	for i, id := range []string{"a", "b", "c"} {
+		index.Add(newPost(id, "페이지 테스트", "요약", "내용", time.Duration(i)*time.Hour))
+	}
+
+	results, total := index.Search("페이지", 2, 2)
+	assert.Equal(t, 3, total)
+	assert.Equal(t, []string{"a"}, resultIDs(results))
+}
+
+// [GIVEN] 검색어와 일치하는 게시글
+// [WHEN] 음수 offset, 0 이하 limit, 매우 큰 limit으로 검색
+// [THEN] 패닉 없이 빈 페이지 또는 남은 결과와 전체 건수를 반환하는지 확인
+func TestSearch_InvalidPage(t *testing.T) {
+	index := search.NewIndex()
+	for i, id := range []string{"a", "b"} {
+		index.Add(newPost(id, "페이지 테스트", "요약", "내용", time.Duration(i)*time.Hour))
+	}
+
+	results, total := index.Search("페이지", -10, 10)
+	assert.Equal(t, 2, total)
+	assert.Empty(t, results)
+
+	results, total = index.Search("페이지", 0, 0)
+	assert.Equal(t, 2, total)
+	assert.Empty(t, results)
+
+	results, _ = index.Search("페이지", 1, math.MaxInt)
+	assert.Equal(t, []string{"a"}, resultIDs(results))
+}
+
+// [GIVEN] 검색어가 포함된 본문
+// [WHEN] Snippet 호출
+// [THEN] 검색어가 <mark>로 감싸지고 HTML이 이스케이프되는지 확인
+func TestSnippet_Highlight(t *testing.T) {
+	snippet := search.Snippet("<b>Go</b> 언어의\n동시성은 GO 답다", []string{"go"})
+	assert.Equal(t, "&lt;b&gt;<mark>Go</mark>&lt;/b&gt; 언어의 동시성은 <mark>GO</mark> 답다", snippet)
+}
+
+// [GIVEN] 검색어가 본문 뒤쪽에 있는 긴 본문
+// [WHEN] Snippet 호출
+// [THEN] 일치 위치 주변만 발췌되는지 확인
+func TestSnippet_Window(t *testing.T) {
+	text := ""
+	for i := 0; i < 100; i++ {
+		text += "가"
+	}
+	text += "검색어"
+	for i := 0; i < 200; i++ {
+		text += "나"
+	}
+
+	snippet := search.Snippet(text, []string{"검색어"})
+	assert.Contains(t, snippet, "<mark>검색어</mark>")
+	assert.True(t, len([]rune(snippet)) < 150)
+	assert.Equal(t, "...", snippet[:3])
+}
+
+// [GIVEN] 저장소를 감싼 색인
+// [WHEN] 게시글 생성, 수정, 상태 변경, 삭제
+// [THEN] 색인이 저장소 변경을 따라가는지 확인
+func TestIndexedPostRepository_KeepsIndexInSync(t *testing.T) {
+	ctx := context.Background()
+	index := search.NewIndex()
+	logger := utils.NewLogger(utils.NewMemorySink())
+	defer logger.Close(ctx)
+	repo := search.NewIndexedPostRepository(repository.NewMemoryPostRepository(repository.NewCursorSigner(nil)), index, logger)
+
+	post := newPost("post1", "처음 제목", "요약", "내용", 0)
+	require.NoError(t, repo.CreatePost(ctx, &post))
+	_, total := index.Search("처음", 0, 10)
+	assert.Equal(t, 1, total)
+
+	update := newPost("post1", "바뀐 제목", "요약", "내용", 0)
+	require.NoError(t, repo.UpdatePost(ctx, &update, nil))
+	_, total = index.Search("처음", 0, 10)
+	assert.Equal(t, 0, total)
+	_, total = index.Search("바뀐", 0, 10)
+	assert.Equal(t, 1, total)
+
+	require.NoError(t, repo.UpdatePostStatus(ctx, "post1", model.PostStatusDraft, nil))
+	_, total = index.Search("바뀐", 0, 10)
+	assert.Equal(t, 0, total)
+
+	require.NoError(t, repo.DeletePost(ctx, "post1"))
+	assert.Equal(t, 0, index.Len())
+}
+
+// [GIVEN] 게시글이 저장된 저장소
+// [WHEN] Rebuild 호출
+// [THEN] 본문까지 색인되는지 확인
+func TestIndex_Rebuild(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemoryPostRepository(repository.NewCursorSigner(nil))
+	for i, id := range []string{"post1", "post2"} {
+		post := newPost(id, "제목", "요약", "본문에만 있는 단어 "+id, time.Duration(i)*time.Hour)
+		require.NoError(t, repo.CreatePost(ctx, &post))
+	}
+
+	index := search.NewIndex()
+	indexed, err := index.Rebuild(ctx, repo)
+	require.NoError(t, err)
+	assert.Equal(t, 2, indexed)
+
+	results, _ := index.Search("post2", 0, 10)
+	assert.Equal(t, []string{"post2"}, resultIDs(results))
+}
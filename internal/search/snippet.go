@@ -0,0 +1,87 @@
+package search
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+// snippetRadius는 발췌에서 첫 일치 위치 앞뒤로 포함할 글자 수입니다.
+const snippetRadius = 60
+
+// Snippet은 text에서 검색어가 처음 나오는 부분을 발췌하고 검색어를 <mark>로 감쌉니다.
+// 발췌 문자열은 HTML 이스케이프되며, 검색어가 없으면 text 앞부분을 반환합니다.
+func Snippet(text string, terms []string) string {
+	// 줄바꿈과 연속 공백은 한 칸으로 정리
+	runes := []rune(strings.Join(strings.Fields(text), " "))
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	termRunes := make([][]rune, 0, len(terms))
+	for _, term := range terms {
+		if term != "" {
+			termRunes = append(termRunes, []rune(strings.ToLower(term)))
+		}
+	}
+
+	// 첫 일치 위치를 기준으로 발췌 구간 결정
+	first := -1
+	for i := range lower {
+		if matchAt(lower, i, termRunes) > 0 {
+			first = i
+			break
+		}
+	}
+
+	start, end := 0, len(runes)
+	if first > snippetRadius {
+		start = first - snippetRadius
+	}
+	if start+snippetRadius*2 < end {
+		end = start + snippetRadius*2
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("...")
+	}
+	plainStart := start
+	for i := start; i < end; {
+		length := matchAt(lower, i, termRunes)
+		if length == 0 {
+			i++
+			continue
+		}
+		if i+length > end {
+			length = end - i
+		}
+		b.WriteString(html.EscapeString(string(runes[plainStart:i])))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(string(runes[i : i+length])))
+		b.WriteString("</mark>")
+		i += length
+		plainStart = i
+	}
+	b.WriteString(html.EscapeString(string(runes[plainStart:end])))
+	if end < len(runes) {
+		b.WriteString("...")
+	}
+
+	return b.String()
+}
+
+// matchAt은 lower[i:]에서 시작하는 가장 긴 검색어 길이를 반환합니다. 없으면 0입니다.
+func matchAt(lower []rune, i int, terms [][]rune) int {
+	longest := 0
+	for _, term := range terms {
+		if len(term) <= longest || i+len(term) > len(lower) {
+			continue
+		}
+		if string(lower[i:i+len(term)]) == string(term) {
+			longest = len(term)
+		}
+	}
+	return longest
+}
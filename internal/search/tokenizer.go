@@ -0,0 +1,107 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// koreanParticles는 단어 끝에서 제거할 조사와 어미입니다. 짧은 조사가 먼저 일치하지 않도록 긴 것을 앞에 둡니다.
+var koreanParticles = []string{
+	"으로부터", "에서부터",
+	"이라고", "입니다", "으로서", "으로써", "에게서", "한테서",
+	"까지", "부터", "에서", "에게", "한테", "으로", "처럼", "보다",
+	"하고", "이나", "라고", "이다", "들의", "들은", "들이", "들을",
+	"은", "는", "이", "가", "을", "를", "에", "의", "와", "과", "도", "로", "만", "들",
+}
+
+// Tokenize는 텍스트를 색인/검색용 토큰으로 나눕니다.
+// 영문과 숫자는 소문자 단어 단위로, 한글은 조사를 제거한 어간과 어간의 2-gram으로 나눕니다.
+// 색인과 검색어에 같은 규칙을 적용하므로 "블로그에서"와 "블로그를"은 같은 토큰을 공유합니다.
+func Tokenize(text string) []string {
+	var tokens []string
+	for _, word := range splitWords(text) {
+		tokens = append(tokens, wordTokens(word)...)
+	}
+	return tokens
+}
+
+// Terms는 검색어를 강조 표시용 단어 목록으로 변환합니다. 한글 단어는 조사를 제거합니다.
+func Terms(query string) []string {
+	var terms []string
+	for _, word := range splitWords(query) {
+		if isHangulWord(word) {
+			word = stripParticle(word)
+		}
+		terms = append(terms, word)
+	}
+	return terms
+}
+
+// splitWords는 글자와 숫자가 아닌 문자를 기준으로 텍스트를 소문자 단어로 나눕니다.
+// 한글과 다른 문자가 붙어 있으면(예: "Go언어") 별도 단어로 분리합니다.
+func splitWords(text string) []string {
+	var words []string
+	var current []rune
+	currentHangul := false
+
+	flush := func() {
+		if len(current) > 0 {
+			words = append(words, string(current))
+			current = current[:0]
+		}
+	}
+
+	for _, r := range strings.ToLower(text) {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			flush()
+			continue
+		}
+		hangul := unicode.Is(unicode.Hangul, r)
+		if len(current) > 0 && hangul != currentHangul {
+			flush()
+		}
+		currentHangul = hangul
+		current = append(current, r)
+	}
+	flush()
+
+	return words
+}
+
+func isHangulWord(word string) bool {
+	for _, r := range word {
+		return unicode.Is(unicode.Hangul, r)
+	}
+	return false
+}
+
+// wordTokens는 한 단어의 토큰을 생성합니다.
+func wordTokens(word string) []string {
+	if !isHangulWord(word) {
+		return []string{word}
+	}
+
+	stem := []rune(stripParticle(word))
+	if len(stem) <= 2 {
+		return []string{string(stem)}
+	}
+
+	// 어간 전체와 2-gram (합성어 일부만 검색해도 찾을 수 있도록)
+	tokens := []string{string(stem)}
+	for i := 0; i+1 < len(stem); i++ {
+		tokens = append(tokens, string(stem[i:i+2]))
+	}
+	return tokens
+}
+
+// stripParticle은 한글 단어 끝의 조사를 제거합니다. 어간이 두 글자 미만으로 남으면 원래 단어를 반환합니다.
+func stripParticle(word string) string {
+	runes := []rune(word)
+	for _, particle := range koreanParticles {
+		p := []rune(particle)
+		if len(runes)-len(p) >= 2 && string(runes[len(runes)-len(p):]) == particle {
+			return string(runes[:len(runes)-len(p)])
+		}
+	}
+	return word
+}
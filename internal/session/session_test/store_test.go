@@ -0,0 +1,204 @@
+package session
+
+import (
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"bumsiku/internal/repository"
+	"bumsiku/internal/session"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	cookieName  = "loginSession"
+	idleTimeout = 2 * time.Hour
+	maxAge      = 24 * time.Hour
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+	gob.Register(time.Time{})
+}
+
+// setupRouter는 서버 세션 저장소를 사용하는 로그인, 조회, 로그아웃 라우트를 구성합니다.
+func setupRouter(repo repository.SessionRepositoryInterface) *gin.Engine {
+	router := gin.New()
+	router.Use(sessions.Sessions(cookieName, session.NewStore(repo, idleTimeout, sessions.Options{
+		Path:     "/",
+		MaxAge:   int(maxAge.Seconds()),
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteStrictMode,
+	})))
+
+	router.POST("/login", func(c *gin.Context) {
+		s := sessions.Default(c)
+		s.Set("username", "editor1")
+		s.Set("ip", "203.0.113.10")
+		s.Set("loginTime", time.Now())
+		if err := s.Save(); err != nil {
+			c.Status(http.StatusInternalServerError)
+			return
+		}
+		c.Status(http.StatusOK)
+	})
+	router.GET("/me", func(c *gin.Context) {
+		s := sessions.Default(c)
+		username, _ := s.Get("username").(string)
+		c.JSON(http.StatusOK, gin.H{"username": username})
+	})
+	router.POST("/logout", func(c *gin.Context) {
+		s := sessions.Default(c)
+		s.Clear()
+		s.Options(sessions.Options{Path: "/", MaxAge: -1})
+		if err := s.Save(); err != nil {
+			c.Status(http.StatusInternalServerError)
+			return
+		}
+		c.Status(http.StatusOK)
+	})
+	return router
+}
+
+func login(t *testing.T, router *gin.Engine) *http.Cookie {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/login", nil)
+	req.Header.Set("User-Agent", "test-agent")
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	cookies := w.Result().Cookies()
+	require.Len(t, cookies, 1)
+	return cookies[0]
+}
+
+func whoami(router *gin.Engine, cookie *http.Cookie) string {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/me", nil)
+	req.AddCookie(cookie)
+	router.ServeHTTP(w, req)
+
+	var body struct {
+		Username string `json:"username"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		return ""
+	}
+	return body.Username
+}
+
+// [GIVEN] 서버 세션 저장소
+// [WHEN] 로그인 후 발급된 쿠키로 다시 요청
+// [THEN] 쿠키에는 토큰만 있고 저장소에 토큰의 해시로 세션 정보가 저장되며, 쿠키 속성이 설정을 따름 확인
+func TestStore_SaveAndLoad(t *testing.T) {
+	repo := repository.NewMemorySessionRepository()
+	router := setupRouter(repo)
+
+	cookie := login(t, router)
+	assert.Equal(t, cookieName, cookie.Name)
+	assert.True(t, cookie.HttpOnly)
+	assert.True(t, cookie.Secure)
+	assert.Equal(t, http.SameSiteStrictMode, cookie.SameSite)
+	assert.Equal(t, int(maxAge.Seconds()), cookie.MaxAge)
+
+	stored, err := repo.GetSession(context.Background(), session.HashToken(cookie.Value))
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	assert.NotEqual(t, cookie.Value, stored.SessionID)
+	assert.Equal(t, "editor1", stored.Username)
+	assert.Equal(t, "203.0.113.10", stored.IP)
+	assert.Equal(t, "test-agent", stored.UserAgent)
+	assert.WithinDuration(t, time.Now().Add(idleTimeout), stored.ExpiresAt, time.Minute)
+
+	assert.Equal(t, "editor1", whoami(router, cookie))
+	assert.Equal(t, "", whoami(router, &http.Cookie{Name: cookieName, Value: "forged"}))
+}
+
+// [GIVEN] 로그인한 세션
+// [WHEN] 저장소에서 세션을 삭제하거나 만료 시간이 지남
+// [THEN] 같은 쿠키로 요청해도 로그인 상태가 아님 확인
+func TestStore_RevokedAndExpired(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemorySessionRepository()
+	router := setupRouter(repo)
+
+	revoked := login(t, router)
+	require.NoError(t, repo.DeleteSession(ctx, session.HashToken(revoked.Value)))
+	assert.Equal(t, "", whoami(router, revoked))
+
+	expired := login(t, router)
+	stored, err := repo.GetSession(ctx, session.HashToken(expired.Value))
+	require.NoError(t, err)
+	stored.ExpiresAt = time.Now().Add(-time.Second)
+	require.NoError(t, repo.SaveSession(ctx, stored))
+	assert.Equal(t, "", whoami(router, expired))
+}
+
+// [GIVEN] 한동안 사용하지 않은 세션
+// [WHEN] 다시 요청
+// [THEN] 유휴 만료 시간이 연장되지만 로그인 후 최대 유지 시간을 넘지 않음 확인
+func TestStore_SlidingExpiry(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemorySessionRepository()
+	router := setupRouter(repo)
+
+	cookie := login(t, router)
+	sessionID := session.HashToken(cookie.Value)
+	stored, err := repo.GetSession(ctx, sessionID)
+	require.NoError(t, err)
+
+	// 10분 전에 마지막으로 사용
+	stored.LastSeenAt = time.Now().Add(-10 * time.Minute)
+	stored.ExpiresAt = time.Now().Add(time.Minute)
+	require.NoError(t, repo.SaveSession(ctx, stored))
+
+	assert.Equal(t, "editor1", whoami(router, cookie))
+	touched, err := repo.GetSession(ctx, sessionID)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), touched.LastSeenAt, time.Minute)
+	assert.WithinDuration(t, time.Now().Add(idleTimeout), touched.ExpiresAt, time.Minute)
+
+	// 로그인한 지 23시간이 지났으면 최대 유지 시간까지만 연장
+	touched.LoginTime = time.Now().Add(-23 * time.Hour)
+	touched.LastSeenAt = time.Now().Add(-10 * time.Minute)
+	require.NoError(t, repo.SaveSession(ctx, touched))
+
+	assert.Equal(t, "editor1", whoami(router, cookie))
+	capped, err := repo.GetSession(ctx, sessionID)
+	require.NoError(t, err)
+	assert.WithinDuration(t, touched.LoginTime.Add(maxAge), capped.ExpiresAt, time.Second)
+}
+
+// [GIVEN] 로그인한 세션
+// [WHEN] MaxAge를 음수로 지정해 저장 (로그아웃)
+// [THEN] 저장소에서 세션이 삭제되고 쿠키가 지워짐 확인
+func TestStore_Logout(t *testing.T) {
+	repo := repository.NewMemorySessionRepository()
+	router := setupRouter(repo)
+	cookie := login(t, router)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/logout", nil)
+	req.AddCookie(cookie)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	cleared := w.Result().Cookies()
+	require.Len(t, cleared, 1)
+	assert.Equal(t, "", cleared[0].Value)
+	assert.Less(t, cleared[0].MaxAge, 0)
+
+	stored, err := repo.GetSession(context.Background(), session.HashToken(cookie.Value))
+	require.NoError(t, err)
+	assert.Nil(t, stored)
+	assert.Equal(t, "", whoami(router, cookie))
+}
@@ -0,0 +1,266 @@
+package session
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/gob"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+
+	"github.com/gin-contrib/sessions"
+	gsessions "github.com/gorilla/sessions"
+)
+
+// touchInterval은 세션의 마지막 사용 시간을 저장소에 기록하는 최소 간격입니다.
+// 요청마다 저장소에 쓰지 않도록 이 간격이 지난 뒤에만 만료 시간을 연장합니다.
+const touchInterval = time.Minute
+
+// tokenSize는 쿠키에 저장하는 세션 토큰의 바이트 수입니다.
+const tokenSize = 32
+
+// Store는 세션 값을 서버 저장소에 보관하는 gin-contrib/sessions 세션 저장소입니다.
+// 쿠키에는 임의의 세션 토큰만 저장하고 저장소에는 토큰의 해시를 세션 ID로 사용하므로,
+// 저장소에서 세션을 삭제하면 해당 쿠키로는 더 이상 로그인 상태를 사용할 수 없습니다.
+//
+// 세션은 마지막 사용 후 idleTimeout이 지나면 만료되며(사용할 때마다 연장),
+// 계속 사용하더라도 로그인 시간("loginTime" 값)으로부터 쿠키 MaxAge가 지나면 만료됩니다.
+// 세션 목록에 표시할 계정, IP, 브라우저는 세션 값 "username", "ip", "userAgent"에서 읽습니다.
+type Store struct {
+	repo        repository.SessionRepositoryInterface
+	options     *gsessions.Options
+	idleTimeout time.Duration
+	maxAge      time.Duration
+}
+
+func NewStore(repo repository.SessionRepositoryInterface, idleTimeout time.Duration, options sessions.Options) *Store {
+	store := &Store{repo: repo, idleTimeout: idleTimeout}
+	store.Options(options)
+	return store
+}
+
+// Options는 새 세션에 적용할 쿠키 설정을 지정합니다. MaxAge는 로그인 후 세션의 최대 유지 시간으로도 사용합니다.
+func (s *Store) Options(options sessions.Options) {
+	s.options = options.ToGorillaOptions()
+	s.maxAge = time.Duration(options.MaxAge) * time.Second
+}
+
+// Get은 요청에서 이미 읽은 세션이 있으면 반환하고, 없으면 저장소에서 읽습니다.
+func (s *Store) Get(r *http.Request, name string) (*gsessions.Session, error) {
+	return gsessions.GetRegistry(r).Get(s, name)
+}
+
+// New는 요청 쿠키의 세션 토큰으로 저장소의 세션을 읽습니다.
+// 세션이 없거나 폐기, 만료되었으면 빈 새 세션을 반환합니다.
+func (s *Store) New(r *http.Request, name string) (*gsessions.Session, error) {
+	cookie, err := r.Cookie(name)
+	if err != nil || cookie.Value == "" {
+		return s.newSession(name), nil
+	}
+
+	ctx := r.Context()
+	sessionID := HashToken(cookie.Value)
+	stored, err := s.repo.GetSession(ctx, sessionID)
+	if err != nil {
+		return s.newSession(name), err
+	}
+	now := time.Now()
+	if stored == nil || stored.IsExpired(now) {
+		return s.newSession(name), nil
+	}
+
+	values, err := decodeValues(stored.Data)
+	if err != nil {
+		return s.newSession(name), err
+	}
+
+	// 유휴 만료 시간 연장 (처리 도중 폐기된 세션은 새 세션으로 시작)
+	if now.Sub(stored.LastSeenAt) >= touchInterval {
+		err := s.repo.TouchSession(ctx, sessionID, now, s.expiresAt(stored.LoginTime, now))
+		if _, ok := err.(*repository.SessionNotFoundError); ok {
+			return s.newSession(name), nil
+		}
+		if err != nil {
+			return s.newSession(name), err
+		}
+	}
+
+	session := s.newSession(name)
+	session.ID = sessionID
+	session.Values = values
+	session.IsNew = false
+	return session, nil
+}
+
+// Save는 세션 값을 저장소에 저장하고 세션 토큰 쿠키를 설정합니다.
+// 세션의 MaxAge가 음수이면 저장소에서 세션을 삭제하고 쿠키를 지웁니다 (로그아웃).
+func (s *Store) Save(r *http.Request, w http.ResponseWriter, session *gsessions.Session) error {
+	ctx := r.Context()
+
+	if session.Options.MaxAge < 0 {
+		if err := s.delete(ctx, session.ID); err != nil {
+			return err
+		}
+		session.ID = ""
+		http.SetCookie(w, gsessions.NewCookie(session.Name(), "", session.Options))
+		return nil
+	}
+
+	// 처음 저장하는 세션이거나 Regenerate로 ID를 지운 세션이면 새 토큰 발급
+	// 요청 쿠키가 가리키던 이전 세션은 삭제해 이전 토큰으로는 새 세션을 사용할 수 없게 함
+	token := requestToken(r, session)
+	if token == "" {
+		if err := s.delete(ctx, requestSessionID(r, session.Name())); err != nil {
+			return err
+		}
+
+		var err error
+		if token, err = newToken(); err != nil {
+			return err
+		}
+		session.ID = HashToken(token)
+	}
+
+	data, err := encodeValues(session.Values)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	loginTime, ok := session.Values["loginTime"].(time.Time)
+	if !ok {
+		loginTime = now
+	}
+	username, _ := session.Values["username"].(string)
+	ip, _ := session.Values["ip"].(string)
+	userAgent, ok := session.Values["userAgent"].(string)
+	if !ok {
+		userAgent = r.UserAgent()
+	}
+
+	err = s.repo.SaveSession(ctx, &model.Session{
+		SessionID:  session.ID,
+		Username:   username,
+		IP:         ip,
+		UserAgent:  userAgent,
+		LoginTime:  loginTime,
+		LastSeenAt: now,
+		ExpiresAt:  s.expiresAt(loginTime, now),
+		Data:       data,
+	})
+	if err != nil {
+		return err
+	}
+
+	session.IsNew = false
+	http.SetCookie(w, gsessions.NewCookie(session.Name(), token, session.Options))
+	return nil
+}
+
+// Regenerate는 다음 Save에서 세션에 새 토큰을 발급하도록 합니다. 세션 값은 유지되고 이전 세션은 저장소에서 삭제됩니다.
+// 로그인처럼 권한이 바뀔 때 호출해, 로그인 전에 알려진 토큰이 그대로 로그인 세션이 되는 세션 고정을 막습니다.
+// Store가 아닌 저장소의 세션이면 아무 작업도 하지 않습니다.
+func Regenerate(s sessions.Session) {
+	if gs, ok := s.(interface{ Session() *gsessions.Session }); ok {
+		if session := gs.Session(); isStore(session.Store()) {
+			session.ID = ""
+		}
+	}
+}
+
+func isStore(store gsessions.Store) bool {
+	_, ok := store.(*Store)
+	return ok
+}
+
+// HashToken은 쿠키의 세션 토큰으로 저장소의 세션 ID를 계산합니다.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *Store) newSession(name string) *gsessions.Session {
+	session := gsessions.NewSession(s, name)
+	options := *s.options
+	session.Options = &options
+	session.IsNew = true
+	return session
+}
+
+// expiresAt은 now에 사용한 세션의 만료 시간입니다. 유휴 만료 시간과 로그인 후 최대 유지 시간 중 이른 시간을 사용합니다.
+func (s *Store) expiresAt(loginTime, now time.Time) time.Time {
+	expiresAt := now.Add(s.idleTimeout)
+	if s.maxAge > 0 {
+		if limit := loginTime.Add(s.maxAge); limit.Before(expiresAt) {
+			expiresAt = limit
+		}
+	}
+	return expiresAt
+}
+
+// delete는 저장소의 세션을 삭제합니다. 이미 삭제된 세션은 무시합니다.
+func (s *Store) delete(ctx context.Context, sessionID string) error {
+	if sessionID == "" {
+		return nil
+	}
+	err := s.repo.DeleteSession(ctx, sessionID)
+	if _, ok := err.(*repository.SessionNotFoundError); ok {
+		return nil
+	}
+	return err
+}
+
+// requestSessionID는 요청 쿠키의 세션 토큰이 가리키는 세션 ID입니다. 쿠키가 없으면 빈 문자열입니다.
+func requestSessionID(r *http.Request, name string) string {
+	cookie, err := r.Cookie(name)
+	if err != nil || cookie.Value == "" {
+		return ""
+	}
+	return HashToken(cookie.Value)
+}
+
+// requestToken은 요청 쿠키의 세션 토큰이 세션과 일치하면 반환합니다.
+func requestToken(r *http.Request, session *gsessions.Session) string {
+	if session.ID == "" {
+		return ""
+	}
+	cookie, err := r.Cookie(session.Name())
+	if err != nil || HashToken(cookie.Value) != session.ID {
+		return ""
+	}
+	return cookie.Value
+}
+
+func newToken() (string, error) {
+	b := make([]byte, tokenSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// encodeValues는 세션 값을 gob으로 인코딩합니다. 기본 타입이 아닌 값은 gob.Register로 등록해야 합니다.
+func encodeValues(values map[interface{}]interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(values); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeValues(data []byte) (map[interface{}]interface{}, error) {
+	values := make(map[interface{}]interface{})
+	if len(data) == 0 {
+		return values, nil
+	}
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
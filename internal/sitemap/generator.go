@@ -0,0 +1,187 @@
+package sitemap
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+)
+
+// Generator는 저장소의 발행된 게시글, 카테고리, 태그로 사이트맵을 생성하고 결과를 캐시합니다.
+// 캐시는 Invalidate가 호출될 때까지 유지되며, 저장소를 NewPostRepository, NewCategoryRepository로
+// 감싸면 게시글이나 카테고리가 바뀔 때 자동으로 무효화됩니다.
+type Generator struct {
+	siteURL      string
+	postRepo     repository.PostRepositoryInterface
+	categoryRepo repository.CategoryRepositoryInterface
+
+	buildMu sync.Mutex // 동시에 여러 요청이 사이트맵을 생성하지 않도록 직렬화
+	mu      sync.Mutex
+	cached  *Result
+	version uint64 // Invalidate마다 증가 (생성 중 변경된 결과를 캐시하지 않기 위해 사용)
+}
+
+func NewGenerator(siteURL string, postRepo repository.PostRepositoryInterface, categoryRepo repository.CategoryRepositoryInterface) *Generator {
+	return &Generator{siteURL: siteURL, postRepo: postRepo, categoryRepo: categoryRepo}
+}
+
+// Get은 캐시된 사이트맵을 반환합니다. 캐시가 없으면 새로 생성합니다.
+func (g *Generator) Get(ctx context.Context) (*Result, error) {
+	if cached := g.load(); cached != nil {
+		return cached, nil
+	}
+
+	g.buildMu.Lock()
+	defer g.buildMu.Unlock()
+
+	// 대기하는 동안 다른 요청이 생성했을 수 있음
+	g.mu.Lock()
+	cached, version := g.cached, g.version
+	g.mu.Unlock()
+	if cached != nil {
+		return cached, nil
+	}
+
+	urls, err := g.collect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result, err := Build(g.siteURL, urls, MaxURLsPerSitemap)
+	if err != nil {
+		return nil, err
+	}
+
+	g.mu.Lock()
+	if g.version == version {
+		g.cached = result
+	}
+	g.mu.Unlock()
+
+	return result, nil
+}
+
+// Invalidate는 캐시된 사이트맵을 버립니다. 다음 Get에서 다시 생성합니다.
+func (g *Generator) Invalidate() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.cached = nil
+	g.version++
+}
+
+func (g *Generator) load() *Result {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.cached
+}
+
+// collect는 사이트맵에 포함할 URL을 모읍니다.
+// 순서는 메인 페이지, 카테고리, 태그(이름순), 게시글(최신순)이며
+// 카테고리와 태그의 수정 시간은 해당 게시글 중 가장 최근 수정 시간입니다.
+func (g *Generator) collect(ctx context.Context) ([]URL, error) {
+	posts, err := g.publishedPosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := g.categoryRepo.GetCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var latest time.Time
+	categoryModified := make(map[string]time.Time)
+	tagModified := make(map[string]time.Time)
+	postURLs := make([]URL, 0, len(posts))
+	for _, post := range posts {
+		modified := lastModified(post)
+		if modified.After(latest) {
+			latest = modified
+		}
+		if modified.After(categoryModified[post.Category]) {
+			categoryModified[post.Category] = modified
+		}
+		for _, tag := range post.Tags {
+			if modified.After(tagModified[tag]) {
+				tagModified[tag] = modified
+			}
+		}
+
+		postURLs = append(postURLs, URL{
+			Loc:        g.siteURL + "/post/" + url.PathEscape(post.PostID),
+			LastMod:    modified,
+			ChangeFreq: ChangeMonthly,
+			Priority:   0.6,
+			Images:     ExtractImages(g.siteURL, post.Content),
+		})
+	}
+
+	tags := make([]string, 0, len(tagModified))
+	for tag := range tagModified {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	urls := make([]URL, 0, 1+len(categories)+len(tags)+len(postURLs))
+	urls = append(urls, URL{Loc: g.siteURL, LastMod: latest, ChangeFreq: ChangeDaily, Priority: 1.0})
+	for _, category := range categories {
+		urls = append(urls, URL{
+			Loc:        g.siteURL + "/category/" + url.PathEscape(category.Category),
+			LastMod:    categoryModified[category.Category],
+			ChangeFreq: ChangeWeekly,
+			Priority:   0.8,
+		})
+	}
+	for _, tag := range tags {
+		urls = append(urls, URL{
+			Loc:        g.siteURL + "/tag/" + url.PathEscape(tag),
+			LastMod:    tagModified[tag],
+			ChangeFreq: ChangeWeekly,
+			Priority:   0.5,
+		})
+	}
+	urls = append(urls, postURLs...)
+
+	return urls, nil
+}
+
+// publishedPosts는 발행된 모든 게시글을 최신순으로 조회합니다.
+// 본문의 이미지를 찾아야 하므로 본문을 포함해 조회합니다.
+func (g *Generator) publishedPosts(ctx context.Context) ([]model.Post, error) {
+	var posts []model.Post
+	var cursor *string
+	for {
+		result, err := g.postRepo.GetPosts(ctx, &repository.GetPostsInput{
+			Status:      model.PostStatusPublished,
+			Sort:        repository.SortNewest,
+			PageSize:    100,
+			Cursor:      cursor,
+			WithContent: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		posts = append(posts, result.Posts...)
+
+		if result.NextCursor == "" {
+			break
+		}
+		next := result.NextCursor
+		cursor = &next
+	}
+	return posts, nil
+}
+
+// lastModified는 게시글의 마지막 수정 시간입니다. 예약 발행된 게시글은 발행 시간보다 이를 수 없습니다.
+func lastModified(post model.Post) time.Time {
+	modified := post.UpdatedAt
+	if post.PublishAt != nil && post.PublishAt.After(modified) {
+		modified = *post.PublishAt
+	}
+	return modified
+}
@@ -0,0 +1,61 @@
+package sitemap
+
+import (
+	"net/url"
+	"regexp"
+	"sort"
+)
+
+var (
+	// Markdown 인라인 이미지: ![대체 텍스트](주소 "제목") 또는 ![대체 텍스트](<주소>)
+	markdownImagePattern = regexp.MustCompile(`!\[[^\]]*\]\(\s*(?:<([^<>\n]+)>|([^\s<>)]+))`)
+	// 본문에 직접 작성한 HTML 이미지: <img src="주소">
+	htmlImagePattern = regexp.MustCompile(`(?i)<img\b[^>]*?\bsrc\s*=\s*["']([^"']+)["']`)
+)
+
+// ExtractImages는 게시글 본문(Markdown)에서 참조하는 이미지 주소를 본문에 나온 순서대로 반환합니다.
+// 상대 주소는 siteURL 기준의 절대 주소로 변환하고, http(s)가 아닌 주소(data: 등)와 중복은 제외합니다.
+func ExtractImages(siteURL, content string) []string {
+	base, err := url.Parse(siteURL + "/")
+	if err != nil {
+		return nil
+	}
+
+	type match struct {
+		offset int
+		ref    string
+	}
+	var matches []match
+	for _, pattern := range []*regexp.Regexp{markdownImagePattern, htmlImagePattern} {
+		for _, m := range pattern.FindAllStringSubmatchIndex(content, -1) {
+			// 주소는 첫 번째로 일치한 그룹 (Markdown은 <주소> 또는 주소)
+			for g := 2; g+1 < len(m); g += 2 {
+				if m[g] >= 0 {
+					matches = append(matches, match{offset: m[0], ref: content[m[g]:m[g+1]]})
+					break
+				}
+			}
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].offset < matches[j].offset })
+
+	var images []string
+	seen := make(map[string]bool)
+	for _, m := range matches {
+		ref, err := url.Parse(m.ref)
+		if err != nil {
+			continue
+		}
+		resolved := base.ResolveReference(ref)
+		if resolved.Scheme != "http" && resolved.Scheme != "https" {
+			continue
+		}
+
+		image := resolved.String()
+		if !seen[image] {
+			seen[image] = true
+			images = append(images, image)
+		}
+	}
+	return images
+}
@@ -0,0 +1,74 @@
+package sitemap
+
+import (
+	"context"
+	"time"
+
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+)
+
+// PostRepository는 게시글 저장소를 감싸 쓰기 작업이 성공할 때마다 사이트맵 캐시를 무효화합니다.
+type PostRepository struct {
+	repository.PostRepositoryInterface
+	generator *Generator
+}
+
+func NewPostRepository(postRepo repository.PostRepositoryInterface, generator *Generator) *PostRepository {
+	return &PostRepository{PostRepositoryInterface: postRepo, generator: generator}
+}
+
+func (r *PostRepository) CreatePost(ctx context.Context, post *model.Post) error {
+	if err := r.PostRepositoryInterface.CreatePost(ctx, post); err != nil {
+		return err
+	}
+
+	r.generator.Invalidate()
+	return nil
+}
+
+func (r *PostRepository) UpdatePost(ctx context.Context, post *model.Post, expectedVersion *int64) error {
+	if err := r.PostRepositoryInterface.UpdatePost(ctx, post, expectedVersion); err != nil {
+		return err
+	}
+
+	r.generator.Invalidate()
+	return nil
+}
+
+func (r *PostRepository) DeletePost(ctx context.Context, postID string) error {
+	if err := r.PostRepositoryInterface.DeletePost(ctx, postID); err != nil {
+		return err
+	}
+
+	r.generator.Invalidate()
+	return nil
+}
+
+func (r *PostRepository) UpdatePostStatus(ctx context.Context, postID string, status string, publishAt *time.Time) error {
+	if err := r.PostRepositoryInterface.UpdatePostStatus(ctx, postID, status, publishAt); err != nil {
+		return err
+	}
+
+	r.generator.Invalidate()
+	return nil
+}
+
+// CategoryRepository는 카테고리 저장소를 감싸 카테고리가 바뀔 때 사이트맵 캐시를 무효화합니다.
+type CategoryRepository struct {
+	repository.CategoryRepositoryInterface
+	generator *Generator
+}
+
+func NewCategoryRepository(categoryRepo repository.CategoryRepositoryInterface, generator *Generator) *CategoryRepository {
+	return &CategoryRepository{CategoryRepositoryInterface: categoryRepo, generator: generator}
+}
+
+func (r *CategoryRepository) UpsertCategory(ctx context.Context, category model.Category) error {
+	if err := r.CategoryRepositoryInterface.UpsertCategory(ctx, category); err != nil {
+		return err
+	}
+
+	r.generator.Invalidate()
+	return nil
+}
@@ -0,0 +1,179 @@
+package sitemap
+
+import (
+	"encoding/xml"
+	"fmt"
+	"time"
+)
+
+// 사이트맵 프로토콜 제한 (https://www.sitemaps.org/protocol.html)
+const (
+	MaxURLsPerSitemap = 50000 // 사이트맵 파일 하나에 넣을 수 있는 최대 URL 수
+	MaxImagesPerURL   = 1000  // URL 하나에 넣을 수 있는 최대 이미지 수 (이미지 사이트맵 확장)
+)
+
+// 갱신 빈도 (changefreq)
+const (
+	ChangeDaily   = "daily"
+	ChangeWeekly  = "weekly"
+	ChangeMonthly = "monthly"
+)
+
+const (
+	sitemapNS = "http://www.sitemaps.org/schemas/sitemap/0.9"
+	imageNS   = "http://www.google.com/schemas/sitemap-image/1.1"
+)
+
+// URL은 사이트맵 항목 하나입니다. Loc과 Images는 절대 URL이어야 합니다.
+type URL struct {
+	Loc        string
+	LastMod    time.Time // zero 값이면 생략
+	ChangeFreq string
+	Priority   float64  // 0이면 생략
+	Images     []string // 페이지에 포함된 이미지 주소
+}
+
+// Result는 생성된 사이트맵입니다.
+// URL이 MaxURLsPerSitemap을 넘으면 여러 파일로 나누고 Index에 사이트맵 색인을 담습니다.
+type Result struct {
+	Index        []byte   // 사이트맵 색인 (파일이 하나면 nil)
+	Pages        [][]byte // 사이트맵 파일 (최소 1개)
+	LastModified time.Time
+}
+
+// Root는 /sitemap.xml로 제공할 문서를 반환합니다. 파일이 하나면 사이트맵, 여러 개면 사이트맵 색인입니다.
+func (r *Result) Root() []byte {
+	if r.Index != nil {
+		return r.Index
+	}
+	return r.Pages[0]
+}
+
+// PagePath는 n번째(1부터) 사이트맵 파일의 경로입니다.
+func PagePath(n int) string {
+	return fmt.Sprintf("/sitemaps/%d.xml", n)
+}
+
+type xmlURLSet struct {
+	XMLName xml.Name `xml:"urlset"`
+	NS      string   `xml:"xmlns,attr"`
+	ImageNS string   `xml:"xmlns:image,attr,omitempty"`
+	URLs    []xmlURL `xml:"url"`
+}
+
+type xmlURL struct {
+	Loc        string     `xml:"loc"`
+	LastMod    string     `xml:"lastmod,omitempty"`
+	ChangeFreq string     `xml:"changefreq,omitempty"`
+	Priority   string     `xml:"priority,omitempty"`
+	Images     []xmlImage `xml:"image:image"`
+}
+
+type xmlImage struct {
+	Loc string `xml:"image:loc"`
+}
+
+type xmlSitemapIndex struct {
+	XMLName  xml.Name     `xml:"sitemapindex"`
+	NS       string       `xml:"xmlns,attr"`
+	Sitemaps []xmlSitemap `xml:"sitemap"`
+}
+
+type xmlSitemap struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// Build는 URL 목록을 perPage개씩 사이트맵 파일로 나누고, 두 개 이상이면 siteURL 기준 색인을 만듭니다.
+// perPage가 0 이하이거나 MaxURLsPerSitemap보다 크면 MaxURLsPerSitemap을 사용합니다.
+func Build(siteURL string, urls []URL, perPage int) (*Result, error) {
+	if perPage <= 0 || perPage > MaxURLsPerSitemap {
+		perPage = MaxURLsPerSitemap
+	}
+
+	result := &Result{}
+	var pageModified []time.Time
+	for start := 0; start == 0 || start < len(urls); start += perPage {
+		end := start + perPage
+		if end > len(urls) {
+			end = len(urls)
+		}
+
+		page, modified, err := encodeURLSet(urls[start:end])
+		if err != nil {
+			return nil, err
+		}
+		result.Pages = append(result.Pages, page)
+		pageModified = append(pageModified, modified)
+		if modified.After(result.LastModified) {
+			result.LastModified = modified
+		}
+	}
+
+	if len(result.Pages) > 1 {
+		index := xmlSitemapIndex{NS: sitemapNS}
+		for i, modified := range pageModified {
+			entry := xmlSitemap{Loc: siteURL + PagePath(i+1)}
+			if !modified.IsZero() {
+				entry.LastMod = formatTime(modified)
+			}
+			index.Sitemaps = append(index.Sitemaps, entry)
+		}
+
+		body, err := marshalXML(index)
+		if err != nil {
+			return nil, err
+		}
+		result.Index = body
+	}
+
+	return result, nil
+}
+
+// encodeURLSet은 사이트맵 파일 하나를 생성하고 항목 중 가장 최근 수정 시간을 함께 반환합니다.
+func encodeURLSet(urls []URL) ([]byte, time.Time, error) {
+	set := xmlURLSet{NS: sitemapNS, URLs: make([]xmlURL, 0, len(urls))}
+	var modified time.Time
+	for _, u := range urls {
+		entry := xmlURL{Loc: u.Loc, ChangeFreq: u.ChangeFreq}
+		if !u.LastMod.IsZero() {
+			entry.LastMod = formatTime(u.LastMod)
+			if u.LastMod.After(modified) {
+				modified = u.LastMod
+			}
+		}
+		if u.Priority > 0 {
+			entry.Priority = fmt.Sprintf("%.1f", u.Priority)
+		}
+
+		images := u.Images
+		if len(images) > MaxImagesPerURL {
+			images = images[:MaxImagesPerURL]
+		}
+		for _, image := range images {
+			entry.Images = append(entry.Images, xmlImage{Loc: image})
+		}
+		if len(entry.Images) > 0 {
+			set.ImageNS = imageNS
+		}
+
+		set.URLs = append(set.URLs, entry)
+	}
+
+	body, err := marshalXML(set)
+	return body, modified, err
+}
+
+// formatTime은 W3C Datetime 형식(UTC)으로 변환합니다.
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// marshalXML은 XML 선언을 포함한 들여쓰기된 문서를 생성합니다.
+func marshalXML(v interface{}) ([]byte, error) {
+	body, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
@@ -0,0 +1,205 @@
+package sitemap
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"testing"
+	"time"
+
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+	"bumsiku/internal/sitemap"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const siteURL = "https://example.com"
+
+var baseTime = time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+type urlSet struct {
+	XMLName xml.Name `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 urlset"`
+	URLs    []struct {
+		Loc     string `xml:"loc"`
+		LastMod string `xml:"lastmod"`
+		Images  []struct {
+			Loc string `xml:"http://www.google.com/schemas/sitemap-image/1.1 loc"`
+		} `xml:"http://www.google.com/schemas/sitemap-image/1.1 image"`
+	} `xml:"url"`
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 sitemapindex"`
+	Sitemaps []struct {
+		Loc     string `xml:"loc"`
+		LastMod string `xml:"lastmod"`
+	} `xml:"sitemap"`
+}
+
+func newPost(id, category, content string, tags []string, offset time.Duration) *model.Post {
+	return &model.Post{
+		PostID:    id,
+		Title:     id,
+		Content:   content,
+		Category:  category,
+		Tags:      tags,
+		Status:    model.PostStatusPublished,
+		CreatedAt: baseTime.Add(offset),
+		UpdatedAt: baseTime.Add(offset),
+	}
+}
+
+func setupGenerator(t *testing.T) (*sitemap.Generator, repository.PostRepositoryInterface, repository.CategoryRepositoryInterface) {
+	ctx := context.Background()
+	postRepo := repository.NewMemoryPostRepository(repository.NewCursorSigner(nil))
+	categoryRepo := repository.NewMemoryCategoryRepository()
+
+	require.NoError(t, categoryRepo.UpsertCategory(ctx, model.Category{Category: "tech", Order: 1}))
+	require.NoError(t, categoryRepo.UpsertCategory(ctx, model.Category{Category: "Q&A <>", Order: 2}))
+	require.NoError(t, postRepo.CreatePost(ctx, newPost("post1", "tech", "![그림](/images/a.png)", []string{"go"}, 0)))
+	require.NoError(t, postRepo.CreatePost(ctx, newPost("post2", "Q&A <>", "본문", []string{"go", "c++"}, time.Hour)))
+
+	draft := newPost("draft", "tech", "", nil, 2*time.Hour)
+	draft.Status = model.PostStatusDraft
+	require.NoError(t, postRepo.CreatePost(ctx, draft))
+
+	generator := sitemap.NewGenerator(siteURL, postRepo, categoryRepo)
+	return generator, sitemap.NewPostRepository(postRepo, generator), sitemap.NewCategoryRepository(categoryRepo, generator)
+}
+
+func locs(set urlSet) []string {
+	result := make([]string, 0, len(set.URLs))
+	for _, u := range set.URLs {
+		result = append(result, u.Loc)
+	}
+	return result
+}
+
+// [GIVEN] 특수 문자가 있는 카테고리와 태그, 이미지가 있는 게시글
+// [WHEN] 사이트맵 생성
+// [THEN] 올바른 XML이며 이스케이프된 URL, 발행된 게시글, 이미지 포함 확인
+func TestGenerator_Sitemap(t *testing.T) {
+	generator, _, _ := setupGenerator(t)
+
+	result, err := generator.Get(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, result.Index)
+	require.Len(t, result.Pages, 1)
+	assert.Equal(t, baseTime.Add(time.Hour), result.LastModified)
+
+	var set urlSet
+	require.NoError(t, xml.Unmarshal(result.Root(), &set))
+	assert.Equal(t, []string{
+		siteURL,
+		siteURL + "/category/tech",
+		siteURL + "/category/Q&A%20%3C%3E",
+		siteURL + "/tag/c++",
+		siteURL + "/tag/go",
+		siteURL + "/post/post2",
+		siteURL + "/post/post1",
+	}, locs(set))
+
+	// 메인 페이지와 카테고리는 해당 게시글 중 가장 최근 수정 시간
+	assert.Equal(t, "2024-01-01T10:00:00Z", set.URLs[0].LastMod)
+	assert.Equal(t, "2024-01-01T09:00:00Z", set.URLs[1].LastMod)
+
+	// 게시글 본문의 상대 이미지 주소는 절대 주소로 변환
+	require.Len(t, set.URLs[6].Images, 1)
+	assert.Equal(t, siteURL+"/images/a.png", set.URLs[6].Images[0].Loc)
+	assert.Empty(t, set.URLs[5].Images)
+}
+
+// [GIVEN] 사이트맵이 한 번 생성된 상태
+// [WHEN] 감싼 저장소로 게시글과 카테고리 변경
+// [THEN] 변경 전에는 캐시를 반환하고, 변경 후에는 새로 생성됨 확인
+func TestGenerator_CacheInvalidation(t *testing.T) {
+	ctx := context.Background()
+	generator, postRepo, categoryRepo := setupGenerator(t)
+
+	first, err := generator.Get(ctx)
+	require.NoError(t, err)
+	cached, err := generator.Get(ctx)
+	require.NoError(t, err)
+	assert.Same(t, first, cached)
+
+	// 예약 발행 등 상태 변경
+	require.NoError(t, postRepo.UpdatePostStatus(ctx, "draft", model.PostStatusPublished, nil))
+	second, err := generator.Get(ctx)
+	require.NoError(t, err)
+	assert.NotSame(t, first, second)
+	assert.Contains(t, string(second.Root()), siteURL+"/post/draft")
+
+	require.NoError(t, categoryRepo.UpsertCategory(ctx, model.Category{Category: "life", Order: 3}))
+	third, err := generator.Get(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, string(third.Root()), siteURL+"/category/life")
+
+	require.NoError(t, postRepo.DeletePost(ctx, "post1"))
+	fourth, err := generator.Get(ctx)
+	require.NoError(t, err)
+	assert.NotContains(t, string(fourth.Root()), siteURL+"/post/post1")
+}
+
+// [GIVEN] 파일당 URL 수를 넘는 URL 목록
+// [WHEN] Build 호출
+// [THEN] 사이트맵 파일로 나뉘고 색인이 각 파일을 가리킴 확인
+func TestBuild_SplitsIntoIndex(t *testing.T) {
+	urls := make([]sitemap.URL, 0, 5)
+	for i := 0; i < 5; i++ {
+		urls = append(urls, sitemap.URL{
+			Loc:     fmt.Sprintf("%s/post/%d", siteURL, i),
+			LastMod: baseTime.Add(time.Duration(i) * time.Hour),
+		})
+	}
+
+	result, err := sitemap.Build(siteURL, urls, 2)
+	require.NoError(t, err)
+	require.Len(t, result.Pages, 3)
+	require.NotNil(t, result.Index)
+	assert.Equal(t, result.Index, result.Root())
+
+	var index sitemapIndex
+	require.NoError(t, xml.Unmarshal(result.Index, &index))
+	require.Len(t, index.Sitemaps, 3)
+	assert.Equal(t, siteURL+"/sitemaps/1.xml", index.Sitemaps[0].Loc)
+	assert.Equal(t, "2024-01-01T10:00:00Z", index.Sitemaps[0].LastMod)
+	assert.Equal(t, siteURL+"/sitemaps/3.xml", index.Sitemaps[2].Loc)
+
+	var last urlSet
+	require.NoError(t, xml.Unmarshal(result.Pages[2], &last))
+	assert.Equal(t, []string{siteURL + "/post/4"}, locs(last))
+}
+
+// [GIVEN] URL이 없는 경우
+// [WHEN] Build 호출
+// [THEN] 빈 사이트맵 파일 하나 생성 확인
+func TestBuild_Empty(t *testing.T) {
+	result, err := sitemap.Build(siteURL, nil, 0)
+	require.NoError(t, err)
+	require.Len(t, result.Pages, 1)
+	assert.Nil(t, result.Index)
+	assert.Contains(t, string(result.Root()), "<urlset")
+}
+
+// [GIVEN] Markdown과 HTML 이미지가 섞인 본문
+// [WHEN] ExtractImages 호출
+// [THEN] 본문 순서대로 절대 주소, 링크와 중복, data URI 제외 확인
+func TestExtractImages(t *testing.T) {
+	content := "소개\n\n" +
+		"![첫 그림](https://cdn.example.com/a.png \"제목\")\n" +
+		"<img class=\"wide\" src='/images/b.jpg' alt=\"b\">\n" +
+		"![상대 경로](<images/c d.png>)\n" +
+		"![중복](https://cdn.example.com/a.png)\n" +
+		"![인라인](data:image/png;base64,AAAA)\n" +
+		"[링크](https://example.com/not-image.png)"
+
+	images := sitemap.ExtractImages(siteURL, content)
+
+	assert.Equal(t, []string{
+		"https://cdn.example.com/a.png",
+		siteURL + "/images/b.jpg",
+		siteURL + "/images/c%20d.png",
+	}, images)
+}
@@ -0,0 +1,233 @@
+package spam
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+	"bumsiku/internal/search"
+)
+
+// 나이브 베이즈 필터 기본값
+const (
+	DefaultSpamThreshold    = 0.9 // 이 확률 이상이면 스팸으로 판정
+	DefaultMinTrainingCount = 5   // 스팸과 정상 댓글이 각각 이 수 이상 학습되어야 판정
+)
+
+// Classifier는 관리자의 검토 결과로 학습하는 나이브 베이즈 댓글 분류기입니다.
+// 댓글 ID별로 학습한 분류를 기억하므로, 같은 댓글을 다시 학습하면 이전 분류를 취소하고 새 분류로 학습합니다.
+type Classifier struct {
+	mu      sync.RWMutex
+	counts  map[string]*tokenCount // 토큰 → 분류별 토큰이 나온 댓글 수
+	spam    int                    // 학습한 스팸 댓글 수
+	ham     int                    // 학습한 정상 댓글 수
+	learned map[string]learnedComment
+}
+
+type tokenCount struct {
+	spam int
+	ham  int
+}
+
+type learnedComment struct {
+	spam   bool
+	tokens []string
+}
+
+func NewClassifier() *Classifier {
+	return &Classifier{
+		counts:  make(map[string]*tokenCount),
+		learned: make(map[string]learnedComment),
+	}
+}
+
+// Learn은 댓글을 스팸(spam=true) 또는 정상 댓글로 학습합니다.
+func (c *Classifier) Learn(commentID, text string, spam bool) {
+	tokens := uniqueTokens(text)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.forgetLocked(commentID)
+	for _, token := range tokens {
+		count := c.counts[token]
+		if count == nil {
+			count = &tokenCount{}
+			c.counts[token] = count
+		}
+		if spam {
+			count.spam++
+		} else {
+			count.ham++
+		}
+	}
+	if spam {
+		c.spam++
+	} else {
+		c.ham++
+	}
+	c.learned[commentID] = learnedComment{spam: spam, tokens: tokens}
+}
+
+// Forget은 댓글의 학습을 취소합니다. 학습하지 않은 댓글이면 아무것도 하지 않습니다.
+func (c *Classifier) Forget(commentID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.forgetLocked(commentID)
+}
+
+func (c *Classifier) forgetLocked(commentID string) {
+	learned, ok := c.learned[commentID]
+	if !ok {
+		return
+	}
+
+	for _, token := range learned.tokens {
+		count := c.counts[token]
+		if learned.spam {
+			count.spam--
+		} else {
+			count.ham--
+		}
+		if count.spam == 0 && count.ham == 0 {
+			delete(c.counts, token)
+		}
+	}
+	if learned.spam {
+		c.spam--
+	} else {
+		c.ham--
+	}
+	delete(c.learned, commentID)
+}
+
+// Counts는 학습한 스팸 댓글 수와 정상 댓글 수를 반환합니다.
+func (c *Classifier) Counts() (spam, ham int) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.spam, c.ham
+}
+
+// SpamProbability는 텍스트가 스팸일 확률(0~1)을 반환합니다. 학습한 댓글이 없으면 0.5입니다.
+// 각 토큰이 댓글에 나오는지 여부만 보는 베르누이 모델이며, 라플라스 평활을 적용합니다.
+func (c *Classifier) SpamProbability(text string) float64 {
+	tokens := uniqueTokens(text)
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.spam == 0 && c.ham == 0 {
+		return 0.5
+	}
+
+	total := float64(c.spam + c.ham)
+	logSpam := math.Log((float64(c.spam) + 1) / (total + 2))
+	logHam := math.Log((float64(c.ham) + 1) / (total + 2))
+	for _, token := range tokens {
+		count := c.counts[token]
+		if count == nil {
+			// 학습한 적 없는 토큰은 판정에 영향을 주지 않음
+			continue
+		}
+		logSpam += math.Log((float64(count.spam) + 1) / (float64(c.spam) + 2))
+		logHam += math.Log((float64(count.ham) + 1) / (float64(c.ham) + 2))
+	}
+
+	return 1 / (1 + math.Exp(logHam-logSpam))
+}
+
+// Rebuild는 관리자가 검토한 댓글로 분류기를 다시 학습합니다. spam 상태 댓글은 스팸으로, 승인된 댓글은 정상 댓글로 학습하며
+// 검토 대기와 거절된 댓글, 스팸 필터의 판정만 받은 댓글은 학습하지 않습니다. 학습한 댓글 수를 반환합니다.
+func (c *Classifier) Rebuild(ctx context.Context, commentRepo repository.CommentRepositoryInterface) (int, error) {
+	comments, err := commentRepo.GetComments(ctx, &repository.GetCommentsInput{})
+	if err != nil {
+		return 0, fmt.Errorf("댓글 조회 실패: %w", err)
+	}
+
+	rebuilt := NewClassifier()
+	learned := 0
+	for _, comment := range comments {
+		if rebuilt.LearnComment(comment) {
+			learned++
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.counts, c.spam, c.ham, c.learned = rebuilt.counts, rebuilt.spam, rebuilt.ham, rebuilt.learned
+	return learned, nil
+}
+
+// LearnComment는 관리자가 지정한 검토 상태에 따라 학습합니다.
+// 분류기가 자신의 판정이나 검토되지 않은 입력으로 학습하지 않도록, 관리자가 검토하지 않은 댓글은 학습하지 않습니다.
+// 삭제되었거나 검토하지 않은 댓글, 학습 대상이 아닌 상태면 false를 반환합니다.
+func (c *Classifier) LearnComment(comment model.Comment) bool {
+	if comment.Deleted || !comment.Moderated {
+		return false
+	}
+
+	switch {
+	case comment.Status == model.CommentStatusSpam:
+		c.Learn(comment.CommentID, commentText(comment.Nickname, comment.Content), true)
+	case comment.IsApproved():
+		c.Learn(comment.CommentID, commentText(comment.Nickname, comment.Content), false)
+	default:
+		return false
+	}
+	return true
+}
+
+func commentText(nickname, content string) string {
+	return nickname + "\n" + content
+}
+
+func uniqueTokens(text string) []string {
+	seen := make(map[string]bool)
+	var tokens []string
+	for _, token := range search.Tokenize(text) {
+		if !seen[token] {
+			seen[token] = true
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens
+}
+
+// BayesFilter는 학습한 분류기로 스팸 확률이 기준 이상인 댓글을 스팸으로 판정합니다.
+// 학습한 댓글이 충분하지 않으면 판정하지 않습니다.
+type BayesFilter struct {
+	classifier       *Classifier
+	Threshold        float64
+	MinTrainingCount int
+}
+
+func NewBayesFilter(classifier *Classifier) *BayesFilter {
+	return &BayesFilter{
+		classifier:       classifier,
+		Threshold:        DefaultSpamThreshold,
+		MinTrainingCount: DefaultMinTrainingCount,
+	}
+}
+
+func (f *BayesFilter) Name() string {
+	return "bayes"
+}
+
+func (f *BayesFilter) Check(ctx context.Context, submission *Submission) (*Verdict, error) {
+	spam, ham := f.classifier.Counts()
+	if spam < f.MinTrainingCount || ham < f.MinTrainingCount {
+		return nil, nil
+	}
+
+	probability := f.classifier.SpamProbability(commentText(submission.Nickname, submission.Content))
+	if probability >= f.Threshold {
+		return &Verdict{Filter: f.Name(), Reason: fmt.Sprintf("스팸 확률 %.2f", probability)}, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,55 @@
+package spam
+
+import (
+	"context"
+	"time"
+)
+
+// Submission은 스팸 검사 대상인 익명 댓글 등록 요청입니다.
+type Submission struct {
+	PostID      string
+	Nickname    string
+	Content     string
+	ClientIP    string
+	Honeypot    string    // 사람에게는 보이지 않는 입력 필드 값 (사람은 비워 둠)
+	FormToken   string    // 댓글 작성 폼을 열 때 발급한 토큰
+	SubmittedAt time.Time // 요청 시각
+}
+
+// Verdict는 스팸으로 판정된 이유입니다.
+// Reject가 true이면 댓글을 저장하지 않고 거부하며, false이면 spam 상태로 저장해 관리자가 검토할 수 있게 합니다.
+type Verdict struct {
+	Filter string // 판정한 필터 이름
+	Reason string // 판정 사유
+	Reject bool
+}
+
+// Filter는 댓글 등록 요청의 스팸 여부를 판정합니다. 스팸이 아니면 nil을 반환합니다.
+type Filter interface {
+	Name() string
+	Check(ctx context.Context, submission *Submission) (*Verdict, error)
+}
+
+// Chain은 여러 필터를 순서대로 적용하고 처음 나온 판정을 반환합니다.
+type Chain []Filter
+
+func NewChain(filters ...Filter) Chain {
+	return Chain(filters)
+}
+
+func (c Chain) Name() string {
+	return "chain"
+}
+
+func (c Chain) Check(ctx context.Context, submission *Submission) (*Verdict, error) {
+	for _, filter := range c {
+		verdict, err := filter.Check(ctx, submission)
+		if err != nil {
+			return nil, err
+		}
+		if verdict != nil {
+			return verdict, nil
+		}
+	}
+	return nil, nil
+}
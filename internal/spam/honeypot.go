@@ -0,0 +1,28 @@
+package spam
+
+import (
+	"context"
+	"strings"
+)
+
+// HoneypotField는 댓글 작성 폼에 숨겨 두는 입력 필드 이름입니다.
+// 사람은 보이지 않는 필드를 비워 두지만, 폼을 자동으로 채우는 봇은 값을 넣습니다.
+const HoneypotField = "website"
+
+// HoneypotFilter는 숨겨진 입력 필드에 값이 있는 요청을 거부합니다.
+type HoneypotFilter struct{}
+
+func NewHoneypotFilter() *HoneypotFilter {
+	return &HoneypotFilter{}
+}
+
+func (f *HoneypotFilter) Name() string {
+	return "honeypot"
+}
+
+func (f *HoneypotFilter) Check(ctx context.Context, submission *Submission) (*Verdict, error) {
+	if strings.TrimSpace(submission.Honeypot) != "" {
+		return &Verdict{Filter: f.Name(), Reason: "숨겨진 필드 입력", Reject: true}, nil
+	}
+	return nil, nil
+}
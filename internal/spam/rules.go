@@ -0,0 +1,62 @@
+package spam
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// linkPattern은 본문의 링크(http(s) 주소와 www. 로 시작하는 주소)를 찾습니다.
+var linkPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)`)
+
+// LinkFilter는 본문의 링크 수가 제한을 넘는 댓글을 스팸으로 판정합니다.
+type LinkFilter struct {
+	MaxLinks int
+}
+
+func NewLinkFilter(maxLinks int) *LinkFilter {
+	return &LinkFilter{MaxLinks: maxLinks}
+}
+
+func (f *LinkFilter) Name() string {
+	return "links"
+}
+
+func (f *LinkFilter) Check(ctx context.Context, submission *Submission) (*Verdict, error) {
+	links := len(linkPattern.FindAllStringIndex(submission.Nickname+" "+submission.Content, -1))
+	if links > f.MaxLinks {
+		return &Verdict{Filter: f.Name(), Reason: fmt.Sprintf("링크 %d개 (최대 %d개)", links, f.MaxLinks)}, nil
+	}
+	return nil, nil
+}
+
+// BlocklistFilter는 닉네임이나 본문에 금지어가 포함된 댓글을 스팸으로 판정합니다. 대소문자는 구분하지 않습니다.
+type BlocklistFilter struct {
+	words []string
+}
+
+func NewBlocklistFilter(words []string) *BlocklistFilter {
+	filter := &BlocklistFilter{}
+	for _, word := range words {
+		word = strings.ToLower(strings.TrimSpace(word))
+		if word != "" {
+			filter.words = append(filter.words, word)
+		}
+	}
+	return filter
+}
+
+func (f *BlocklistFilter) Name() string {
+	return "blocklist"
+}
+
+func (f *BlocklistFilter) Check(ctx context.Context, submission *Submission) (*Verdict, error) {
+	text := strings.ToLower(submission.Nickname + "\n" + submission.Content)
+	for _, word := range f.words {
+		if strings.Contains(text, word) {
+			return &Verdict{Filter: f.Name(), Reason: fmt.Sprintf("금지어 포함: %s", word)}, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,234 @@
+package spam
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+	"bumsiku/internal/spam"
+	"bumsiku/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var baseTime = time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+func check(t *testing.T, filter spam.Filter, submission *spam.Submission) *spam.Verdict {
+	verdict, err := filter.Check(context.Background(), submission)
+	require.NoError(t, err)
+	return verdict
+}
+
+// [GIVEN] 링크 수와 금지어가 다른 댓글
+// [WHEN] 링크 필터와 금지어 필터 적용
+// [THEN] 제한을 넘거나 금지어가 있는 댓글만 저장 후 검토 대상(거부 아님)으로 판정 확인
+func TestRuleFilters(t *testing.T) {
+	links := spam.NewLinkFilter(2)
+	assert.Nil(t, check(t, links, &spam.Submission{Content: "참고: https://a.example, www.b.example"}))
+	verdict := check(t, links, &spam.Submission{Content: "HTTPS://a.example http://b.example www.c.example"})
+	require.NotNil(t, verdict)
+	assert.Equal(t, "links", verdict.Filter)
+	assert.False(t, verdict.Reject)
+
+	blocklist := spam.NewBlocklistFilter([]string{" Casino ", "", "대출"})
+	assert.Nil(t, check(t, blocklist, &spam.Submission{Nickname: "독자", Content: "좋은 글입니다"}))
+	assert.NotNil(t, check(t, blocklist, &spam.Submission{Nickname: "독자", Content: "online CASINO"}))
+	assert.NotNil(t, check(t, blocklist, &spam.Submission{Nickname: "무직자대출", Content: "안녕하세요"}))
+}
+
+// [GIVEN] 숨김 필드를 채운 요청과 비운 요청
+// [WHEN] 허니팟 필터 적용
+// [THEN] 숨김 필드를 채운 요청만 거부 확인
+func TestHoneypotFilter(t *testing.T) {
+	filter := spam.NewHoneypotFilter()
+
+	assert.Nil(t, check(t, filter, &spam.Submission{Honeypot: " "}))
+	verdict := check(t, filter, &spam.Submission{Honeypot: "http://spam.example"})
+	require.NotNil(t, verdict)
+	assert.True(t, verdict.Reject)
+}
+
+// [GIVEN] 게시글별로 발급한 폼 토큰
+// [WHEN] 제출 시각과 게시글을 바꿔 검증
+// [THEN] 최소 시간 이전, 만료, 다른 게시글, 위조된 토큰은 오류 확인
+func TestFormTokens(t *testing.T) {
+	tokens := spam.NewFormTokens([]byte("secret"), 3*time.Second, time.Hour)
+	token := tokens.Issue("post1", baseTime)
+
+	assert.NoError(t, tokens.Verify(token, "post1", baseTime.Add(3*time.Second)))
+	assert.ErrorIs(t, tokens.Verify(token, "post1", baseTime.Add(time.Second)), spam.ErrSubmittedTooSoon)
+	assert.ErrorIs(t, tokens.Verify(token, "post1", baseTime.Add(2*time.Hour)), spam.ErrFormTokenExpired)
+	assert.ErrorIs(t, tokens.Verify(token, "post2", baseTime.Add(time.Minute)), spam.ErrInvalidFormToken)
+	assert.ErrorIs(t, tokens.Verify("", "post1", baseTime), spam.ErrInvalidFormToken)
+
+	// 발급 시각을 바꾸면 서명이 맞지 않음
+	forged := tokens.Issue("post1", baseTime.Add(-time.Minute))[:10] + token[10:]
+	assert.ErrorIs(t, tokens.Verify(forged, "post1", baseTime.Add(time.Minute)), spam.ErrInvalidFormToken)
+
+	// 다른 키로 발급한 토큰은 무효
+	other := spam.NewFormTokens(nil, 0, 0)
+	assert.ErrorIs(t, other.Verify(token, "post1", baseTime.Add(time.Minute)), spam.ErrInvalidFormToken)
+
+	filter := spam.NewTimingFilter(tokens)
+	assert.Nil(t, check(t, filter, &spam.Submission{PostID: "post1", FormToken: token, SubmittedAt: baseTime.Add(time.Minute)}))
+	verdict := check(t, filter, &spam.Submission{PostID: "post1", FormToken: token, SubmittedAt: baseTime})
+	require.NotNil(t, verdict)
+	assert.True(t, verdict.Reject)
+}
+
+// [GIVEN] 순서대로 연결한 필터
+// [WHEN] 여러 필터에 걸리는 요청 검사
+// [THEN] 처음 판정한 필터의 결과 반환 확인
+func TestChain(t *testing.T) {
+	chain := spam.NewChain(spam.NewHoneypotFilter(), spam.NewLinkFilter(0))
+
+	assert.Nil(t, check(t, chain, &spam.Submission{Content: "링크 없음"}))
+	verdict := check(t, chain, &spam.Submission{Content: "https://a.example", Honeypot: "x"})
+	require.NotNil(t, verdict)
+	assert.Equal(t, "honeypot", verdict.Filter)
+}
+
+func trainSamples(classifier *spam.Classifier) {
+	spamTexts := []string{
+		"저렴한 대출 상담 지금 바로 연락주세요",
+		"무료 카지노 보너스 지금 가입하세요",
+		"대출 승인 100% 카지노 바로가기",
+		"cheap casino bonus click here",
+		"무료 상담 대출 한도 조회 클릭",
+	}
+	hamTexts := []string{
+		"글 잘 읽었습니다 Go 동시성 설명이 좋네요",
+		"예제 코드에서 채널을 닫는 부분이 궁금합니다",
+		"DynamoDB 설계 글 덕분에 많이 배웠습니다",
+		"다음 글도 기대하겠습니다",
+		"테스트 코드 작성 방법이 인상적이네요",
+	}
+	for i, text := range spamTexts {
+		classifier.Learn("spam"+string(rune('a'+i)), text, true)
+	}
+	for i, text := range hamTexts {
+		classifier.Learn("ham"+string(rune('a'+i)), text, false)
+	}
+}
+
+// [GIVEN] 스팸과 정상 댓글로 학습한 분류기
+// [WHEN] 새 댓글의 스팸 확률 계산
+// [THEN] 스팸과 비슷한 댓글은 높게, 정상 댓글과 비슷한 댓글은 낮게 판정 확인
+func TestClassifier(t *testing.T) {
+	classifier := spam.NewClassifier()
+	assert.Equal(t, 0.5, classifier.SpamProbability("아무 내용"))
+
+	trainSamples(classifier)
+	spamCount, hamCount := classifier.Counts()
+	assert.Equal(t, 5, spamCount)
+	assert.Equal(t, 5, hamCount)
+
+	assert.Greater(t, classifier.SpamProbability("카지노 대출 무료 상담"), 0.9)
+	assert.Less(t, classifier.SpamProbability("Go 채널 예제 코드 잘 읽었습니다"), 0.1)
+
+	// 같은 댓글을 다시 학습하면 이전 분류는 취소
+	classifier.Learn("hama", "글 잘 읽었습니다 Go 동시성 설명이 좋네요", true)
+	spamCount, hamCount = classifier.Counts()
+	assert.Equal(t, 6, spamCount)
+	assert.Equal(t, 4, hamCount)
+
+	classifier.Forget("hama")
+	classifier.Forget("unknown")
+	spamCount, hamCount = classifier.Counts()
+	assert.Equal(t, 5, spamCount)
+	assert.Equal(t, 4, hamCount)
+}
+
+// [GIVEN] 학습한 댓글 수가 다른 분류기
+// [WHEN] 나이브 베이즈 필터 적용
+// [THEN] 충분히 학습한 뒤에만 스팸으로 판정 확인
+func TestBayesFilter(t *testing.T) {
+	classifier := spam.NewClassifier()
+	filter := spam.NewBayesFilter(classifier)
+	submission := &spam.Submission{Nickname: "광고", Content: "카지노 대출 무료 상담"}
+
+	classifier.Learn("spam1", "카지노 대출 무료 상담", true)
+	assert.Nil(t, check(t, filter, submission))
+
+	trainSamples(classifier)
+	verdict := check(t, filter, submission)
+	require.NotNil(t, verdict)
+	assert.Equal(t, "bayes", verdict.Filter)
+	assert.False(t, verdict.Reject)
+
+	assert.Nil(t, check(t, filter, &spam.Submission{Nickname: "독자", Content: "채널 예제 코드가 궁금합니다"}))
+}
+
+// [GIVEN] 관리자가 검토한 댓글과 스팸 필터의 판정만 받은 댓글이 저장된 저장소
+// [WHEN] 분류기를 다시 학습하고, 감싼 저장소로 댓글을 저장하고 검토
+// [THEN] 관리자가 spam 또는 승인으로 지정한 댓글만 학습하고, 검토 결과만 분류기에 반영됨 확인
+func TestTrainingCommentRepository(t *testing.T) {
+	ctx := context.Background()
+	commentRepo := repository.NewMemoryCommentRepository()
+
+	create := func(nickname, content, status string) string {
+		comment, err := commentRepo.CreateComment(ctx, &model.Comment{PostID: "post1", Nickname: nickname, Content: content, Status: status})
+		require.NoError(t, err)
+		return comment.CommentID
+	}
+	moderate := func(status string, commentIDs ...string) {
+		_, err := commentRepo.SetCommentStatus(ctx, commentIDs, status)
+		require.NoError(t, err)
+	}
+	moderate(model.CommentStatusApproved, create("독자", "좋은 글입니다", model.CommentStatusPending))
+	moderate(model.CommentStatusSpam, create("광고", "카지노 바로가기", model.CommentStatusPending))
+	moderate(model.CommentStatusRejected, create("독자", "거절된 댓글", model.CommentStatusPending))
+	pendingID := create("광고", "대출 상담", model.CommentStatusPending)
+	// 스팸 필터가 판정한 상태는 학습하지 않음
+	create("독자", "자동 승인된 댓글", model.CommentStatusApproved)
+	create("광고", "자동 스팸 처리된 댓글", model.CommentStatusSpam)
+
+	classifier := spam.NewClassifier()
+	learned, err := classifier.Rebuild(ctx, commentRepo)
+	require.NoError(t, err)
+	assert.Equal(t, 2, learned)
+
+	logger := utils.NewLogger(utils.NewMemorySink())
+	defer logger.Close(ctx)
+	repo := spam.NewTrainingCommentRepository(commentRepo, classifier, logger)
+
+	// 스팸 처리한 댓글은 스팸으로 학습
+	updated, err := repo.SetCommentStatus(ctx, []string{pendingID}, model.CommentStatusSpam)
+	require.NoError(t, err)
+	assert.Equal(t, []string{pendingID}, updated)
+	spamCount, hamCount := classifier.Counts()
+	assert.Equal(t, 2, spamCount)
+	assert.Equal(t, 1, hamCount)
+
+	// 승인하면 정상 댓글로 다시 학습
+	_, err = repo.SetCommentStatus(ctx, []string{pendingID}, model.CommentStatusApproved)
+	require.NoError(t, err)
+	spamCount, hamCount = classifier.Counts()
+	assert.Equal(t, 1, spamCount)
+	assert.Equal(t, 2, hamCount)
+
+	// 거절하거나 삭제하면 학습 취소
+	_, err = repo.SetCommentStatus(ctx, []string{pendingID}, model.CommentStatusRejected)
+	require.NoError(t, err)
+	spamCount, hamCount = classifier.Counts()
+	assert.Equal(t, 1, spamCount)
+	assert.Equal(t, 1, hamCount)
+
+	// 새로 저장한 댓글은 관리자가 검토할 때까지 학습하지 않음
+	created, err := repo.CreateComment(ctx, &model.Comment{PostID: "post1", Nickname: "광고", Content: "무료 상담", Status: model.CommentStatusSpam})
+	require.NoError(t, err)
+	spamCount, _ = classifier.Counts()
+	assert.Equal(t, 1, spamCount)
+
+	_, err = repo.SetCommentStatus(ctx, []string{created.CommentID}, model.CommentStatusSpam)
+	require.NoError(t, err)
+	spamCount, _ = classifier.Counts()
+	assert.Equal(t, 2, spamCount)
+
+	require.NoError(t, repo.DeleteComment(ctx, created.CommentID))
+	spamCount, _ = classifier.Counts()
+	assert.Equal(t, 1, spamCount)
+}
@@ -0,0 +1,100 @@
+package spam
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultFormTokenMaxAge는 폼 토큰의 기본 유효 기간입니다.
+const DefaultFormTokenMaxAge = 24 * time.Hour
+
+var (
+	ErrInvalidFormToken = errors.New("유효하지 않은 폼 토큰입니다")
+	ErrFormTokenExpired = errors.New("만료된 폼 토큰입니다")
+	ErrSubmittedTooSoon = errors.New("폼을 연 직후 제출되었습니다")
+)
+
+// FormTokens는 댓글 작성 폼을 연 시각을 서명한 토큰을 발급하고 검증합니다.
+// 토큰은 게시글별로 발급되며, 서버에 상태를 저장하지 않습니다.
+type FormTokens struct {
+	secret   []byte
+	MinDelay time.Duration // 폼을 연 뒤 제출까지 걸려야 하는 최소 시간
+	MaxAge   time.Duration // 토큰 유효 기간
+}
+
+// NewFormTokens는 폼 토큰 발급기를 생성합니다. secret이 비어 있으면 임의의 키를 생성하며,
+// 이 경우 서버를 재시작하면 이전에 발급한 토큰은 무효가 됩니다.
+func NewFormTokens(secret []byte, minDelay, maxAge time.Duration) *FormTokens {
+	if len(secret) == 0 {
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			panic(fmt.Sprintf("폼 토큰 키 생성 실패: %v", err))
+		}
+	}
+	if maxAge <= 0 {
+		maxAge = DefaultFormTokenMaxAge
+	}
+	return &FormTokens{secret: secret, MinDelay: minDelay, MaxAge: maxAge}
+}
+
+// Issue는 게시글의 댓글 작성 폼 토큰을 발급합니다. 토큰 형식은 "발급 시각(unix 초).서명"입니다.
+func (t *FormTokens) Issue(postID string, now time.Time) string {
+	issuedAt := strconv.FormatInt(now.Unix(), 10)
+	return issuedAt + "." + t.sign(postID, issuedAt)
+}
+
+// Verify는 토큰이 해당 게시글에 발급되었고, 최소 시간이 지났으며, 만료되지 않았는지 확인합니다.
+func (t *FormTokens) Verify(token, postID string, now time.Time) error {
+	issuedAt, signature, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(t.sign(postID, issuedAt))) {
+		return ErrInvalidFormToken
+	}
+
+	unix, err := strconv.ParseInt(issuedAt, 10, 64)
+	if err != nil {
+		return ErrInvalidFormToken
+	}
+
+	elapsed := now.Sub(time.Unix(unix, 0))
+	if elapsed < t.MinDelay {
+		return ErrSubmittedTooSoon
+	}
+	if elapsed > t.MaxAge {
+		return ErrFormTokenExpired
+	}
+	return nil
+}
+
+func (t *FormTokens) sign(postID, issuedAt string) string {
+	mac := hmac.New(sha256.New, t.secret)
+	mac.Write([]byte(postID + "\n" + issuedAt))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// TimingFilter는 폼 토큰이 없거나 유효하지 않은 요청, 폼을 연 직후 제출된 요청을 거부합니다.
+type TimingFilter struct {
+	tokens *FormTokens
+}
+
+func NewTimingFilter(tokens *FormTokens) *TimingFilter {
+	return &TimingFilter{tokens: tokens}
+}
+
+func (f *TimingFilter) Name() string {
+	return "timing"
+}
+
+func (f *TimingFilter) Check(ctx context.Context, submission *Submission) (*Verdict, error) {
+	if err := f.tokens.Verify(submission.FormToken, submission.PostID, submission.SubmittedAt); err != nil {
+		return &Verdict{Filter: f.Name(), Reason: err.Error(), Reject: true}, nil
+	}
+	return nil, nil
+}
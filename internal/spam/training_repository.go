@@ -0,0 +1,61 @@
+package spam
+
+import (
+	"context"
+
+	"bumsiku/internal/model"
+	"bumsiku/internal/repository"
+	"bumsiku/internal/utils"
+)
+
+// TrainingCommentRepository는 댓글 저장소를 감싸 관리자가 댓글을 스팸 처리하거나 승인할 때마다 분류기를 학습시킵니다.
+// 새로 저장된 댓글은 스팸 필터의 판정만 받았으므로 학습하지 않습니다. 서버 시작 시 Rebuild한 결과와 같은 상태를 유지합니다.
+type TrainingCommentRepository struct {
+	repository.CommentRepositoryInterface
+	classifier *Classifier
+	logger     *utils.Logger
+}
+
+func NewTrainingCommentRepository(commentRepo repository.CommentRepositoryInterface, classifier *Classifier, logger *utils.Logger) *TrainingCommentRepository {
+	return &TrainingCommentRepository{CommentRepositoryInterface: commentRepo, classifier: classifier, logger: logger}
+}
+
+func (r *TrainingCommentRepository) SetCommentStatus(ctx context.Context, commentIDs []string, status string) ([]string, error) {
+	updated, err := r.CommentRepositoryInterface.SetCommentStatus(ctx, commentIDs, status)
+	if len(updated) > 0 {
+		r.learn(ctx, updated)
+	}
+	return updated, err
+}
+
+func (r *TrainingCommentRepository) DeleteComment(ctx context.Context, commentID string) error {
+	if err := r.CommentRepositoryInterface.DeleteComment(ctx, commentID); err != nil {
+		return err
+	}
+
+	r.classifier.Forget(commentID)
+	return nil
+}
+
+// learn은 상태가 바뀐 댓글을 다시 읽어 학습합니다. 거절 등 학습 대상이 아닌 상태로 바뀌면 이전 학습을 취소합니다.
+// 학습 실패는 검토 결과에 영향을 주지 않도록 로그만 남깁니다.
+func (r *TrainingCommentRepository) learn(ctx context.Context, commentIDs []string) {
+	comments, err := r.CommentRepositoryInterface.GetComments(ctx, &repository.GetCommentsInput{})
+	if err != nil {
+		r.logger.Warn(ctx, "스팸 분류기 학습 실패", map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	byID := make(map[string]model.Comment, len(comments))
+	for _, comment := range comments {
+		byID[comment.CommentID] = comment
+	}
+	for _, commentID := range commentIDs {
+		comment, ok := byID[commentID]
+		if !ok || !r.classifier.LearnComment(comment) {
+			r.classifier.Forget(commentID)
+		}
+	}
+}
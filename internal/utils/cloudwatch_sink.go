@@ -0,0 +1,308 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+)
+
+// CloudWatch PutLogEvents 요청 제한
+const (
+	maxBatchEvents     = 10000          // 요청당 최대 이벤트 수
+	maxBatchBytes      = 1048576        // 요청당 최대 크기 (메시지 UTF-8 바이트 + 이벤트당 26바이트)
+	eventOverheadBytes = 26             // 이벤트당 추가로 계산되는 크기
+	maxEventBytes      = 256 * 1024     // 이벤트 하나의 최대 크기 (추가 크기 포함)
+	maxBatchSpan       = 24 * time.Hour // 한 요청에 포함된 이벤트의 최대 시간 범위
+)
+
+// CloudWatch 전송 재시도 기본값
+const (
+	DefaultLogMaxRetries   = 3
+	DefaultLogRetryBackoff = 200 * time.Millisecond
+)
+
+// CloudWatchLogsClient는 CloudWatchSink가 사용하는 CloudWatch Logs API입니다. *cloudwatchlogs.Client가 구현합니다.
+type CloudWatchLogsClient interface {
+	CreateLogGroup(ctx context.Context, params *cloudwatchlogs.CreateLogGroupInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.CreateLogGroupOutput, error)
+	CreateLogStream(ctx context.Context, params *cloudwatchlogs.CreateLogStreamInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.CreateLogStreamOutput, error)
+	PutLogEvents(ctx context.Context, params *cloudwatchlogs.PutLogEventsInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.PutLogEventsOutput, error)
+}
+
+// CloudWatchSinkOptions는 CloudWatch 전송 재시도 방식을 설정합니다. 0인 항목은 기본값을 사용합니다.
+type CloudWatchSinkOptions struct {
+	MaxRetries   int           // 전송 실패 시 재시도 횟수
+	RetryBackoff time.Duration // 첫 재시도 대기 시간 (재시도마다 두 배)
+}
+
+func (o CloudWatchSinkOptions) withDefaults() CloudWatchSinkOptions {
+	if o.MaxRetries <= 0 {
+		o.MaxRetries = DefaultLogMaxRetries
+	}
+	if o.RetryBackoff <= 0 {
+		o.RetryBackoff = DefaultLogRetryBackoff
+	}
+	return o
+}
+
+// CloudWatchSink는 로그를 CloudWatch Logs로 전송하는 싱크입니다.
+// 로그는 JSON으로 인코딩하며, 로그 시각의 날짜별 스트림({APP_ENV}-{날짜})에 기록하므로 자정이 지나면 새 스트림으로 넘어갑니다.
+type CloudWatchSink struct {
+	client       CloudWatchLogsClient
+	logGroupName string
+	env          string
+	options      CloudWatchSinkOptions
+
+	// 마지막으로 전송한 스트림과 시퀀스 토큰
+	logStreamName string
+	sequenceToken *string
+}
+
+// NewCloudWatchSink는 CloudWatch 싱크를 생성하고 로그 그룹과 오늘 날짜의 스트림을 초기화합니다.
+// 로그 그룹은 CLOUDWATCH_LOG_GROUP(기본값 bumsiku-api)입니다.
+func NewCloudWatchSink(client CloudWatchLogsClient, options CloudWatchSinkOptions) *CloudWatchSink {
+	env := os.Getenv("APP_ENV")
+	if env == "" {
+		env = "development"
+	}
+
+	logGroupName := os.Getenv("CLOUDWATCH_LOG_GROUP")
+	if logGroupName == "" {
+		logGroupName = "bumsiku-api"
+	}
+
+	sink := &CloudWatchSink{
+		client:       client,
+		logGroupName: logGroupName,
+		env:          env,
+		options:      options.withDefaults(),
+	}
+	sink.logStreamName = sink.streamName(time.Now())
+
+	// 로그 그룹과 스트림 초기화
+	_ = sink.initLogGroupAndStream(context.Background())
+	return sink
+}
+
+// streamName은 t 날짜의 로그 스트림 이름입니다: {prefix}-{date}
+func (s *CloudWatchSink) streamName(t time.Time) string {
+	return fmt.Sprintf("%s-%s", s.env, t.Format("2006-01-02"))
+}
+
+// initLogGroupAndStream 로그 그룹과 스트림을 초기화합니다.
+func (s *CloudWatchSink) initLogGroupAndStream(ctx context.Context) error {
+	// 로그 그룹 생성 (이미 존재해도 오류 발생하지 않음)
+	_, err := s.client.CreateLogGroup(ctx, &cloudwatchlogs.CreateLogGroupInput{
+		LogGroupName: aws.String(s.logGroupName),
+	})
+	if err != nil {
+		// 이미 존재하는 로그 그룹이면 무시
+		fmt.Printf("로그 그룹 생성 중 알림: %v\n", err)
+	}
+
+	return s.initLogStream(ctx)
+}
+
+// initLogStream 현재 로그 스트림을 생성합니다.
+func (s *CloudWatchSink) initLogStream(ctx context.Context) error {
+	// 로그 스트림 생성 (이미 존재해도 오류 발생하지 않음)
+	_, err := s.client.CreateLogStream(ctx, &cloudwatchlogs.CreateLogStreamInput{
+		LogGroupName:  aws.String(s.logGroupName),
+		LogStreamName: aws.String(s.logStreamName),
+	})
+	if err != nil {
+		// 이미 존재하는 로그 스트림이면 무시
+		fmt.Printf("로그 스트림 생성 중 알림: %v\n", err)
+	}
+
+	return nil
+}
+
+// Write는 로그를 날짜별 스트림으로 나누고, 다시 PutLogEvents 제한에 맞게 나누어 전송합니다.
+// 전송에 실패한 묶음은 버리고, 나머지 묶음은 계속 전송한 뒤 마지막 오류를 반환합니다.
+func (s *CloudWatchSink) Write(entries []LogEntry) error {
+	var lastErr error
+	for start := 0; start < len(entries); {
+		// 로그는 시간순이므로 같은 날짜의 로그는 연속해 있음
+		stream := s.streamName(entries[start].Time)
+		end := start + 1
+		for end < len(entries) && s.streamName(entries[end].Time) == stream {
+			end++
+		}
+
+		if err := s.writeStream(stream, entries[start:end]); err != nil {
+			lastErr = err
+		}
+		start = end
+	}
+	return lastErr
+}
+
+// writeStream은 같은 날짜의 로그를 해당 날짜의 스트림에 전송합니다. 스트림이 바뀌면 새 스트림을 만듭니다.
+func (s *CloudWatchSink) writeStream(stream string, entries []LogEntry) error {
+	if stream != s.logStreamName {
+		s.logStreamName = stream
+		s.sequenceToken = nil
+		_ = s.initLogStream(context.Background())
+	}
+
+	var lastErr error
+	events := make([]types.InputLogEvent, 0, len(entries))
+	for _, entry := range entries {
+		message, err := encodeLogEvent(entry)
+		if err != nil {
+			lastErr = fmt.Errorf("로그 인코딩 실패: %w", err)
+			continue
+		}
+		events = append(events, types.InputLogEvent{
+			Message:   aws.String(message),
+			Timestamp: aws.Int64(entry.Time.UnixMilli()),
+		})
+	}
+
+	for _, batch := range splitLogBatches(events) {
+		if err := s.putWithRetry(batch); err != nil {
+			lastErr = fmt.Errorf("%d건 버림: %w", len(batch), err)
+		}
+	}
+	return lastErr
+}
+
+// Close 아무 작업도 하지 않음 (전송은 Write에서 끝남)
+func (s *CloudWatchSink) Close() error {
+	return nil
+}
+
+// putWithRetry는 로그를 전송하고, 실패하면 대기 시간을 늘려가며 재시도합니다.
+// 로그 스트림이 없으면 다시 만들고, 시퀀스 토큰이 맞지 않으면 응답의 토큰으로 재시도합니다.
+func (s *CloudWatchSink) putWithRetry(events []types.InputLogEvent) error {
+	backoff := s.options.RetryBackoff
+	var err error
+	for attempt := 0; attempt <= s.options.MaxRetries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		var resp *cloudwatchlogs.PutLogEventsOutput
+		resp, err = s.client.PutLogEvents(ctx, &cloudwatchlogs.PutLogEventsInput{
+			LogGroupName:  aws.String(s.logGroupName),
+			LogStreamName: aws.String(s.logStreamName),
+			LogEvents:     events,
+			SequenceToken: s.sequenceToken,
+		})
+		cancel()
+		if err == nil {
+			// 다음 시퀀스 토큰 저장
+			s.sequenceToken = resp.NextSequenceToken
+			return nil
+		}
+
+		var sequenceErr *types.InvalidSequenceTokenException
+		if errors.As(err, &sequenceErr) {
+			s.sequenceToken = sequenceErr.ExpectedSequenceToken
+			continue
+		}
+		var notFoundErr *types.ResourceNotFoundException
+		if errors.As(err, &notFoundErr) {
+			s.sequenceToken = nil
+			_ = s.initLogGroupAndStream(context.Background())
+		}
+	}
+	return err
+}
+
+// splitLogBatches는 시간순으로 정렬된 로그를 요청당 개수, 크기, 시간 범위 제한에 맞게 나눕니다.
+func splitLogBatches(events []types.InputLogEvent) [][]types.InputLogEvent {
+	var batches [][]types.InputLogEvent
+	start, size := 0, 0
+	for i, event := range events {
+		eventSize := len(*event.Message) + eventOverheadBytes
+		if i > start && (i-start >= maxBatchEvents ||
+			size+eventSize > maxBatchBytes ||
+			time.Duration(*event.Timestamp-*events[start].Timestamp)*time.Millisecond > maxBatchSpan) {
+			batches = append(batches, events[start:i])
+			start, size = i, 0
+		}
+		size += eventSize
+	}
+	if start < len(events) {
+		batches = append(batches, events[start:])
+	}
+	return batches
+}
+
+// truncatedSuffix는 크기 제한 때문에 잘린 값 끝에 붙입니다.
+const truncatedSuffix = "...(truncated)"
+
+// encodeLogEvent는 로그를 CloudWatch 이벤트 메시지(JSON)로 인코딩합니다.
+// 이벤트 크기 제한을 넘으면 JSON 형식이 유지되도록 가장 긴 값(메시지나 필드)부터 잘라냅니다.
+func encodeLogEvent(entry LogEntry) (string, error) {
+	const limit = maxEventBytes - eventOverheadBytes
+
+	copied := false
+	for {
+		data, err := marshalLogEntry(entry)
+		if err != nil {
+			return "", err
+		}
+		over := len(data) - limit
+		if over <= 0 {
+			return string(data), nil
+		}
+
+		// 다른 싱크도 같은 로그를 사용하므로 필드 맵은 복사해서 수정
+		if !copied {
+			fields := make(map[string]string, len(entry.Fields))
+			for k, v := range entry.Fields {
+				fields[k] = v
+			}
+			entry.Fields = fields
+			copied = true
+		}
+
+		longestKey, longest := "", entry.Message
+		for k, v := range entry.Fields {
+			if len(v) > len(longest) {
+				longestKey, longest = k, v
+			}
+		}
+		if len(longest) <= len(truncatedSuffix) {
+			// 필드 수가 지나치게 많은 경우: 필드를 버리고 메시지만 남김 (이스케이프로 최대 6배까지 커질 수 있음)
+			entry.Fields = nil
+			entry.Message = truncateString(entry.Message, limit/8)
+			continue
+		}
+
+		// 원본에서 줄인 바이트 수 이상 인코딩 결과가 줄어듦
+		truncated := truncateString(longest, len(longest)-over)
+		if longestKey == "" {
+			entry.Message = truncated
+		} else {
+			entry.Fields[longestKey] = truncated
+		}
+	}
+}
+
+// truncateString은 s를 잘린 표시를 포함해 최대 n바이트로 자릅니다. UTF-8 문자 중간에서는 자르지 않습니다.
+func truncateString(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+
+	cut := n - len(truncatedSuffix)
+	if cut < 0 {
+		cut = 0
+	}
+	// UTF-8 문자 중간에서 자르지 않도록 문자 시작 위치까지 이동
+	for cut > 0 && s[cut]&0xC0 == 0x80 {
+		cut--
+	}
+	return s[:cut] + truncatedSuffix
+}
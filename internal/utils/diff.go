@@ -0,0 +1,200 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffContextLines는 unified diff에서 변경 줄 앞뒤로 표시하는 줄 수입니다.
+const diffContextLines = 3
+
+// diffOp는 줄 단위 편집 연산입니다.
+type diffOp struct {
+	kind byte // ' ' 유지, '-' 삭제, '+' 추가
+	text string
+}
+
+// UnifiedDiff는 두 텍스트를 줄 단위로 비교하여 unified diff 형식 문자열을 반환합니다.
+// 내용이 같으면 빈 문자열을 반환합니다.
+func UnifiedDiff(fromName, toName, from, to string) string {
+	ops := diffLines(splitLines(from), splitLines(to))
+
+	changed := false
+	for _, op := range ops {
+		if op.kind != ' ' {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return ""
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s\n+++ %s\n", fromName, toName)
+
+	// 변경 구간별로 앞뒤 문맥을 포함한 hunk 생성 (문맥이 겹치면 하나로 합침)
+	for start := 0; start < len(ops); {
+		first := start
+		for first < len(ops) && ops[first].kind == ' ' {
+			first++
+		}
+		if first == len(ops) {
+			break
+		}
+
+		hunkStart := first - diffContextLines
+		if hunkStart < start {
+			hunkStart = start
+		}
+		if hunkStart < 0 {
+			hunkStart = 0
+		}
+
+		hunkEnd := first
+		for hunkEnd < len(ops) {
+			if ops[hunkEnd].kind != ' ' {
+				hunkEnd++
+				continue
+			}
+			// 다음 변경까지의 유지 줄이 문맥 두 배 이하이면 같은 hunk로 처리
+			next := hunkEnd
+			for next < len(ops) && ops[next].kind == ' ' {
+				next++
+			}
+			if next < len(ops) && next-hunkEnd <= 2*diffContextLines {
+				hunkEnd = next
+				continue
+			}
+			hunkEnd += diffContextLines
+			if hunkEnd > next {
+				hunkEnd = next
+			}
+			break
+		}
+
+		writeHunk(&b, ops, hunkStart, hunkEnd)
+		start = hunkEnd
+	}
+
+	return b.String()
+}
+
+// writeHunk는 ops[start:end] 구간을 hunk 헤더와 함께 기록합니다.
+func writeHunk(b *strings.Builder, ops []diffOp, start, end int) {
+	// 구간 시작 전까지의 원본/대상 줄 번호 계산
+	fromLine, toLine := 1, 1
+	for _, op := range ops[:start] {
+		if op.kind != '+' {
+			fromLine++
+		}
+		if op.kind != '-' {
+			toLine++
+		}
+	}
+
+	fromCount, toCount := 0, 0
+	for _, op := range ops[start:end] {
+		if op.kind != '+' {
+			fromCount++
+		}
+		if op.kind != '-' {
+			toCount++
+		}
+	}
+
+	// 빈 구간은 직전 줄 번호로 표시 (GNU diff 규칙)
+	if fromCount == 0 {
+		fromLine--
+	}
+	if toCount == 0 {
+		toLine--
+	}
+
+	fmt.Fprintf(b, "@@ -%d,%d +%d,%d @@\n", fromLine, fromCount, toLine, toCount)
+	for _, op := range ops[start:end] {
+		b.WriteByte(op.kind)
+		b.WriteString(op.text)
+		b.WriteByte('\n')
+	}
+}
+
+// splitLines는 텍스트를 줄 단위로 나눕니다. 마지막 개행은 빈 줄로 취급하지 않습니다.
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// diffLines는 Myers 알고리즘으로 a를 b로 바꾸는 최소 편집 연산 목록을 계산합니다.
+func diffLines(a, b []string) []diffOp {
+	n, m := len(a), len(b)
+	max := n + m
+	offset := max + 1
+	v := make([]int, 2*max+2)
+	var trace [][]int
+
+	// 편집 거리 d를 늘려가며 각 대각선 k에서 도달 가능한 가장 먼 x를 기록
+	found := false
+	for d := 0; d <= max && !found; d++ {
+		trace = append(trace, append([]int(nil), v...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				found = true
+				break
+			}
+		}
+	}
+
+	// 기록된 경로를 역추적하여 연산 목록 생성
+	ops := make([]diffOp, 0, max)
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		k := x - y
+
+		var prevK int
+		if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[offset+prevK]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			ops = append(ops, diffOp{kind: ' ', text: a[x]})
+		}
+		if d > 0 {
+			if x == prevX {
+				y--
+				ops = append(ops, diffOp{kind: '+', text: b[y]})
+			} else {
+				x--
+				ops = append(ops, diffOp{kind: '-', text: a[x]})
+			}
+		}
+	}
+
+	// 역순으로 쌓았으므로 뒤집기
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+	return ops
+}
@@ -0,0 +1,119 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// 파일 싱크 기본값
+const (
+	DefaultLogFileMaxSize    = 100 * 1024 * 1024 // 로그 파일 하나의 최대 크기 (100MB)
+	DefaultLogFileMaxBackups = 5                 // 보관할 이전 로그 파일 수
+)
+
+// FileSink는 로그를 JSON 줄 형식으로 로컬 파일에 기록하는 싱크입니다.
+// 파일이 최대 크기를 넘으면 {path}.1, {path}.2, ... 로 밀어내고 새 파일에 기록하며, maxBackups개를 넘는 이전 파일은 삭제합니다.
+type FileSink struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	file *os.File
+	size int64
+}
+
+// NewFileSink는 path에 로그를 기록하는 파일 싱크를 생성합니다. 디렉터리가 없으면 만들고, 기존 파일에는 이어서 기록합니다.
+// maxSize가 0 이하이면 DefaultLogFileMaxSize, maxBackups가 0 미만이면 DefaultLogFileMaxBackups를 사용합니다.
+func NewFileSink(path string, maxSize int64, maxBackups int) (*FileSink, error) {
+	if maxSize <= 0 {
+		maxSize = DefaultLogFileMaxSize
+	}
+	if maxBackups < 0 {
+		maxBackups = DefaultLogFileMaxBackups
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("로그 디렉터리 생성 실패: %w", err)
+	}
+
+	sink := &FileSink{path: path, maxSize: maxSize, maxBackups: maxBackups}
+	if err := sink.open(); err != nil {
+		return nil, err
+	}
+	return sink, nil
+}
+
+// open은 로그 파일을 추가 모드로 엽니다.
+func (s *FileSink) open() error {
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("로그 파일 열기 실패: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("로그 파일 정보 조회 실패: %w", err)
+	}
+	s.file, s.size = file, info.Size()
+	return nil
+}
+
+// Write 로그를 JSON 줄로 기록하고, 최대 크기를 넘으면 파일을 교체
+func (s *FileSink) Write(entries []LogEntry) error {
+	for _, entry := range entries {
+		line, err := marshalLogEntry(entry)
+		if err != nil {
+			return err
+		}
+		line = append(line, '\n')
+
+		if s.size > 0 && s.size+int64(len(line)) > s.maxSize {
+			if err := s.rotate(); err != nil {
+				return err
+			}
+		}
+
+		n, err := s.file.Write(line)
+		s.size += int64(n)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rotate는 현재 파일을 {path}.1로 옮기고 새 파일을 엽니다. 이전 파일은 번호를 하나씩 올리고 가장 오래된 파일은 삭제합니다.
+func (s *FileSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return err
+	}
+
+	if s.maxBackups == 0 {
+		if err := os.Remove(s.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return s.open()
+	}
+
+	_ = os.Remove(s.backupPath(s.maxBackups))
+	for i := s.maxBackups - 1; i >= 1; i-- {
+		if err := os.Rename(s.backupPath(i), s.backupPath(i+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := os.Rename(s.path, s.backupPath(1)); err != nil {
+		return err
+	}
+	return s.open()
+}
+
+// backupPath는 n번째 이전 로그 파일 경로입니다.
+func (s *FileSink) backupPath(n int) string {
+	return fmt.Sprintf("%s.%d", s.path, n)
+}
+
+// Close 로그 파일 닫기
+func (s *FileSink) Close() error {
+	return s.file.Close()
+}
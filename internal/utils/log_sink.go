@@ -0,0 +1,113 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// LogEntry는 Logger가 싱크로 내보내는 로그 하나입니다.
+// JSON으로 인코딩하면 time, level, message, env, fields 순서이며 fields는 키 순서로 정렬됩니다.
+type LogEntry struct {
+	Time    time.Time         `json:"time"`
+	Level   string            `json:"level"`
+	Message string            `json:"message"`
+	Env     string            `json:"env"`
+	Fields  map[string]string `json:"fields,omitempty"`
+}
+
+// marshalLogEntry는 로그를 한 줄의 JSON으로 인코딩합니다. HTML 문자(<, >, &)는 이스케이프하지 않습니다.
+func marshalLogEntry(entry LogEntry) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(entry); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// Sink는 로그를 내보내는 대상입니다.
+// Logger의 전송 고루틴 하나에서만 호출되므로 Write와 Close가 동시에 호출되지 않습니다.
+type Sink interface {
+	// Write는 시간순으로 정렬된 로그를 내보냅니다.
+	Write(entries []LogEntry) error
+	// Close는 Logger가 종료될 때 남은 자원을 정리합니다.
+	Close() error
+}
+
+// JSONSink는 로그를 한 줄에 하나씩 JSON으로 기록합니다.
+type JSONSink struct {
+	w io.Writer
+}
+
+// NewJSONSink는 w에 JSON 줄 형식으로 로그를 기록하는 싱크를 생성합니다.
+func NewJSONSink(w io.Writer) *JSONSink {
+	return &JSONSink{w: w}
+}
+
+// NewStdoutSink는 표준 출력에 JSON 줄 형식으로 로그를 기록하는 싱크를 생성합니다. 로컬 실행과 컨테이너 로그 수집에 사용합니다.
+func NewStdoutSink() *JSONSink {
+	return NewJSONSink(os.Stdout)
+}
+
+// Write 로그를 JSON 줄로 기록
+func (s *JSONSink) Write(entries []LogEntry) error {
+	for _, entry := range entries {
+		line, err := marshalLogEntry(entry)
+		if err != nil {
+			return err
+		}
+		if _, err := s.w.Write(append(line, '\n')); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close 표준 출력 등 w는 호출한 쪽에서 관리하므로 닫지 않음
+func (s *JSONSink) Close() error {
+	return nil
+}
+
+// MemorySink는 로그를 메모리에 보관하는 싱크입니다. 테스트에서 남긴 로그를 확인할 때 사용합니다.
+type MemorySink struct {
+	mu      sync.Mutex
+	entries []LogEntry
+}
+
+// NewMemorySink 메모리 싱크 생성
+func NewMemorySink() *MemorySink {
+	return &MemorySink{}
+}
+
+// Write 로그를 메모리에 추가
+func (s *MemorySink) Write(entries []LogEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = append(s.entries, entries...)
+	return nil
+}
+
+// Close 아무 작업도 하지 않음 (닫은 뒤에도 Entries로 로그를 확인할 수 있음)
+func (s *MemorySink) Close() error {
+	return nil
+}
+
+// Entries는 지금까지 기록된 로그의 복사본을 반환합니다.
+// Logger는 로그를 모아서 내보내므로, 확인하기 전에 Logger.Flush를 호출해야 합니다.
+func (s *MemorySink) Entries() []LogEntry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]LogEntry(nil), s.entries...)
+}
+
+// Reset은 기록된 로그를 모두 지웁니다.
+func (s *MemorySink) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = nil
+}
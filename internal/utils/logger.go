@@ -2,13 +2,12 @@ package utils
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"sort"
+	"sync"
 	"time"
-
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
-	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
 )
 
 const (
@@ -19,115 +18,158 @@ const (
 	LogLevelDebug = "DEBUG"
 )
 
-// Logger CloudWatch 로깅 유틸리티
-type Logger struct {
-	client        *cloudwatchlogs.Client
-	logGroupName  string
-	logStreamName string
-	sequenceToken *string
-	env           string
+// logLevelRanks는 로그 레벨의 심각도 순서입니다. 목록에 없는 레벨은 항상 기록합니다.
+var logLevelRanks = map[string]int{
+	LogLevelDebug: 0,
+	LogLevelInfo:  1,
+	LogLevelWarn:  2,
+	LogLevelError: 3,
 }
 
-// NewLogger Logger 인스턴스 생성
-func NewLogger(client *cloudwatchlogs.Client) *Logger {
-	env := os.Getenv("APP_ENV")
-	if env == "" {
-		env = "development"
-	}
+// IsValidLogLevel은 DEBUG, INFO, WARN, ERROR 중 하나인지 확인합니다.
+func IsValidLogLevel(level string) bool {
+	_, ok := logLevelRanks[level]
+	return ok
+}
 
-	logGroupName := os.Getenv("CLOUDWATCH_LOG_GROUP")
-	if logGroupName == "" {
-		logGroupName = "bumsiku-api"
-	}
+// 로그 전송 기본값
+const (
+	DefaultLogFlushInterval = 5 * time.Second
+	DefaultLogBatchSize     = 500
+	DefaultLogQueueSize     = 10000
+)
+
+var (
+	// ErrLoggerClosed는 Close 이후에 로그를 남기려고 할 때 반환합니다.
+	ErrLoggerClosed = errors.New("로거가 종료됨")
+	// ErrLogQueueFull은 전송 대기 중인 로그가 너무 많아 로그를 버렸을 때 반환합니다.
+	ErrLogQueueFull = errors.New("로그 전송 대기열이 가득 참")
+)
 
-	// 로그 스트림 이름: {prefix}-{timestamp}
-	timestamp := time.Now().Format("2006-01-02")
-	logStreamName := fmt.Sprintf("%s-%s", env, timestamp)
+// LoggerOptions는 로그 전송 방식을 설정합니다. 0인 항목은 기본값을 사용합니다.
+type LoggerOptions struct {
+	FlushInterval time.Duration // 쌓인 로그를 싱크로 내보내는 주기
+	BatchSize     int           // 이 수만큼 쌓이면 주기를 기다리지 않고 내보냄
+	QueueSize     int           // 전송 대기 중인 최대 로그 수 (넘으면 새 로그를 버림)
+	MinLevel      string        // 이보다 낮은 레벨의 로그는 버림 (기본값 DEBUG)
+}
 
-	logger := &Logger{
-		client:        client,
-		logGroupName:  logGroupName,
-		logStreamName: logStreamName,
-		env:           env,
+func (o LoggerOptions) withDefaults() LoggerOptions {
+	if o.FlushInterval <= 0 {
+		o.FlushInterval = DefaultLogFlushInterval
+	}
+	if o.BatchSize <= 0 {
+		o.BatchSize = DefaultLogBatchSize
 	}
+	if o.QueueSize <= 0 {
+		o.QueueSize = DefaultLogQueueSize
+	}
+	if !IsValidLogLevel(o.MinLevel) {
+		o.MinLevel = LogLevelDebug
+	}
+	return o
+}
 
-	// 로그 그룹과 스트림 초기화
-	_ = logger.initLogGroupAndStream(context.Background())
+// Logger 로깅 유틸리티
+// 로그는 요청 처리 중에 바로 내보내지 않고 대기열에 쌓은 뒤, 백그라운드 고루틴이 모아서 모든 싱크(CloudWatch, 표준 출력, 파일 등)로 내보냅니다.
+// 종료할 때는 Close로 남은 로그를 내보내야 합니다.
+type Logger struct {
+	sinks   []Sink
+	env     string
+	options LoggerOptions
 
-	return logger
+	events   chan LogEntry
+	flushes  chan chan struct{}
+	stop     chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
 }
 
-// initLogGroupAndStream 로그 그룹과 스트림을 초기화합니다.
-func (l *Logger) initLogGroupAndStream(ctx context.Context) error {
-	// 로그 그룹 생성 (이미 존재해도 오류 발생하지 않음)
-	_, err := l.client.CreateLogGroup(ctx, &cloudwatchlogs.CreateLogGroupInput{
-		LogGroupName: aws.String(l.logGroupName),
-	})
-	if err != nil {
-		// 이미 존재하는 로그 그룹이면 무시
-		fmt.Printf("로그 그룹 생성 중 알림: %v\n", err)
+// NewLogger Logger 인스턴스 생성
+func NewLogger(sinks ...Sink) *Logger {
+	return NewLoggerWithOptions(LoggerOptions{}, sinks...)
+}
+
+// NewLoggerWithOptions는 전송 방식을 지정해 Logger를 생성하고 백그라운드 전송을 시작합니다.
+// 로그는 지정한 모든 싱크로 내보내며, 한 싱크의 실패는 다른 싱크에 영향을 주지 않습니다.
+func NewLoggerWithOptions(options LoggerOptions, sinks ...Sink) *Logger {
+	env := os.Getenv("APP_ENV")
+	if env == "" {
+		env = "development"
 	}
 
-	// 로그 스트림 생성 (이미 존재해도 오류 발생하지 않음)
-	_, err = l.client.CreateLogStream(ctx, &cloudwatchlogs.CreateLogStreamInput{
-		LogGroupName:  aws.String(l.logGroupName),
-		LogStreamName: aws.String(l.logStreamName),
-	})
-	if err != nil {
-		// 이미 존재하는 로그 스트림이면 무시
-		fmt.Printf("로그 스트림 생성 중 알림: %v\n", err)
+	options = options.withDefaults()
+	logger := &Logger{
+		sinks:   sinks,
+		env:     env,
+		options: options,
+		events:  make(chan LogEntry, options.QueueSize),
+		flushes: make(chan chan struct{}),
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
 	}
 
-	return nil
+	go logger.run()
+	return logger
 }
 
 // Log 지정된 로그 레벨로 로그를 남깁니다
+// ctx에 요청 ID와 추적 정보가 있으면 requestID, traceID, spanID 필드로 함께 남깁니다.
+// 로그는 전송 대기열에 넣기만 하므로 요청 처리를 지연시키지 않습니다.
+// 최소 레벨(MinLevel)보다 낮은 로그는 기록하지 않으며, 대기열이 가득 차면 로그를 버리고 ErrLogQueueFull을 반환합니다.
 func (l *Logger) Log(ctx context.Context, level, message string, fields map[string]string) error {
-	// 로그 엔트리에 환경 정보 추가
-	if fields == nil {
-		fields = make(map[string]string)
+	select {
+	case <-l.stop:
+		return ErrLoggerClosed
+	default:
 	}
-	fields["env"] = l.env
 
-	// 필드를 문자열로 변환
-	fieldStr := ""
-	for k, v := range fields {
-		fieldStr += fmt.Sprintf(" %s=%s", k, v)
+	if !l.Enabled(level) {
+		return nil
 	}
 
-	logEvent := fmt.Sprintf("[%s]%s %s", level, fieldStr, message)
-
-	// 현재 타임스탬프
-	timestamp := time.Now().UnixNano() / int64(time.Millisecond)
-
-	// 로그 이벤트 전송
-	input := &cloudwatchlogs.PutLogEventsInput{
-		LogGroupName:  aws.String(l.logGroupName),
-		LogStreamName: aws.String(l.logStreamName),
-		LogEvents: []types.InputLogEvent{
-			{
-				Message:   aws.String(logEvent),
-				Timestamp: aws.Int64(timestamp),
-			},
-		},
+	// 호출한 쪽에서 맵을 계속 사용할 수 있으므로 복사해서 보관
+	entryFields := make(map[string]string, len(fields)+3)
+	for k, v := range fields {
+		if k != "env" {
+			entryFields[k] = v
+		}
 	}
 
-	// 시퀀스 토큰이 있으면 추가
-	if l.sequenceToken != nil {
-		input.SequenceToken = l.sequenceToken
+	// 요청 컨텍스트의 요청 ID와 추적 정보 (필드에 직접 지정한 값이 우선)
+	if requestID := RequestIDFromContext(ctx); requestID != "" && entryFields["requestID"] == "" {
+		entryFields["requestID"] = requestID
+	}
+	if trace, ok := TraceContextFromContext(ctx); ok && entryFields["traceID"] == "" {
+		entryFields["traceID"] = trace.TraceID
+		entryFields["spanID"] = trace.SpanID
+	}
+	if len(entryFields) == 0 {
+		entryFields = nil
 	}
 
-	// 로그 전송
-	resp, err := l.client.PutLogEvents(ctx, input)
-	if err != nil {
-		fmt.Printf("로그 전송 실패: %v\n", err)
-		return err
+	entry := LogEntry{
+		Time:    time.Now(),
+		Level:   level,
+		Message: message,
+		Env:     l.env,
+		Fields:  entryFields,
 	}
 
-	// 다음 시퀀스 토큰 저장
-	l.sequenceToken = resp.NextSequenceToken
+	select {
+	case l.events <- entry:
+		return nil
+	default:
+		fmt.Printf("로그 전송 대기열이 가득 차 로그를 버림: [%s] %s\n", level, message)
+		return ErrLogQueueFull
+	}
+}
 
-	return nil
+// Enabled는 level의 로그가 최소 레벨 설정에 따라 기록되는지 확인합니다.
+// 기록되지 않는 로그의 필드를 만드는 비용을 줄일 때 사용합니다.
+func (l *Logger) Enabled(level string) bool {
+	rank, ok := logLevelRanks[level]
+	return !ok || rank >= logLevelRanks[l.options.MinLevel]
 }
 
 // Info 정보 레벨 로그
@@ -149,3 +191,107 @@ func (l *Logger) Error(ctx context.Context, message string, fields map[string]st
 func (l *Logger) Debug(ctx context.Context, message string, fields map[string]string) error {
 	return l.Log(ctx, LogLevelDebug, message, fields)
 }
+
+// Flush는 지금까지 남긴 로그를 모든 싱크로 내보낼 때까지 기다립니다.
+func (l *Logger) Flush(ctx context.Context) error {
+	reply := make(chan struct{})
+	select {
+	case l.flushes <- reply:
+	case <-l.done:
+		return ErrLoggerClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case <-reply:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close는 새 로그를 받지 않고, 남은 로그를 내보낸 뒤 백그라운드 전송을 종료하고 싱크를 닫습니다.
+// ctx가 먼저 끝나면 전송을 기다리지 않고 ctx의 오류를 반환합니다.
+func (l *Logger) Close(ctx context.Context) error {
+	l.stopOnce.Do(func() { close(l.stop) })
+
+	select {
+	case <-l.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// run은 대기열의 로그를 모아 개수(BatchSize)나 주기(FlushInterval)에 따라 내보냅니다.
+// 싱크는 이 고루틴에서만 호출합니다.
+func (l *Logger) run() {
+	defer close(l.done)
+	defer l.closeSinks()
+
+	ticker := time.NewTicker(l.options.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]LogEntry, 0, l.options.BatchSize)
+	send := func() {
+		if len(batch) > 0 {
+			l.send(batch)
+			batch = make([]LogEntry, 0, l.options.BatchSize)
+		}
+	}
+	// drain은 대기열에 남은 로그를 모두 꺼냅니다.
+	drain := func() {
+		for {
+			select {
+			case entry := <-l.events:
+				batch = append(batch, entry)
+			default:
+				return
+			}
+		}
+	}
+
+	for {
+		select {
+		case entry := <-l.events:
+			batch = append(batch, entry)
+			if len(batch) >= l.options.BatchSize {
+				send()
+			}
+		case <-ticker.C:
+			send()
+		case reply := <-l.flushes:
+			drain()
+			send()
+			close(reply)
+		case <-l.stop:
+			drain()
+			send()
+			return
+		}
+	}
+}
+
+// send는 로그를 시간순으로 정렬해 모든 싱크로 내보냅니다.
+func (l *Logger) send(entries []LogEntry) {
+	// 여러 고루틴이 남긴 로그는 대기열 순서와 시간 순서가 다를 수 있음
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Time.Before(entries[j].Time)
+	})
+
+	for _, sink := range l.sinks {
+		if err := sink.Write(entries); err != nil {
+			fmt.Printf("로그 전송 실패 (%T, %d건): %v\n", sink, len(entries), err)
+		}
+	}
+}
+
+// closeSinks는 모든 싱크를 닫습니다.
+func (l *Logger) closeSinks() {
+	for _, sink := range l.sinks {
+		if err := sink.Close(); err != nil {
+			fmt.Printf("로그 싱크 종료 실패 (%T): %v\n", sink, err)
+		}
+	}
+}
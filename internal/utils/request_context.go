@@ -0,0 +1,107 @@
+package utils
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+)
+
+// 요청 컨텍스트에 값을 저장하는 키
+type (
+	requestIDKey    struct{}
+	traceContextKey struct{}
+)
+
+// WithRequestID는 요청 ID를 담은 컨텍스트를 반환합니다. Logger는 이 컨텍스트로 남긴 로그에 requestID를 붙입니다.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext는 컨텍스트의 요청 ID를 반환합니다. 없으면 빈 문자열입니다.
+func RequestIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+// TraceContext는 W3C Trace Context(traceparent 헤더)의 추적 정보입니다.
+type TraceContext struct {
+	TraceID  string // 요청 전체를 묶는 추적 ID (32자리 16진수)
+	SpanID   string // 이 서버에서 처리하는 구간 ID (16자리 16진수)
+	ParentID string // 요청을 보낸 쪽의 구간 ID (traceparent 헤더가 없으면 빈 문자열)
+	Flags    string // 추적 플래그 (01이면 샘플링됨)
+}
+
+// NewTraceContext는 요청의 traceparent 헤더를 이어받아 이 서버의 구간을 만듭니다.
+// 헤더가 없거나 형식이 잘못되면 새 추적을 시작합니다.
+func NewTraceContext(traceparent string) TraceContext {
+	trace := TraceContext{SpanID: randomHex(8), Flags: "01"}
+	if traceID, parentID, flags, ok := parseTraceParent(traceparent); ok {
+		trace.TraceID, trace.ParentID, trace.Flags = traceID, parentID, flags
+	} else {
+		trace.TraceID = randomHex(16)
+	}
+	return trace
+}
+
+// TraceParent는 이 서버의 구간을 가리키는 traceparent 헤더 값입니다. 다른 서비스를 호출할 때 전달합니다.
+func (t TraceContext) TraceParent() string {
+	return "00-" + t.TraceID + "-" + t.SpanID + "-" + t.Flags
+}
+
+// parseTraceParent는 "00-{trace-id}-{parent-id}-{flags}" 형식의 traceparent 헤더를 해석합니다.
+// 버전 00 이후의 형식은 앞의 네 항목만 사용하며, 모두 0인 ID는 잘못된 값으로 처리합니다.
+func parseTraceParent(value string) (traceID, parentID, flags string, ok bool) {
+	parts := strings.Split(strings.TrimSpace(value), "-")
+	if len(parts) < 4 {
+		return "", "", "", false
+	}
+	version, traceID, parentID, flags := parts[0], parts[1], parts[2], parts[3]
+	if !isLowerHex(version, 2) || version == "ff" || (version == "00" && len(parts) != 4) {
+		return "", "", "", false
+	}
+	if !isLowerHex(traceID, 32) || !isLowerHex(parentID, 16) || !isLowerHex(flags, 2) {
+		return "", "", "", false
+	}
+	if strings.Trim(traceID, "0") == "" || strings.Trim(parentID, "0") == "" {
+		return "", "", "", false
+	}
+	return traceID, parentID, flags, true
+}
+
+// isLowerHex는 value가 길이 n의 소문자 16진수인지 확인합니다.
+func isLowerHex(value string, n int) bool {
+	if len(value) != n {
+		return false
+	}
+	for _, r := range value {
+		if (r < '0' || r > '9') && (r < 'a' || r > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// randomHex는 n바이트 난수를 16진수 문자열로 만듭니다.
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// WithTraceContext는 추적 정보를 담은 컨텍스트를 반환합니다. Logger는 이 컨텍스트로 남긴 로그에 traceID와 spanID를 붙입니다.
+func WithTraceContext(ctx context.Context, trace TraceContext) context.Context {
+	return context.WithValue(ctx, traceContextKey{}, trace)
+}
+
+// TraceContextFromContext는 컨텍스트의 추적 정보를 반환합니다.
+func TraceContextFromContext(ctx context.Context) (TraceContext, bool) {
+	if ctx == nil {
+		return TraceContext{}, false
+	}
+	trace, ok := ctx.Value(traceContextKey{}).(TraceContext)
+	return trace, ok
+}
@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"bumsiku/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// [GIVEN] 같은 내용의 두 텍스트
+// [WHEN] UnifiedDiff 호출
+// [THEN] 빈 문자열 반환 확인
+func TestUnifiedDiff_Identical(t *testing.T) {
+	assert.Equal(t, "", utils.UnifiedDiff("a", "b", "line1\nline2\n", "line1\nline2"))
+}
+
+// [GIVEN] 한 줄이 수정된 텍스트
+// [WHEN] UnifiedDiff 호출
+// [THEN] 변경 줄과 앞뒤 문맥이 포함된 hunk 반환 확인
+func TestUnifiedDiff_SingleChange(t *testing.T) {
+	from := "1\n2\n3\n4\n5\n6\n7\n8\n9\n"
+	to := "1\n2\n3\n4\nfive\n6\n7\n8\n9\n"
+
+	expected := strings.Join([]string{
+		"--- revision 1",
+		"+++ current",
+		"@@ -2,7 +2,7 @@",
+		" 2",
+		" 3",
+		" 4",
+		"-5",
+		"+five",
+		" 6",
+		" 7",
+		" 8",
+		"",
+	}, "\n")
+	assert.Equal(t, expected, utils.UnifiedDiff("revision 1", "current", from, to))
+}
+
+// [GIVEN] 멀리 떨어진 두 곳이 변경된 텍스트
+// [WHEN] UnifiedDiff 호출
+// [THEN] hunk가 두 개로 나뉘는지 확인
+func TestUnifiedDiff_SeparateHunks(t *testing.T) {
+	from := "a\nb\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\n"
+	to := "A\nb\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\nm\n"
+
+	expected := strings.Join([]string{
+		"--- old",
+		"+++ new",
+		"@@ -1,4 +1,4 @@",
+		"-a",
+		"+A",
+		" b",
+		" c",
+		" d",
+		"@@ -10,3 +10,4 @@",
+		" j",
+		" k",
+		" l",
+		"+m",
+		"",
+	}, "\n")
+	assert.Equal(t, expected, utils.UnifiedDiff("old", "new", from, to))
+}
+
+// [GIVEN] 빈 텍스트에서 내용이 추가된 경우
+// [WHEN] UnifiedDiff 호출
+// [THEN] 원본 범위가 0으로 표시되는지 확인
+func TestUnifiedDiff_FromEmpty(t *testing.T) {
+	expected := "--- old\n+++ new\n@@ -0,0 +1,2 @@\n+x\n+y\n"
+	assert.Equal(t, expected, utils.UnifiedDiff("old", "new", "", "x\ny"))
+}
@@ -0,0 +1,111 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"bumsiku/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingSink는 항상 기록에 실패하는 싱크입니다.
+type failingSink struct {
+	closed bool
+}
+
+func (s *failingSink) Write(entries []utils.LogEntry) error { return errors.New("기록 실패") }
+func (s *failingSink) Close() error                         { s.closed = true; return nil }
+
+// [GIVEN] 메모리, JSON, 항상 실패하는 싱크를 함께 사용하는 로거
+// [WHEN] 로그를 남기고 Close 호출
+// [THEN] 실패하는 싱크와 관계없이 나머지 싱크에 모두 기록되고, 모든 싱크가 닫힘 확인
+func TestLogger_FanOut(t *testing.T) {
+	memory := utils.NewMemorySink()
+	var buf bytes.Buffer
+	failing := &failingSink{}
+	logger := utils.NewLoggerWithOptions(utils.LoggerOptions{FlushInterval: time.Hour}, failing, memory, utils.NewJSONSink(&buf))
+
+	require.NoError(t, logger.Info(context.Background(), "첫 번째", map[string]string{"handler": "Test", "env": "무시"}))
+	require.NoError(t, logger.Error(context.Background(), "<두 번째>", nil))
+	require.NoError(t, logger.Close(context.Background()))
+
+	entries := memory.Entries()
+	require.Len(t, entries, 2)
+	assert.Equal(t, utils.LogLevelInfo, entries[0].Level)
+	assert.Equal(t, "첫 번째", entries[0].Message)
+	assert.Equal(t, map[string]string{"handler": "Test"}, entries[0].Fields)
+	assert.NotEqual(t, "무시", entries[0].Env)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	var decoded utils.LogEntry
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &decoded))
+	assert.Equal(t, utils.LogLevelError, decoded.Level)
+	assert.Equal(t, "<두 번째>", decoded.Message)
+	assert.Contains(t, lines[1], `"message":"<두 번째>"`)
+
+	assert.True(t, failing.closed)
+}
+
+// [GIVEN] 최대 크기가 작고 이전 파일을 2개까지 보관하는 파일 싱크
+// [WHEN] 최대 크기를 여러 번 넘도록 로그 기록
+// [THEN] 파일이 교체되고, 이전 파일은 2개만 남으며 모든 줄이 JSON 로그임 확인
+func TestFileSink_Rotate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", "app.log")
+	sink, err := utils.NewFileSink(path, 300, 2)
+	require.NoError(t, err)
+
+	for i := 0; i < 20; i++ {
+		require.NoError(t, sink.Write([]utils.LogEntry{{
+			Time:    time.Now(),
+			Level:   utils.LogLevelInfo,
+			Message: strings.Repeat("x", 50),
+			Env:     "test",
+		}}))
+	}
+	require.NoError(t, sink.Close())
+
+	for _, name := range []string{path, path + ".1", path + ".2"} {
+		data, err := os.ReadFile(name)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, len(data), 300)
+		for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+			var entry utils.LogEntry
+			require.NoError(t, json.Unmarshal([]byte(line), &entry))
+			assert.Equal(t, "test", entry.Env)
+		}
+	}
+	_, err = os.Stat(path + ".3")
+	assert.True(t, os.IsNotExist(err))
+}
+
+// [GIVEN] 최소 레벨이 WARN인 로거
+// [WHEN] 모든 레벨의 로그를 남기고 Flush 호출
+// [THEN] WARN 이상의 로그만 기록 확인
+func TestLogger_MinLevel(t *testing.T) {
+	memory := utils.NewMemorySink()
+	logger := utils.NewLoggerWithOptions(utils.LoggerOptions{FlushInterval: time.Hour, MinLevel: utils.LogLevelWarn}, memory)
+	defer logger.Close(context.Background())
+
+	assert.False(t, logger.Enabled(utils.LogLevelInfo))
+	require.NoError(t, logger.Debug(context.Background(), "디버그", nil))
+	require.NoError(t, logger.Info(context.Background(), "정보", nil))
+	require.NoError(t, logger.Warn(context.Background(), "경고", nil))
+	require.NoError(t, logger.Error(context.Background(), "오류", nil))
+	require.NoError(t, logger.Flush(context.Background()))
+
+	var levels []string
+	for _, entry := range memory.Entries() {
+		levels = append(levels, entry.Level)
+	}
+	assert.Equal(t, []string{utils.LogLevelWarn, utils.LogLevelError}, levels)
+}
@@ -0,0 +1,254 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"bumsiku/internal/utils"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCloudWatchLogs는 전송된 로그를 기록하는 CloudWatch Logs 모의 클라이언트입니다.
+type fakeCloudWatchLogs struct {
+	mu       sync.Mutex
+	batches  [][]string
+	streams  []string // 묶음별 로그 스트림
+	created  []string // 생성한 로그 스트림
+	failures int      // 처음 이 횟수만큼 전송 실패
+	attempts int
+}
+
+func (f *fakeCloudWatchLogs) CreateLogGroup(ctx context.Context, params *cloudwatchlogs.CreateLogGroupInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.CreateLogGroupOutput, error) {
+	return &cloudwatchlogs.CreateLogGroupOutput{}, nil
+}
+
+func (f *fakeCloudWatchLogs) CreateLogStream(ctx context.Context, params *cloudwatchlogs.CreateLogStreamInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.CreateLogStreamOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.created = append(f.created, *params.LogStreamName)
+	return &cloudwatchlogs.CreateLogStreamOutput{}, nil
+}
+
+func (f *fakeCloudWatchLogs) PutLogEvents(ctx context.Context, params *cloudwatchlogs.PutLogEventsInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.PutLogEventsOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.attempts++
+	if f.attempts <= f.failures {
+		return nil, errors.New("일시적인 오류")
+	}
+
+	var size int
+	messages := make([]string, 0, len(params.LogEvents))
+	for i, event := range params.LogEvents {
+		if i > 0 && *event.Timestamp < *params.LogEvents[i-1].Timestamp {
+			return nil, errors.New("시간순으로 정렬되지 않은 로그")
+		}
+		size += len(*event.Message) + 26
+		messages = append(messages, *event.Message)
+	}
+	if len(messages) > 10000 || size > 1048576 {
+		return nil, errors.New("요청 크기 제한 초과")
+	}
+
+	f.batches = append(f.batches, messages)
+	f.streams = append(f.streams, *params.LogStreamName)
+	return &cloudwatchlogs.PutLogEventsOutput{NextSequenceToken: aws.String("next")}, nil
+}
+
+func (f *fakeCloudWatchLogs) result() (batches [][]string, attempts int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([][]string(nil), f.batches...), f.attempts
+}
+
+func countEvents(batches [][]string) int {
+	count := 0
+	for _, batch := range batches {
+		count += len(batch)
+	}
+	return count
+}
+
+// [GIVEN] 전송 주기가 긴 로거
+// [WHEN] 로그를 남긴 뒤 Flush 호출
+// [THEN] 로그를 남길 때는 전송하지 않고, Flush할 때 한 번에 전송하며 호출한 쪽의 필드는 변경하지 않음 확인
+func TestLogger_BuffersUntilFlush(t *testing.T) {
+	client := &fakeCloudWatchLogs{}
+	logger := utils.NewLoggerWithOptions(utils.LoggerOptions{FlushInterval: time.Hour}, utils.NewCloudWatchSink(client, utils.CloudWatchSinkOptions{}))
+	defer logger.Close(context.Background())
+
+	fields := map[string]string{"handler": "Test"}
+	for i := 0; i < 5; i++ {
+		require.NoError(t, logger.Info(context.Background(), fmt.Sprintf("로그 %d", i), fields))
+	}
+	assert.Equal(t, map[string]string{"handler": "Test"}, fields)
+
+	batches, _ := client.result()
+	assert.Empty(t, batches)
+
+	require.NoError(t, logger.Flush(context.Background()))
+	batches, _ = client.result()
+	require.Len(t, batches, 1)
+	require.Len(t, batches[0], 5)
+
+	var entry utils.LogEntry
+	require.NoError(t, json.Unmarshal([]byte(batches[0][0]), &entry))
+	assert.Equal(t, utils.LogLevelInfo, entry.Level)
+	assert.Equal(t, "로그 0", entry.Message)
+	assert.Equal(t, map[string]string{"handler": "Test"}, entry.Fields)
+}
+
+// [GIVEN] 3개가 쌓이면 전송하는 로거
+// [WHEN] 로그 3개를 남기고, 1개를 더 남긴 뒤 Flush 호출
+// [THEN] 3개가 쌓이면 전송 주기를 기다리지 않고 전송하고, 나머지는 Flush할 때 전송 확인
+func TestLogger_BatchSize(t *testing.T) {
+	client := &fakeCloudWatchLogs{}
+	logger := utils.NewLoggerWithOptions(utils.LoggerOptions{FlushInterval: time.Hour, BatchSize: 3}, utils.NewCloudWatchSink(client, utils.CloudWatchSinkOptions{}))
+	defer logger.Close(context.Background())
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, logger.Info(context.Background(), "로그", nil))
+	}
+	assert.Eventually(t, func() bool {
+		batches, _ := client.result()
+		return countEvents(batches) == 3
+	}, time.Second, time.Millisecond)
+
+	require.NoError(t, logger.Info(context.Background(), "로그", nil))
+	require.NoError(t, logger.Flush(context.Background()))
+	batches, _ := client.result()
+	require.Len(t, batches, 2)
+	assert.Len(t, batches[1], 1)
+}
+
+// [GIVEN] 처음 두 번 전송에 실패하는 CloudWatch
+// [WHEN] 로그를 남기고 Flush 호출
+// [THEN] 재시도해 로그를 한 번만 전송 확인
+func TestCloudWatchSink_Retry(t *testing.T) {
+	client := &fakeCloudWatchLogs{failures: 2}
+	sink := utils.NewCloudWatchSink(client, utils.CloudWatchSinkOptions{RetryBackoff: time.Millisecond})
+	logger := utils.NewLoggerWithOptions(utils.LoggerOptions{FlushInterval: time.Hour}, sink)
+	defer logger.Close(context.Background())
+
+	require.NoError(t, logger.Error(context.Background(), "오류", nil))
+	require.NoError(t, logger.Flush(context.Background()))
+
+	batches, attempts := client.result()
+	assert.Equal(t, 3, attempts)
+	require.Len(t, batches, 1)
+	assert.Len(t, batches[0], 1)
+}
+
+// [GIVEN] 요청 크기 제한을 넘는 큰 로그
+// [WHEN] 로그를 남기고 Flush 호출
+// [THEN] 이벤트 크기 제한에 맞게 잘리고, 요청 크기 제한에 맞게 나누어 전송 확인
+func TestCloudWatchSink_SplitsLargeBatches(t *testing.T) {
+	client := &fakeCloudWatchLogs{}
+	logger := utils.NewLoggerWithOptions(utils.LoggerOptions{FlushInterval: time.Hour}, utils.NewCloudWatchSink(client, utils.CloudWatchSinkOptions{}))
+	defer logger.Close(context.Background())
+
+	large := strings.Repeat("가", 100*1024) // 300KB
+	for i := 0; i < 5; i++ {
+		require.NoError(t, logger.Info(context.Background(), large, nil))
+	}
+	require.NoError(t, logger.Flush(context.Background()))
+
+	batches, _ := client.result()
+	require.Len(t, batches, 2)
+	assert.Equal(t, 5, countEvents(batches))
+	assert.LessOrEqual(t, len(batches[0][0]), 256*1024-26)
+
+	var entry utils.LogEntry
+	require.NoError(t, json.Unmarshal([]byte(batches[0][0]), &entry))
+	assert.True(t, strings.HasSuffix(entry.Message, "...(truncated)"))
+}
+
+// [GIVEN] 필드 값이 크기 제한을 넘는 로그
+// [WHEN] CloudWatch 싱크로 전송
+// [THEN] 필드 순서가 고정된 JSON으로 인코딩되고, JSON 형식을 유지한 채 큰 필드만 잘림 확인
+func TestCloudWatchSink_JSONEvents(t *testing.T) {
+	client := &fakeCloudWatchLogs{}
+	sink := utils.NewCloudWatchSink(client, utils.CloudWatchSinkOptions{})
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	fields := map[string]string{"path": "/posts", "method": "GET", "body": strings.Repeat("\"", 200*1024)}
+	require.NoError(t, sink.Write([]utils.LogEntry{
+		{Time: at, Level: utils.LogLevelInfo, Message: "a=\"b\" <c>", Env: "test", Fields: map[string]string{"path": "/posts", "method": "GET"}},
+		{Time: at, Level: utils.LogLevelError, Message: "큰 필드", Env: "test", Fields: fields},
+	}))
+
+	batches, _ := client.result()
+	require.Len(t, batches, 1)
+	assert.Equal(t, `{"time":"2026-01-02T03:04:05Z","level":"INFO","message":"a=\"b\" <c>","env":"test","fields":{"method":"GET","path":"/posts"}}`, batches[0][0])
+
+	assert.LessOrEqual(t, len(batches[0][1]), 256*1024-26)
+	var entry utils.LogEntry
+	require.NoError(t, json.Unmarshal([]byte(batches[0][1]), &entry))
+	assert.Equal(t, "큰 필드", entry.Message)
+	assert.Equal(t, "/posts", entry.Fields["path"])
+	assert.True(t, strings.HasSuffix(entry.Fields["body"], "...(truncated)"))
+	assert.Len(t, fields["body"], 200*1024, "호출한 쪽의 필드는 변경하지 않음")
+}
+
+// [GIVEN] 자정을 사이에 둔 로그
+// [WHEN] CloudWatch 싱크로 전송
+// [THEN] 날짜별 로그 스트림을 만들어 각 스트림에 나누어 전송 확인
+func TestCloudWatchSink_DailyStreams(t *testing.T) {
+	t.Setenv("APP_ENV", "test")
+	client := &fakeCloudWatchLogs{}
+	sink := utils.NewCloudWatchSink(client, utils.CloudWatchSinkOptions{})
+
+	midnight := time.Date(2030, 5, 2, 0, 0, 0, 0, time.Local)
+	require.NoError(t, sink.Write([]utils.LogEntry{
+		{Time: midnight.Add(-2 * time.Second), Level: utils.LogLevelInfo, Message: "어제 1"},
+		{Time: midnight.Add(-time.Second), Level: utils.LogLevelInfo, Message: "어제 2"},
+		{Time: midnight, Level: utils.LogLevelInfo, Message: "오늘"},
+	}))
+
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	assert.Equal(t, []string{"test-2030-05-01", "test-2030-05-02"}, client.streams)
+	assert.Len(t, client.batches[0], 2)
+	assert.Len(t, client.batches[1], 1)
+	assert.Contains(t, client.created, "test-2030-05-01")
+	assert.Contains(t, client.created, "test-2030-05-02")
+}
+
+// [GIVEN] 여러 고루틴에서 동시에 로그를 남기는 로거
+// [WHEN] Close 호출
+// [THEN] 남은 로그를 모두 전송하고, 이후에는 로그를 받지 않음 확인
+func TestLogger_CloseFlushesConcurrentLogs(t *testing.T) {
+	client := &fakeCloudWatchLogs{}
+	logger := utils.NewLoggerWithOptions(utils.LoggerOptions{FlushInterval: time.Millisecond, BatchSize: 7}, utils.NewCloudWatchSink(client, utils.CloudWatchSinkOptions{}))
+
+	var wg sync.WaitGroup
+	for g := 0; g < 20; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				_ = logger.Warn(context.Background(), "동시 로그", map[string]string{"goroutine": fmt.Sprintf("%d", g)})
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	require.NoError(t, logger.Close(context.Background()))
+	batches, _ := client.result()
+	assert.Equal(t, 1000, countEvents(batches))
+
+	assert.ErrorIs(t, logger.Info(context.Background(), "종료 후", nil), utils.ErrLoggerClosed)
+	assert.ErrorIs(t, logger.Flush(context.Background()), utils.ErrLoggerClosed)
+	assert.NoError(t, logger.Close(context.Background()))
+}